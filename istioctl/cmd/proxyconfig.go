@@ -15,19 +15,24 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 
 	"github.com/ghodss/yaml"
+	"github.com/hashicorp/go-multierror"
 	"github.com/spf13/cobra"
 
 	"istio.io/istio/istioctl/pkg/util/handlers"
+	"istio.io/istio/istioctl/pkg/writer/compare"
 	"istio.io/istio/istioctl/pkg/writer/envoy/clusters"
 	"istio.io/istio/istioctl/pkg/writer/envoy/configdump"
 	"istio.io/istio/pilot/pkg/model"
@@ -37,6 +42,7 @@ import (
 
 const (
 	jsonOutput    = "json"
+	yamlOutput    = "yaml"
 	summaryOutput = "short"
 )
 
@@ -151,6 +157,14 @@ var (
 )
 
 func setupPodConfigdumpWriter(podName, podNamespace string, out io.Writer) (*configdump.ConfigWriter, error) {
+	debug, err := getPodConfigDump(podName, podNamespace)
+	if err != nil {
+		return nil, err
+	}
+	return setupConfigdumpEnvoyConfigWriter(debug, out)
+}
+
+func getPodConfigDump(podName, podNamespace string) ([]byte, error) {
 	kubeClient, err := kubeClient(kubeconfig, configContext)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create k8s client: %v", err)
@@ -160,10 +174,19 @@ func setupPodConfigdumpWriter(podName, podNamespace string, out io.Writer) (*con
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute command on %s.%s sidecar: %v", podName, podNamespace, err)
 	}
-	return setupConfigdumpEnvoyConfigWriter(debug, out)
+	return debug, nil
 }
 
 func setupFileConfigdumpWriter(filename string, out io.Writer) (*configdump.ConfigWriter, error) {
+	data, err := readConfigFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return setupConfigdumpEnvoyConfigWriter(data, out)
+}
+
+// readConfigFile reads a file, or stdin if the filename is "-".
+func readConfigFile(filename string) ([]byte, error) {
 	file := os.Stdin
 	if filename != "-" {
 		var err error
@@ -177,11 +200,7 @@ func setupFileConfigdumpWriter(filename string, out io.Writer) (*configdump.Conf
 			log.Errorf("failed to close %s: %s", filename, err)
 		}
 	}()
-	data, err := ioutil.ReadAll(file)
-	if err != nil {
-		return nil, err
-	}
-	return setupConfigdumpEnvoyConfigWriter(data, out)
+	return ioutil.ReadAll(file)
 }
 
 func setupConfigdumpEnvoyConfigWriter(debug []byte, out io.Writer) (*configdump.ConfigWriter, error) {
@@ -230,6 +249,14 @@ func getLogLevelFromConfigMap() (string, error) {
 }
 
 func setupPodClustersWriter(podName, podNamespace string, out io.Writer) (*clusters.ConfigWriter, error) {
+	debug, err := getPodClustersDump(podName, podNamespace)
+	if err != nil {
+		return nil, err
+	}
+	return setupClustersEnvoyConfigWriter(debug, out)
+}
+
+func getPodClustersDump(podName, podNamespace string) ([]byte, error) {
 	kubeClient, err := kubeClient(kubeconfig, configContext)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create k8s client: %v", err)
@@ -239,7 +266,7 @@ func setupPodClustersWriter(podName, podNamespace string, out io.Writer) (*clust
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute command on Envoy: %v", err)
 	}
-	return setupClustersEnvoyConfigWriter(debug, out)
+	return debug, nil
 }
 
 func setupFileClustersWriter(filename string, out io.Writer) (*clusters.ConfigWriter, error) {
@@ -411,6 +438,48 @@ func listenerConfigCmd() *cobra.Command {
 	return listenerConfigCmd
 }
 
+// parseLoggerLevels parses a comma separated list of [<logger>:]<level>, validating every logger
+// against the logger names reported by the Envoys. All invalid loggers and levels are reported
+// together, so nothing is applied unless the whole list is valid.
+func parseLoggerLevels(loggerLevels string, loggerNames []string) (map[string]Level, error) {
+	var errs error
+	destLoggerLevels := map[string]Level{}
+	for _, ol := range strings.Split(loggerLevels, ",") {
+		if !strings.Contains(ol, ":") && !strings.Contains(ol, "=") {
+			level, ok := stringToLevel[ol]
+			if ok {
+				destLoggerLevels = map[string]Level{
+					defaultLoggerName: level,
+				}
+			} else {
+				errs = multierror.Append(errs, fmt.Errorf("unrecognized logging level: %v", ol))
+			}
+			continue
+		}
+		loggerLevel := regexp.MustCompile(`[:=]`).Split(ol, 2)
+		valid := true
+		for _, logName := range loggerNames {
+			if !strings.Contains(logName, loggerLevel[0]) {
+				errs = multierror.Append(errs, fmt.Errorf("unrecognized logger name: %v", loggerLevel[0]))
+				valid = false
+				break
+			}
+		}
+		level, ok := stringToLevel[loggerLevel[1]]
+		if !ok {
+			errs = multierror.Append(errs, fmt.Errorf("unrecognized logging level: %v", loggerLevel[1]))
+			valid = false
+		}
+		if valid {
+			destLoggerLevels[loggerLevel[0]] = level
+		}
+	}
+	if errs != nil {
+		return nil, errs
+	}
+	return destLoggerLevels, nil
+}
+
 func logCmd() *cobra.Command {
 	var podName, podNamespace string
 	var podNames []string
@@ -481,30 +550,8 @@ func logCmd() *cobra.Command {
 					destLoggerLevels[defaultLoggerName] = defaultOutputLevel
 				}
 			} else if loggerLevelString != "" {
-				levels := strings.Split(loggerLevelString, ",")
-				for _, ol := range levels {
-					if !strings.Contains(ol, ":") && !strings.Contains(ol, "=") {
-						level, ok := stringToLevel[ol]
-						if ok {
-							destLoggerLevels = map[string]Level{
-								defaultLoggerName: level,
-							}
-						} else {
-							return fmt.Errorf("unrecognized logging level: %v", ol)
-						}
-					} else {
-						loggerLevel := regexp.MustCompile(`[:=]`).Split(ol, 2)
-						for _, logName := range loggerNames {
-							if !strings.Contains(logName, loggerLevel[0]) {
-								return fmt.Errorf("unrecognized logger name: %v", loggerLevel[0])
-							}
-						}
-						level, ok := stringToLevel[loggerLevel[1]]
-						if !ok {
-							return fmt.Errorf("unrecognized logging level: %v", loggerLevel[1])
-						}
-						destLoggerLevels[loggerLevel[0]] = level
-					}
+				if destLoggerLevels, err = parseLoggerLevels(loggerLevelString, loggerNames); err != nil {
+					return err
 				}
 			}
 
@@ -636,6 +683,9 @@ func endpointConfigCmd() *cobra.Command {
   # Retrieve full endpoint with the status (healthy).
   istioctl proxy-config endpoint <pod-name[.namespace]> --status healthy -ojson
 
+  # Retrieve endpoint summary for endpoints that are unhealthy or draining.
+  istioctl proxy-config endpoint <pod-name[.namespace]> --status unhealthy,draining
+
   # Retrieve endpoint summary without using Kubernetes API
   ssh <user@hostname> 'curl localhost:15000/clusters?format=json' > envoy-clusters.json
   istioctl proxy-config endpoints --file envoy-clusters.json
@@ -663,6 +713,11 @@ func endpointConfigCmd() *cobra.Command {
 				return err
 			}
 
+			if status != "" {
+				if err := clusters.ValidateEndpointStatus(status); err != nil {
+					return err
+				}
+			}
 			filter := clusters.EndpointFilter{
 				Address: address,
 				Port:    uint32(port),
@@ -685,7 +740,7 @@ func endpointConfigCmd() *cobra.Command {
 	endpointConfigCmd.PersistentFlags().StringVar(&address, "address", "", "Filter endpoints by address field")
 	endpointConfigCmd.PersistentFlags().IntVar(&port, "port", 0, "Filter endpoints by Port field")
 	endpointConfigCmd.PersistentFlags().StringVar(&clusterName, "cluster", "", "Filter endpoints by cluster name field")
-	endpointConfigCmd.PersistentFlags().StringVar(&status, "status", "", "Filter endpoints by status field")
+	endpointConfigCmd.PersistentFlags().StringVar(&status, "status", "", "Filter endpoints by a comma separated list of statuses: HEALTHY|UNHEALTHY|DRAINING|DEGRADED")
 	endpointConfigCmd.PersistentFlags().StringVarP(&configDumpFile, "file", "f", "",
 		"Envoy config dump JSON file")
 
@@ -791,13 +846,266 @@ func secretConfigCmd() *cobra.Command {
 	return secretConfigCmd
 }
 
+// configSection renders one section of the output of proxy-config all as JSON.
+type configSection struct {
+	name  string
+	empty string
+	print func(out io.Writer) error
+}
+
+// collectAllConfig returns every config type of an Envoy as JSON, keyed by type. Sections that cannot be
+// retrieved, for example because the config dump does not contain them, are empty. A config or clusters dump
+// that cannot be parsed is an error.
+func collectAllConfig(configDump, clustersDump []byte) (map[string]json.RawMessage, error) {
+	var configWriter *configdump.ConfigWriter
+	if configDump != nil {
+		var err error
+		if configWriter, err = setupConfigdumpEnvoyConfigWriter(configDump, nil); err != nil {
+			return nil, fmt.Errorf("failed to parse config dump: %v", err)
+		}
+	}
+	var clustersWriter *clusters.ConfigWriter
+	if clustersDump != nil {
+		var err error
+		if clustersWriter, err = setupClustersEnvoyConfigWriter(clustersDump, nil); err != nil {
+			return nil, fmt.Errorf("failed to parse clusters dump: %v", err)
+		}
+	}
+
+	fromConfigDump := func(printFn func(*configdump.ConfigWriter) error) func(io.Writer) error {
+		return func(w io.Writer) error {
+			if configWriter == nil {
+				return fmt.Errorf("no config dump")
+			}
+			configWriter.Stdout = w
+			return printFn(configWriter)
+		}
+	}
+	sections := []configSection{
+		{"bootstrap", "{}", fromConfigDump(func(cw *configdump.ConfigWriter) error {
+			return cw.PrintBootstrapDump()
+		})},
+		{"clusters", "[]", fromConfigDump(func(cw *configdump.ConfigWriter) error {
+			return cw.PrintClusterDump(configdump.ClusterFilter{})
+		})},
+		{"listeners", "[]", fromConfigDump(func(cw *configdump.ConfigWriter) error {
+			return cw.PrintListenerDump(configdump.ListenerFilter{})
+		})},
+		{"routes", "[]", fromConfigDump(func(cw *configdump.ConfigWriter) error {
+			return cw.PrintRouteDump(configdump.RouteFilter{})
+		})},
+		{"secrets", "{}", fromConfigDump(func(cw *configdump.ConfigWriter) error {
+			return cw.PrintSecretDump()
+		})},
+		{"endpoints", "[]", func(w io.Writer) error {
+			if clustersWriter == nil {
+				return fmt.Errorf("no clusters dump")
+			}
+			clustersWriter.Stdout = w
+			return clustersWriter.PrintEndpoints(clusters.EndpointFilter{})
+		}},
+	}
+
+	all := map[string]json.RawMessage{}
+	for _, section := range sections {
+		var buf bytes.Buffer
+		if err := section.print(&buf); err != nil || !json.Valid(buf.Bytes()) {
+			log.Debugf("%s config is not available, leaving it empty: %v", section.name, err)
+			all[section.name] = json.RawMessage(section.empty)
+			continue
+		}
+		all[section.name] = buf.Bytes()
+	}
+	return all, nil
+}
+
+// formatConfig renders a value as indented JSON or as YAML.
+func formatConfig(v interface{}, format string) ([]byte, error) {
+	b, err := json.MarshalIndent(v, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+	switch format {
+	case jsonOutput:
+		return b, nil
+	case yamlOutput:
+		return yaml.JSONToYAML(b)
+	default:
+		return nil, fmt.Errorf("output format %q not supported", format)
+	}
+}
+
+// writeAllConfig writes every config type of an Envoy in a single document keyed by type.
+func writeAllConfig(out io.Writer, configDump, clustersDump []byte, format string) error {
+	all, err := collectAllConfig(configDump, clustersDump)
+	if err != nil {
+		return err
+	}
+	b, err := formatConfig(all, format)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(out, string(b))
+	return err
+}
+
+// writeAllConfigToDir writes every config type of an Envoy to its own file in dir, e.g. clusters.yaml.
+// The directory is created if needed. If any of the files exists and overwrite is not set, nothing is
+// written.
+func writeAllConfigToDir(dir string, configDump, clustersDump []byte, format string, overwrite bool) error {
+	all, err := collectAllConfig(configDump, clustersDump)
+	if err != nil {
+		return err
+	}
+	files := map[string][]byte{}
+	for name, section := range all {
+		b, err := formatConfig(section, format)
+		if err != nil {
+			return err
+		}
+		file := filepath.Join(dir, name+"."+format)
+		if _, err := os.Stat(file); err == nil && !overwrite {
+			return fmt.Errorf("%s already exists, use --overwrite to replace it", file)
+		}
+		files[file] = append(b, '\n')
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create output directory %s: %v", dir, err)
+	}
+	for file, b := range files {
+		if err := ioutil.WriteFile(file, b, 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %v", file, err)
+		}
+	}
+	return nil
+}
+
+func allConfigCmd() *cobra.Command {
+	var podName, podNamespace, outputDir string
+	var overwrite bool
+
+	allConfigCmd := &cobra.Command{
+		Use:   "all [<type>/]<name>[.<namespace>]",
+		Short: "Retrieves all configuration for the Envoy in the specified pod",
+		Long: `Retrieve the bootstrap, cluster, listener, route, secret and endpoint configuration for the Envoy instance ` +
+			`in the specified pod, in a single document keyed by type. Types that are not available are left empty.`,
+		Example: `  # Retrieve all configuration for a given pod from Envoy.
+  istioctl proxy-config all <pod-name[.namespace]>
+
+  # Retrieve all configuration for a given pod from Envoy in YAML.
+  istioctl proxy-config all <pod-name[.namespace]> -o yaml
+
+  # Write each type of configuration for a given pod to its own file, e.g. ./config/<pod-name.namespace>/clusters.yaml.
+  istioctl proxy-config all <pod-name[.namespace]> -o yaml --output-dir ./config
+
+  # Retrieve all configuration without using Kubernetes API. Endpoints are not part of the config dump and are left empty.
+  ssh <user@hostname> 'curl localhost:15000/config_dump' > envoy-config.json
+  istioctl proxy-config all --file envoy-config.json
+`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if (len(args) == 1) != (configDumpFile == "") {
+				cmd.Println(cmd.UsageString())
+				return fmt.Errorf("all requires pod name or --file parameter")
+			}
+			return nil
+		},
+		RunE: func(c *cobra.Command, args []string) error {
+			var configDump, clustersDump []byte
+			var name string
+			var err error
+			if len(args) == 1 {
+				if podName, podNamespace, err = getPodName(args[0]); err != nil {
+					return err
+				}
+				if configDump, err = getPodConfigDump(podName, podNamespace); err != nil {
+					return err
+				}
+				if clustersDump, err = getPodClustersDump(podName, podNamespace); err != nil {
+					log.Warnf("failed to retrieve the endpoints of %s.%s, leaving them empty: %v", podName, podNamespace, err)
+					clustersDump = nil
+				}
+				name = podName + "." + podNamespace
+			} else {
+				if configDump, err = readConfigFile(configDumpFile); err != nil {
+					return err
+				}
+				name = strings.TrimSuffix(filepath.Base(configDumpFile), filepath.Ext(configDumpFile))
+			}
+			if outputDir != "" {
+				dir := filepath.Join(outputDir, name)
+				if err := writeAllConfigToDir(dir, configDump, clustersDump, outputFormat, overwrite); err != nil {
+					return err
+				}
+				_, _ = fmt.Fprintf(c.OutOrStdout(), "Wrote configuration to %s\n", dir)
+				return nil
+			}
+			return writeAllConfig(c.OutOrStdout(), configDump, clustersDump, outputFormat)
+		},
+	}
+
+	allConfigCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", jsonOutput, "Output format: one of json|yaml")
+	allConfigCmd.PersistentFlags().StringVarP(&configDumpFile, "file", "f", "",
+		"Envoy config dump JSON file")
+	allConfigCmd.PersistentFlags().StringVar(&outputDir, "output-dir", "",
+		"Write each type of configuration to its own file in a directory named after the pod, under this directory")
+	allConfigCmd.PersistentFlags().BoolVar(&overwrite, "overwrite", false,
+		"Replace existing files when using --output-dir")
+
+	return allConfigCmd
+}
+
+func diffConfigCmd() *cobra.Command {
+	diffConfigCmd := &cobra.Command{
+		Use:   "diff [<type>/]<name>[.<namespace>] [<type>/]<name>[.<namespace>]",
+		Short: "(experimental) Diffs the configuration of the Envoys in two pods",
+		Long: `(experimental) Retrieve the config dumps of the Envoy instances in two pods and print a diff of their ` +
+			`clusters, listeners and routes. Versions and update times are ignored.`,
+		Example: `  # Diff the configuration of two pods, e.g. before and after an upgrade.
+  istioctl proxy-config diff <pod-name[.namespace]> <pod-name[.namespace]>`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				cmd.Println(cmd.UsageString())
+				return fmt.Errorf("diff requires two pod names")
+			}
+			return nil
+		},
+		RunE: func(c *cobra.Command, args []string) error {
+			names := make([]string, 0, len(args))
+			dumps := make([][]byte, 0, len(args))
+			for _, arg := range args {
+				podName, podNamespace, err := getPodName(arg)
+				if err != nil {
+					return err
+				}
+				dump, err := getPodConfigDump(podName, podNamespace)
+				if err != nil {
+					return err
+				}
+				names = append(names, fmt.Sprintf("%s.%s", podName, podNamespace))
+				dumps = append(dumps, dump)
+			}
+			comparator, err := compare.NewProxyComparator(c.OutOrStdout(), names[0], dumps[0], names[1], dumps[1])
+			if err != nil {
+				return err
+			}
+			return comparator.Diff()
+		},
+	}
+
+	diffConfigCmd.Long += "\n\n" + ExperimentalMsg
+	return diffConfigCmd
+}
+
 func proxyConfig() *cobra.Command {
 	configCmd := &cobra.Command{
 		Use:   "proxy-config",
 		Short: "Retrieve information about proxy configuration from Envoy [kube only]",
 		Long:  `A group of commands used to retrieve information about proxy configuration from the Envoy config dump`,
 		Example: `  # Retrieve information about proxy configuration from an Envoy instance.
-  istioctl proxy-config <clusters|listeners|routes|endpoints|bootstrap|log|secret> <pod-name[.namespace]>`,
+  istioctl proxy-config <clusters|listeners|routes|endpoints|bootstrap|log|secret|all> <pod-name[.namespace]>
+
+  # Diff the proxy configuration of two Envoy instances.
+  istioctl proxy-config diff <pod-name[.namespace]> <pod-name[.namespace]>`,
 		Aliases: []string{"pc"},
 	}
 
@@ -810,6 +1118,8 @@ func proxyConfig() *cobra.Command {
 	configCmd.AddCommand(bootstrapConfigCmd())
 	configCmd.AddCommand(endpointConfigCmd())
 	configCmd.AddCommand(secretConfigCmd())
+	configCmd.AddCommand(allConfigCmd())
+	configCmd.AddCommand(diffConfigCmd())
 
 	return configCmd
 }
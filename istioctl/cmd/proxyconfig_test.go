@@ -17,6 +17,10 @@ package cmd
 import (
 	"bytes"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -42,6 +46,10 @@ func TestProxyConfig(t *testing.T) {
 		"details-v1-5b7f94f9bc-wp5tb": util.ReadFile("../pkg/writer/envoy/logging/testdata/logging.txt", t),
 		"httpbin-794b576b6c-qx6pf":    []byte("{}"),
 	}
+	diffConfig := map[string][]byte{
+		"httpbin-v1": util.ReadFile("testdata/proxyconfig/configdump-a.json", t),
+		"httpbin-v2": util.ReadFile("testdata/proxyconfig/configdump-b.json", t),
+	}
 	cases := []execTestCase{
 		{
 			args:           strings.Split("proxy-config", " "),
@@ -95,6 +103,12 @@ func TestProxyConfig(t *testing.T) {
 			expectedString:   "unrecognized logger name: xxx",
 			wantException:    true,
 		},
+		{ // every invalid logger and level is reported
+			execClientConfig: loggingConfig,
+			args:             strings.Split("proxy-config log details-v1-5b7f94f9bc-wp5tb --level xxx:debug,http:yyy,redis:debug,zzz", " "),
+			expectedString:   "3 errors occurred",
+			wantException:    true,
+		},
 		{ // routes invalid
 			args:           strings.Split("proxy-config routes invalid", " "),
 			expectedString: "unable to retrieve Pod: pods \"invalid\" not found",
@@ -160,6 +174,67 @@ func TestProxyConfig(t *testing.T) {
 			expectedString:   `config dump has no configuration type`,
 			wantException:    true,
 		},
+		{ // endpoints filtered by a list of statuses
+			args: strings.Split("pc endpoint --file testdata/proxyconfig/clusters.json --status Unhealthy,draining", " "),
+			expectedOutput: `ENDPOINT          STATUS        OUTLIER CHECK     CLUSTER
+10.0.0.2:8080     UNHEALTHY     OK                outbound|80||httpbin.default.svc.cluster.local
+10.0.0.3:8080     DRAINING      OK                outbound|80||httpbin.default.svc.cluster.local
+`,
+		},
+		{ // no endpoints match the status
+			args:           strings.Split("pc endpoint --file testdata/proxyconfig/clusters.json --status degraded", " "),
+			expectedOutput: "No endpoints match status degraded\n",
+		},
+		{ // unknown status
+			args:           strings.Split("pc endpoint --file testdata/proxyconfig/clusters.json --status sick", " "),
+			expectedString: `unknown endpoint status "sick"`,
+			wantException:  true,
+		},
+		{ // all requires a pod or a file
+			args:           strings.Split("proxy-config all", " "),
+			expectedString: "all requires pod name or --file parameter",
+			wantException:  true,
+		},
+		{ // all with an empty config dump emits empty sections rather than failing
+			execClientConfig: loggingConfig,
+			args:             strings.Split("pc all httpbin-794b576b6c-qx6pf", " "),
+			expectedString:   "\"bootstrap\": {},\n    \"clusters\": [],",
+		},
+		{ // all from a pod
+			execClientConfig: diffConfig,
+			args:             strings.Split("pc all httpbin-v1", " "),
+			expectedString:   `"connectTimeout": "10s"`,
+		},
+		{ // all from a file in yaml, endpoints are not part of the config dump
+			args:           strings.Split("pc all --file testdata/proxyconfig/configdump-a.json -o yaml", " "),
+			expectedString: "endpoints: []",
+		},
+		{ // all does not support the short output format
+			args:           strings.Split("pc all --file testdata/proxyconfig/configdump-a.json -o short", " "),
+			expectedString: `output format "short" not supported`,
+			wantException:  true,
+		},
+		{ // diff requires two pods
+			args:           strings.Split("proxy-config diff httpbin-v1", " "),
+			expectedString: "diff requires two pod names",
+			wantException:  true,
+		},
+		{ // diff invalid
+			execClientConfig: diffConfig,
+			args:             strings.Split("proxy-config diff httpbin-v1 invalid", " "),
+			expectedString:   "unable to retrieve Pod: pods \"invalid\" not found",
+			wantException:    true,
+		},
+		{ // diff identical config dumps ignoring versions
+			execClientConfig: diffConfig,
+			args:             strings.Split("proxy-config diff httpbin-v1 httpbin-v1", " "),
+			expectedString:   "Clusters Match\nListeners Match\nRoutes Match",
+		},
+		{ // diff config dumps with a different cluster
+			execClientConfig: diffConfig,
+			args:             strings.Split("proxy-config diff httpbin-v1 httpbin-v2", " "),
+			expectedString:   `"connectTimeout": "5s"`,
+		},
 	}
 
 	for i, c := range cases {
@@ -169,6 +244,80 @@ func TestProxyConfig(t *testing.T) {
 	}
 }
 
+func TestParseLoggerLevels(t *testing.T) {
+	loggerNames := []string{"active loggers:\n  http: info\n  redis: info\n"}
+
+	levels, err := parseLoggerLevels("http:debug,redis=trace", loggerNames)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(levels, map[string]Level{"http": DebugLevel, "redis": TraceLevel}) {
+		t.Fatalf("unexpected logger levels: %v", levels)
+	}
+
+	_, err = parseLoggerLevels("xxx:debug,http:yyy,redis:debug,zzz", loggerNames)
+	if err == nil {
+		t.Fatal("expected invalid loggers and levels to be rejected")
+	}
+	for _, want := range []string{
+		"unrecognized logger name: xxx",
+		"unrecognized logging level: yyy",
+		"unrecognized logging level: zzz",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("expected error to contain %q, got %v", want, err)
+		}
+	}
+}
+
+func TestWriteAllConfigToDir(t *testing.T) {
+	configDump := util.ReadFile("testdata/proxyconfig/configdump-a.json", t)
+	tmp, err := ioutil.TempDir("", "proxyconfig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	dir := filepath.Join(tmp, "httpbin-v1.default")
+
+	if err := writeAllConfigToDir(dir, configDump, nil, yamlOutput, false); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"bootstrap", "clusters", "listeners", "routes", "secrets", "endpoints"} {
+		if _, err := os.Stat(filepath.Join(dir, name+".yaml")); err != nil {
+			t.Fatalf("expected %s to be written: %v", name, err)
+		}
+	}
+	clusters, err := ioutil.ReadFile(filepath.Join(dir, "clusters.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(clusters), "connectTimeout: 10s") {
+		t.Fatalf("expected the clusters of the config dump, got %s", clusters)
+	}
+
+	err = writeAllConfigToDir(dir, configDump, nil, yamlOutput, false)
+	if err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("expected existing files not to be replaced, got %v", err)
+	}
+	if err := writeAllConfigToDir(dir, configDump, nil, yamlOutput, true); err != nil {
+		t.Fatalf("expected existing files to be replaced with --overwrite: %v", err)
+	}
+}
+
+func TestWriteAllConfigInvalidDump(t *testing.T) {
+	var out bytes.Buffer
+	err := writeAllConfig(&out, []byte("not a config dump"), nil, jsonOutput)
+	if err == nil || !strings.Contains(err.Error(), "failed to parse config dump") {
+		t.Fatalf("expected an unparsable config dump to fail, got %v", err)
+	}
+
+	configDump := util.ReadFile("testdata/proxyconfig/configdump-a.json", t)
+	err = writeAllConfig(&out, configDump, []byte("not a clusters dump"), jsonOutput)
+	if err == nil || !strings.Contains(err.Error(), "failed to parse clusters dump") {
+		t.Fatalf("expected an unparsable clusters dump to fail, got %v", err)
+	}
+}
+
 func verifyExecTestOutput(t *testing.T, c execTestCase) {
 	t.Helper()
 
@@ -0,0 +1,127 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+// envoyStat is a single stat from the Envoy admin /stats endpoint.
+type envoyStat struct {
+	name  string
+	value string
+}
+
+// histogramQuantileRegex matches the quantiles of an Envoy histogram, e.g. "P50(1.0,1.05)",
+// where the first value is for the latest interval and the second is cumulative.
+var histogramQuantileRegex = regexp.MustCompile(`P([0-9.]+)\(([^,]+),([^)]+)\)`)
+
+func proxyStatsCmd() *cobra.Command {
+	var match string
+
+	statsCmd := &cobra.Command{
+		Use:   "proxy-stats [<type>/]<name>[.<namespace>]",
+		Short: "Retrieves Envoy stats matching a regex for the specified pod",
+		Long: `Retrieves the stats of the Envoy instance in the specified pod, filtered to the names matching ` +
+			`a regular expression and sorted by name. Histograms are printed as their cumulative quantiles.`,
+		Example: `  # Retrieve all upstream connection stats of a pod.
+  istioctl experimental proxy-stats <pod-name[.namespace]> --match 'upstream_cx'
+
+  # Retrieve the stats of the xds-grpc cluster.
+  istioctl experimental proxy-stats <pod-name[.namespace]> --match '^cluster\.xds-grpc\.'`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				cmd.Println(cmd.UsageString())
+				return fmt.Errorf("proxy-stats requires pod name")
+			}
+			return nil
+		},
+		RunE: func(c *cobra.Command, args []string) error {
+			re, err := regexp.Compile(match)
+			if err != nil {
+				return fmt.Errorf("invalid --match regex %q: %v", match, err)
+			}
+			podName, podNamespace, err := getPodName(args[0])
+			if err != nil {
+				return err
+			}
+			kubeClient, err := kubeClient(kubeconfig, configContext)
+			if err != nil {
+				return fmt.Errorf("failed to create k8s client: %v", err)
+			}
+			stats, err := kubeClient.EnvoyDo(context.TODO(), podName, podNamespace, "GET", "stats", nil)
+			if err != nil {
+				return fmt.Errorf("failed to execute command on %s.%s sidecar: %v", podName, podNamespace, err)
+			}
+			return printEnvoyStats(c.OutOrStdout(), filterEnvoyStats(string(stats), re))
+		},
+	}
+
+	statsCmd.PersistentFlags().StringVar(&match, "match", "", "Regular expression the stat names must match")
+	statsCmd.Long += "\n\n" + ExperimentalMsg
+	return statsCmd
+}
+
+// filterEnvoyStats parses the text output of the Envoy /stats endpoint and returns the stats whose
+// names match the regex, sorted by name.
+func filterEnvoyStats(stats string, match *regexp.Regexp) []envoyStat {
+	out := make([]envoyStat, 0)
+	for _, line := range strings.Split(stats, "\n") {
+		parts := strings.SplitN(line, ": ", 2)
+		if len(parts) != 2 || !match.MatchString(parts[0]) {
+			continue
+		}
+		out = append(out, envoyStat{name: parts[0], value: formatEnvoyStatValue(parts[1])})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].name < out[j].name
+	})
+	return out
+}
+
+// formatEnvoyStatValue renders histogram quantiles as "P50=1.05" using the cumulative values. Other
+// values, such as counters and gauges, are returned unchanged.
+func formatEnvoyStatValue(value string) string {
+	quantiles := histogramQuantileRegex.FindAllStringSubmatch(value, -1)
+	if len(quantiles) == 0 {
+		return value
+	}
+	rendered := make([]string, 0, len(quantiles))
+	for _, q := range quantiles {
+		cumulative := q[3]
+		if cumulative == "nan" {
+			cumulative = "-"
+		}
+		rendered = append(rendered, fmt.Sprintf("P%s=%s", q[1], cumulative))
+	}
+	return strings.Join(rendered, " ")
+}
+
+func printEnvoyStats(out io.Writer, stats []envoyStat) error {
+	w := new(tabwriter.Writer).Init(out, 0, 8, 5, ' ', 0)
+	fmt.Fprintln(w, "NAME\tVALUE")
+	for _, s := range stats {
+		fmt.Fprintf(w, "%s\t%s\n", s.name, s.value)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,68 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"istio.io/istio/pilot/test/util"
+)
+
+func TestProxyStats(t *testing.T) {
+	statsConfig := map[string][]byte{
+		"httpbin-794b576b6c-qx6pf": util.ReadFile("testdata/proxystats/stats.txt", t),
+	}
+	cases := []execTestCase{
+		{ // no pod
+			args:           strings.Split("x proxy-stats", " "),
+			expectedString: "proxy-stats requires pod name",
+			wantException:  true,
+		},
+		{ // pod invalid
+			execClientConfig: statsConfig,
+			args:             strings.Split("x proxy-stats invalid", " "),
+			expectedString:   "unable to retrieve Pod: pods \"invalid\" not found",
+			wantException:    true,
+		},
+		{ // regex invalid
+			execClientConfig: statsConfig,
+			args:             strings.Split("x proxy-stats httpbin-794b576b6c-qx6pf --match (", " "),
+			expectedString:   "invalid --match regex",
+			wantException:    true,
+		},
+		{ // filtered and sorted, with readable histogram quantiles
+			execClientConfig: statsConfig,
+			args:             strings.Split(`x proxy-stats httpbin-794b576b6c-qx6pf --match ^cluster\.xds-grpc\.`, " "),
+			expectedOutput: "NAME                                       VALUE\n" +
+				"cluster.xds-grpc.upstream_cx_active        1\n" +
+				"cluster.xds-grpc.upstream_cx_length_ms     " +
+				"P0=1.0 P25=1.025 P50=1.05 P75=1.075 P90=1.09 P95=1.095 P99=1.099 P99.5=1.0995 P99.9=1.0999 P100=1.1\n" +
+				"cluster.xds-grpc.upstream_cx_total         3\n",
+		},
+		{ // no match
+			execClientConfig: statsConfig,
+			args:             strings.Split("x proxy-stats httpbin-794b576b6c-qx6pf --match ^http\\.", " "),
+			expectedOutput:   "NAME     VALUE\n",
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("case %d %s", i, strings.Join(c.args, " ")), func(t *testing.T) {
+			verifyExecTestOutput(t, c)
+		})
+	}
+}
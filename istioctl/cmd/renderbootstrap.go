@@ -0,0 +1,101 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"istio.io/istio/pkg/bootstrap"
+	"istio.io/istio/pkg/config/mesh"
+	"istio.io/istio/pkg/util/gogoprotomarshal"
+)
+
+func renderBootstrapCmd() *cobra.Command {
+	var (
+		node            string
+		templateFile    string
+		proxyConfigFile string
+		nodeIPs         []string
+		meta            map[string]string
+	)
+
+	renderCmd := &cobra.Command{
+		Use:   "render-bootstrap",
+		Short: "Renders and validates an Envoy bootstrap offline",
+		Long: `Renders the Envoy bootstrap of a proxy from its node metadata and a bootstrap template, ` +
+			`without contacting Istiod, and validates the result against the Envoy bootstrap schema. ` +
+			`The rendered bootstrap is written to stdout.`,
+		Example: `  # Render the bootstrap of a sidecar using the default proxy config.
+  istioctl experimental render-bootstrap --template envoy_bootstrap.json \
+    --node sidecar~10.0.0.1~productpage.default~default.svc.cluster.local
+
+  # Render the bootstrap with a custom proxy config and node metadata.
+  istioctl experimental render-bootstrap --template envoy_bootstrap.json --proxy-config proxyconfig.yaml \
+    --meta CLUSTER_ID=Kubernetes --meta NETWORK=network1`,
+		Args: cobra.NoArgs,
+		RunE: func(c *cobra.Command, args []string) error {
+			if templateFile == "" {
+				return fmt.Errorf("--template is required")
+			}
+			proxyConfig := mesh.DefaultProxyConfig()
+			if proxyConfigFile != "" {
+				content, err := ioutil.ReadFile(proxyConfigFile)
+				if err != nil {
+					return fmt.Errorf("failed to read proxy config: %v", err)
+				}
+				if err := gogoprotomarshal.ApplyYAML(string(content), &proxyConfig); err != nil {
+					return fmt.Errorf("failed to parse proxy config: %v", err)
+				}
+			}
+
+			keys := make([]string, 0, len(meta))
+			for k := range meta {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			localEnv := make([]string, 0, len(meta))
+			for _, k := range keys {
+				localEnv = append(localEnv, bootstrap.IstioMetaPrefix+k+"="+meta[k])
+			}
+
+			content, err := bootstrap.Render(bootstrap.Config{
+				Node:     node,
+				Proxy:    &proxyConfig,
+				LocalEnv: localEnv,
+				NodeIPs:  nodeIPs,
+			}, templateFile)
+			if err != nil {
+				return err
+			}
+			_, err = c.OutOrStdout().Write(content)
+			return err
+		},
+	}
+
+	renderCmd.PersistentFlags().StringVar(&node, "node", "sidecar~127.0.0.1~proxy.default~default.svc.cluster.local",
+		"Envoy node ID of the proxy")
+	renderCmd.PersistentFlags().StringVar(&templateFile, "template", "", "Path to the Envoy bootstrap template")
+	renderCmd.PersistentFlags().StringVar(&proxyConfigFile, "proxy-config", "",
+		"Path to a YAML ProxyConfig overriding the default proxy config")
+	renderCmd.PersistentFlags().StringSliceVar(&nodeIPs, "ip", []string{"127.0.0.1"}, "IP addresses of the proxy")
+	renderCmd.PersistentFlags().StringToStringVar(&meta, "meta", nil,
+		"Node metadata of the proxy, as KEY=VALUE pairs without the ISTIO_META_ prefix")
+	renderCmd.Long += "\n\n" + ExperimentalMsg
+	return renderCmd
+}
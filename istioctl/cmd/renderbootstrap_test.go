@@ -0,0 +1,48 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestRenderBootstrap(t *testing.T) {
+	template := "../../tools/packaging/common/envoy_bootstrap.json"
+	cases := []testCase{
+		{ // no template
+			args:           strings.Split("x render-bootstrap", " "),
+			expectedRegexp: regexp.MustCompile("--template is required"),
+			wantException:  true,
+		},
+		{ // missing template
+			args:          strings.Split("x render-bootstrap --template testdata/render-bootstrap/missing.json", " "),
+			wantException: true,
+		},
+		{ // rendered from node metadata
+			args: strings.Split("x render-bootstrap --template "+template+
+				" --node sidecar~10.0.0.1~productpage.default~default.svc.cluster.local --ip 10.0.0.1 --meta CLUSTER_ID=Kubernetes", " "),
+			expectedRegexp: regexp.MustCompile(`(?s)"id": "sidecar~10\.0\.0\.1~productpage\.default~default\.svc\.cluster\.local".*"name": "xds-grpc"`),
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("case %d %s", i, strings.Join(c.args, " ")), func(t *testing.T) {
+			verifyOutput(t, c)
+		})
+	}
+}
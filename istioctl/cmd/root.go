@@ -228,6 +228,8 @@ debug and diagnose their Istio mesh.
 	rootCmd.AddCommand(seeExperimentalCmd("authz"))
 	experimentalCmd.AddCommand(uninjectCommand())
 	experimentalCmd.AddCommand(metricsCmd)
+	experimentalCmd.AddCommand(proxyStatsCmd())
+	experimentalCmd.AddCommand(renderBootstrapCmd())
 	experimentalCmd.AddCommand(describe())
 	experimentalCmd.AddCommand(addToMeshCmd())
 	experimentalCmd.AddCommand(removeFromMeshCmd())
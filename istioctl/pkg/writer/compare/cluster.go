@@ -39,9 +39,9 @@ func (c *Comparator) ClusterDiff() error {
 		return err
 	}
 	diff := difflib.UnifiedDiff{
-		FromFile: "Istiod Clusters",
+		FromFile: c.istiodName + " Clusters",
 		A:        difflib.SplitLines(istiodBytes.String()),
-		ToFile:   "Envoy Clusters",
+		ToFile:   c.envoyName + " Clusters",
 		B:        difflib.SplitLines(envoyBytes.String()),
 		Context:  c.context,
 	}
@@ -50,6 +50,7 @@ func (c *Comparator) ClusterDiff() error {
 		return err
 	}
 	if text != "" {
+		c.differs = true
 		fmt.Fprintln(c.w, text)
 	} else {
 		fmt.Fprintln(c.w, "Clusters Match")
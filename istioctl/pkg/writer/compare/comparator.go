@@ -31,6 +31,10 @@ type Comparator struct {
 	w             io.Writer
 	context       int
 	location      string
+	// istiodName and envoyName label the two sides of the diff
+	istiodName, envoyName string
+	// differs is set once any of the diffs found a difference
+	differs bool
 }
 
 // NewComparator is a comparator constructor
@@ -57,6 +61,7 @@ func NewComparator(w io.Writer, istiodResponses map[string][]byte, envoyResponse
 	c.w = w
 	c.context = 7
 	c.location = "Local" // the time.Location for formatting time.Time instances
+	c.istiodName, c.envoyName = "Istiod", "Envoy"
 	return c, nil
 }
 
@@ -85,9 +90,37 @@ func NewXdsComparator(w io.Writer, istiodResponses map[string]*xdsapi.DiscoveryR
 	c.w = w
 	c.context = 7
 	c.location = "Local" // the time.Location for formatting time.Time instances
+	c.istiodName, c.envoyName = "Istiod", "Envoy"
 	return c, nil
 }
 
+// NewProxyComparator is a comparator constructor for diffing the config dumps of two Envoys, named
+// fromName and toName in the output. Volatile fields, such as versions and update times, are ignored.
+func NewProxyComparator(w io.Writer, fromName string, fromResponse []byte, toName string, toResponse []byte) (*Comparator, error) {
+	fromDump := &configdump.Wrapper{}
+	if err := json.Unmarshal(fromResponse, fromDump); err != nil {
+		return nil, fmt.Errorf("unable to parse config dump of %s: %v", fromName, err)
+	}
+	toDump := &configdump.Wrapper{}
+	if err := json.Unmarshal(toResponse, toDump); err != nil {
+		return nil, fmt.Errorf("unable to parse config dump of %s: %v", toName, err)
+	}
+	return &Comparator{
+		istiod:     fromDump,
+		envoy:      toDump,
+		w:          w,
+		context:    7,
+		location:   "Local", // the time.Location for formatting time.Time instances
+		istiodName: fromName,
+		envoyName:  toName,
+	}, nil
+}
+
+// Differs returns true if any diff written so far found a difference.
+func (c *Comparator) Differs() bool {
+	return c.differs
+}
+
 // Diff prints a diff between Istiod and Envoy to the passed writer
 func (c *Comparator) Diff() error {
 	if err := c.ClusterDiff(); err != nil {
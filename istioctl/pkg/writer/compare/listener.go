@@ -39,9 +39,9 @@ func (c *Comparator) ListenerDiff() error {
 		return err
 	}
 	diff := difflib.UnifiedDiff{
-		FromFile: "Istiod Listeners",
+		FromFile: c.istiodName + " Listeners",
 		A:        difflib.SplitLines(istiodBytes.String()),
-		ToFile:   "Envoy Listeners",
+		ToFile:   c.envoyName + " Listeners",
 		B:        difflib.SplitLines(envoyBytes.String()),
 		Context:  c.context,
 	}
@@ -50,6 +50,7 @@ func (c *Comparator) ListenerDiff() error {
 		return err
 	}
 	if text != "" {
+		c.differs = true
 		fmt.Fprintln(c.w, text)
 	} else {
 		fmt.Fprintln(c.w, "Listeners Match")
@@ -40,9 +40,9 @@ func (c *Comparator) RouteDiff() error {
 		return err
 	}
 	diff := difflib.UnifiedDiff{
-		FromFile: "Istiod Routes",
+		FromFile: c.istiodName + " Routes",
 		A:        difflib.SplitLines(istiodBytes.String()),
-		ToFile:   "Envoy Routes",
+		ToFile:   c.envoyName + " Routes",
 		B:        difflib.SplitLines(envoyBytes.String()),
 		Context:  c.context,
 	}
@@ -61,6 +61,7 @@ func (c *Comparator) RouteDiff() error {
 		lastUpdatedStr = fmt.Sprintf(" (RDS last loaded at %s)", lastUpdated.In(loc).Format(time.RFC1123))
 	}
 	if text != "" {
+		c.differs = true
 		fmt.Fprintf(c.w, "Routes Don't Match%s\n", lastUpdatedStr)
 		fmt.Fprintln(c.w, text)
 	} else {
@@ -35,7 +35,8 @@ type EndpointFilter struct {
 	Address string
 	Port    uint32
 	Cluster string
-	Status  string
+	// Status is a comma separated list of health statuses, e.g. "unhealthy,draining".
+	Status string
 }
 
 // ConfigWriter is a writer for processing responses from the Envoy Admin config_dump endpoint
@@ -102,13 +103,32 @@ func (e *EndpointFilter) Verify(host *adminapi.HostStatus, cluster string) bool
 	if e.Cluster != "" && !strings.EqualFold(cluster, e.Cluster) {
 		return false
 	}
-	status := retrieveEndpointStatus(host)
-	if e.Status != "" && !strings.EqualFold(core.HealthStatus_name[int32(status)], e.Status) {
+	if e.Status != "" && !e.matchesStatus(retrieveEndpointStatus(host)) {
 		return false
 	}
 	return true
 }
 
+func (e *EndpointFilter) matchesStatus(status core.HealthStatus) bool {
+	for _, s := range strings.Split(e.Status, ",") {
+		if strings.EqualFold(core.HealthStatus_name[int32(status)], strings.TrimSpace(s)) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateEndpointStatus returns an error if the comma separated list of statuses contains an
+// unknown health status.
+func ValidateEndpointStatus(statuses string) error {
+	for _, s := range strings.Split(statuses, ",") {
+		if _, f := core.HealthStatus_value[strings.ToUpper(strings.TrimSpace(s))]; !f {
+			return fmt.Errorf("unknown endpoint status %q, expected one of HEALTHY|UNHEALTHY|DRAINING|DEGRADED|TIMEOUT|UNKNOWN", s)
+		}
+	}
+	return nil
+}
+
 // PrintEndpointsSummary prints just the endpoints config summary to the ConfigWriter stdout
 func (c *ConfigWriter) PrintEndpointsSummary(filter EndpointFilter) error {
 	if c.clusters == nil {
@@ -130,6 +150,11 @@ func (c *ConfigWriter) PrintEndpointsSummary(filter EndpointFilter) error {
 		}
 	}
 
+	if len(clusterEndpoint) == 0 && filter.Status != "" {
+		fmt.Fprintf(c.Stdout, "No endpoints match status %s\n", filter.Status)
+		return nil
+	}
+
 	clusterEndpoint = retrieveSortedEndpointClusterSlice(clusterEndpoint)
 	fmt.Fprintln(w, "ENDPOINT\tSTATUS\tOUTLIER CHECK\tCLUSTER")
 	for _, ce := range clusterEndpoint {
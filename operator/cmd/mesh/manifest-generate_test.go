@@ -281,6 +281,38 @@ func TestManifestGenerateFlagsSetValues(t *testing.T) {
 	g.Expect(cm).Should(HavePathValueMatchRegex(PathValue{"data.values", `.*"includeIPRanges"\: "172\.30\.0\.0/16,172\.21\.0\.0/16".*`}))
 }
 
+func TestManifestGenerateNetworkPolicy(t *testing.T) {
+	g := NewWithT(t)
+	m, _, err := generateManifest("default", "", liveCharts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	objs, err := parseObjectSetFromManifest(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.Expect(objs.kind(name.NetworkPolicyStr).size()).Should(Equal(0))
+
+	m, _, err = generateManifest("default", "-s values.global.networkPolicy=true", liveCharts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	objs, err = parseObjectSetFromManifest(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	policies := objs.kind(name.NetworkPolicyStr)
+	g.Expect(policies.size()).Should(Equal(2))
+
+	istiod := policies.nameEquals("istiod")
+	g.Expect(istiod).Should(Not(BeNil()))
+	g.Expect(istiod.Unstructured()).Should(HavePathValueEqual(PathValue{"spec.podSelector.matchLabels.app", "istiod"}))
+
+	ingress := policies.nameEquals("istio-ingressgateway")
+	g.Expect(ingress).Should(Not(BeNil()))
+	g.Expect(ingress.Unstructured()).Should(HavePathValueEqual(PathValue{"spec.podSelector.matchLabels.istio", "ingressgateway"}))
+}
+
 func TestManifestGenerateFlags(t *testing.T) {
 	flagOutputDir := createTempDirOrFail(t, "flag-output")
 	flagOutputValuesDir := createTempDirOrFail(t, "flag-output-values")
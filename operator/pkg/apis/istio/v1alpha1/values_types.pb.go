@@ -1151,7 +1151,9 @@ type GlobalConfig struct {
 	// Controls whether one external istiod is enabled.
 	ExternalIstiod *protobuf.BoolValue `protobuf:"bytes,62,opt,name=externalIstiod,proto3" json:"externalIstiod,omitempty"`
 	// Controls whether one central istiod is enabled.
-	CentralIstiod        *protobuf.BoolValue `protobuf:"bytes,63,opt,name=centralIstiod,proto3" json:"centralIstiod,omitempty"`
+	CentralIstiod *protobuf.BoolValue `protobuf:"bytes,63,opt,name=centralIstiod,proto3" json:"centralIstiod,omitempty"`
+	// Controls whether default NetworkPolicies are generated for istiod and the gateways.
+	NetworkPolicy        *protobuf.BoolValue `protobuf:"bytes,64,opt,name=networkPolicy,proto3" json:"networkPolicy,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -1473,6 +1475,13 @@ func (m *GlobalConfig) GetCentralIstiod() *protobuf.BoolValue {
 	return nil
 }
 
+func (m *GlobalConfig) GetNetworkPolicy() *protobuf.BoolValue {
+	if m != nil {
+		return m.NetworkPolicy
+	}
+	return nil
+}
+
 // Configuration for Security Token Service (STS) server.
 //
 // See https://tools.ietf.org/html/draft-ietf-oauth-token-exchange-16
@@ -4259,295 +4268,293 @@ func init() {
 }
 
 var fileDescriptor_261260e22432516f = []byte{
-	// 4628 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3c, 0x39, 0x77, 0x1c, 0x47,
-	0x7a, 0x1c, 0xdc, 0xf3, 0xcd, 0x0c, 0x30, 0x28, 0x1c, 0x6c, 0x42, 0x10, 0x09, 0xf5, 0x4a, 0x5c,
-	0x88, 0xd4, 0x82, 0x14, 0x84, 0x25, 0x29, 0xae, 0xa4, 0x15, 0x4e, 0x09, 0x5a, 0x00, 0x1c, 0xf7,
-	0x80, 0xd4, 0xb1, 0xde, 0xa5, 0x1b, 0xdd, 0x85, 0x41, 0x09, 0x3d, 0x5d, 0xed, 0xee, 0x9a, 0x01,
-	0x46, 0x89, 0x9f, 0x23, 0x47, 0x76, 0xe0, 0x1f, 0x60, 0x07, 0x0e, 0x1c, 0x39, 0xf6, 0xf3, 0x3f,
-	0x70, 0xb8, 0xcf, 0xef, 0x39, 0xf7, 0x53, 0xb4, 0x0e, 0x1d, 0xf8, 0x6d, 0xe0, 0xc4, 0xaf, 0x8e,
-	0x3e, 0xa7, 0xe7, 0x00, 0x20, 0xd9, 0x7e, 0x8e, 0x30, 0xfd, 0x5d, 0x55, 0x5d, 0xf5, 0xd5, 0x77,
-	0xd5, 0xd7, 0x80, 0x07, 0xde, 0x79, 0xe3, 0x91, 0xe9, 0x91, 0xe0, 0x11, 0x09, 0x18, 0xa1, 0x8f,
-	0xda, 0xef, 0x9b, 0x8e, 0x77, 0x66, 0xbe, 0xff, 0xa8, 0x6d, 0x3a, 0x2d, 0x1c, 0xbc, 0x66, 0x1d,
-	0x0f, 0x07, 0x6b, 0x9e, 0x4f, 0x19, 0x45, 0x53, 0x21, 0x72, 0xe9, 0x6e, 0x83, 0xd2, 0x86, 0x83,
-	0x1f, 0x09, 0xf8, 0x49, 0xeb, 0xf4, 0x91, 0xdd, 0xf2, 0x4d, 0x46, 0xa8, 0x2b, 0x29, 0x97, 0x3e,
-	0x6d, 0x10, 0x76, 0xd6, 0x3a, 0x59, 0xb3, 0x68, 0xf3, 0x51, 0x83, 0x36, 0x68, 0x4c, 0x18, 0xfd,
-	0xc8, 0x4a, 0xb8, 0xf0, 0x4d, 0xcf, 0xc3, 0xbe, 0x1a, 0x6b, 0x69, 0x9e, 0xb3, 0x89, 0x9f, 0x42,
-	0x80, 0x84, 0xea, 0x06, 0xc0, 0xa6, 0x6f, 0x9d, 0x6d, 0x53, 0xf7, 0x94, 0x34, 0xd0, 0x3c, 0x8c,
-	0x9b, 0x4d, 0xfb, 0xc9, 0x86, 0x56, 0x58, 0x29, 0xac, 0x56, 0x0c, 0xf9, 0x80, 0x34, 0x98, 0xf4,
-	0x3c, 0xeb, 0xc9, 0x86, 0x83, 0xb5, 0x11, 0x01, 0x0f, 0x1f, 0x39, 0x7d, 0xf0, 0xc1, 0x87, 0x8f,
-	0x2f, 0xb5, 0x51, 0x49, 0x2f, 0x1e, 0xf4, 0x3f, 0x8c, 0x41, 0x71, 0xfb, 0x68, 0x5f, 0xc9, 0xdc,
-	0x80, 0x49, 0xec, 0x9a, 0x27, 0x0e, 0xb6, 0x85, 0xd4, 0xd2, 0xfa, 0xd2, 0x9a, 0x9c, 0xe9, 0x5a,
-	0x38, 0xd3, 0xb5, 0x2d, 0x4a, 0x9d, 0x57, 0x7c, 0x75, 0x8c, 0x90, 0x14, 0x55, 0x61, 0xf4, 0xac,
-	0x75, 0x22, 0xc6, 0x2b, 0x1a, 0xfc, 0x27, 0x7a, 0x17, 0x46, 0x99, 0xd9, 0x10, 0x23, 0x95, 0xd6,
-	0x6f, 0xaf, 0x85, 0x2b, 0xb7, 0x76, 0xdc, 0xf1, 0xf0, 0xbe, 0xcb, 0xb0, 0x7f, 0x6a, 0x5a, 0xd8,
-	0xe0, 0x34, 0x7c, 0x5a, 0xa4, 0x69, 0x36, 0xb0, 0x36, 0x26, 0xd8, 0xe5, 0x03, 0xba, 0x0b, 0xe0,
-	0xb5, 0x1c, 0xa7, 0x46, 0x1d, 0x62, 0x75, 0xb4, 0x71, 0x81, 0x4a, 0x40, 0xd0, 0x32, 0x14, 0x2d,
-	0x97, 0x6c, 0x11, 0x77, 0x87, 0xf8, 0xda, 0x84, 0x40, 0xc7, 0x00, 0xce, 0x6d, 0xb9, 0x84, 0xbf,
-	0x13, 0x47, 0x4f, 0x4a, 0xee, 0x18, 0x82, 0x56, 0x61, 0x46, 0x3d, 0xed, 0x11, 0x07, 0x1f, 0x99,
-	0x4d, 0xac, 0x4d, 0x09, 0xa2, 0x2c, 0x18, 0xbd, 0x07, 0xb3, 0xf8, 0xd2, 0x72, 0x5a, 0xb6, 0x78,
-	0x0c, 0x3c, 0xd3, 0xc2, 0x81, 0x56, 0x5c, 0x19, 0x5d, 0x2d, 0x1a, 0xdd, 0x08, 0x74, 0x00, 0xd3,
-	0x1e, 0xb5, 0x37, 0x5d, 0x97, 0x32, 0xa1, 0x0f, 0x81, 0x06, 0x62, 0x05, 0x56, 0xd2, 0x2b, 0x70,
-	0x68, 0x7a, 0x75, 0xe6, 0x13, 0xb7, 0x11, 0x2d, 0xc5, 0xd6, 0x88, 0x56, 0x30, 0x32, 0xbc, 0x68,
-	0x15, 0xaa, 0x5e, 0xe0, 0xbd, 0xb6, 0x9c, 0x56, 0xc0, 0xb0, 0xff, 0xda, 0xa7, 0x0e, 0xd6, 0x4a,
-	0x62, 0x9a, 0xd3, 0x5e, 0xe0, 0x6d, 0x4b, 0xb0, 0x41, 0x1d, 0x8c, 0x96, 0x60, 0xca, 0xa1, 0x8d,
-	0x03, 0xdc, 0xc6, 0x8e, 0x56, 0x16, 0x14, 0xd1, 0x33, 0x7a, 0x1f, 0x26, 0x7c, 0xec, 0x99, 0xc4,
-	0xd7, 0x2a, 0x62, 0x2e, 0x77, 0xe2, 0xb9, 0x6c, 0x1f, 0xed, 0x1b, 0x02, 0x25, 0x77, 0xdf, 0x50,
-	0x84, 0x5c, 0x0b, 0xac, 0x33, 0x93, 0xb8, 0xd8, 0xd6, 0xa6, 0x07, 0x6b, 0x81, 0x22, 0x45, 0x6b,
-	0x30, 0xce, 0x4c, 0xe2, 0x32, 0x6d, 0x46, 0xf0, 0x68, 0xa9, 0x71, 0x8e, 0x39, 0x46, 0x0d, 0x23,
-	0xc9, 0xf4, 0x3d, 0x98, 0x4e, 0x23, 0xae, 0xa7, 0x7d, 0xfa, 0x5f, 0x8d, 0xc2, 0x4c, 0xe6, 0x4d,
-	0xfe, 0xef, 0xe8, 0xf1, 0x32, 0x14, 0x1d, 0xf3, 0x04, 0x3b, 0x35, 0x6a, 0x07, 0x42, 0x8d, 0xa7,
-	0x8c, 0x18, 0x80, 0xee, 0x43, 0xd9, 0xf2, 0xb1, 0xc9, 0xf0, 0x6e, 0x1b, 0xbb, 0x2c, 0x90, 0x8a,
-	0x2c, 0x74, 0x21, 0x05, 0xe7, 0xfa, 0x6c, 0x63, 0x07, 0x33, 0x2c, 0xc4, 0x4c, 0x0a, 0x31, 0x09,
-	0x08, 0xd7, 0xd2, 0x13, 0x9f, 0x9e, 0x63, 0xb7, 0x46, 0xed, 0x03, 0x2e, 0xfd, 0x57, 0xb8, 0xa3,
-	0x34, 0xba, 0x1b, 0x81, 0x1e, 0xc3, 0x5c, 0x1a, 0x28, 0x96, 0x41, 0x2b, 0x0a, 0xfa, 0x3c, 0x14,
-	0x97, 0x4f, 0x5c, 0xc2, 0xb7, 0x89, 0x6f, 0x1d, 0xf6, 0xc5, 0x89, 0x01, 0x29, 0xbf, 0x0b, 0xa1,
-	0x7f, 0x05, 0x4b, 0xdb, 0xb5, 0x97, 0xc7, 0xa6, 0xdf, 0xc0, 0xec, 0x25, 0x23, 0x0e, 0xf9, 0x4e,
-	0x28, 0xb4, 0xda, 0x9a, 0xe7, 0xa0, 0x31, 0x81, 0xda, 0x6c, 0x63, 0xdf, 0x6c, 0xe0, 0x04, 0x85,
-	0xd8, 0xab, 0x71, 0xa3, 0x27, 0x5e, 0xff, 0xaf, 0x02, 0x14, 0x0d, 0x1c, 0xd0, 0x96, 0xcf, 0x4f,
-	0xdb, 0x53, 0x98, 0x70, 0x48, 0x93, 0xb0, 0x40, 0x2b, 0xac, 0x8c, 0xae, 0x96, 0xd6, 0xef, 0xc5,
-	0xfb, 0x13, 0x11, 0xad, 0x1d, 0x08, 0x8a, 0x5d, 0x97, 0xf9, 0x1d, 0x43, 0x91, 0xa3, 0x8f, 0x61,
-	0xca, 0xc7, 0x7f, 0xda, 0xc2, 0x01, 0x0b, 0xb4, 0x11, 0xc1, 0xfa, 0x56, 0x1e, 0xab, 0xa1, 0x68,
-	0x24, 0x73, 0xc4, 0xb2, 0xf4, 0x21, 0x94, 0x12, 0x52, 0xb9, 0xd6, 0x9c, 0xe3, 0x8e, 0x98, 0x7b,
-	0xd1, 0xe0, 0x3f, 0xb9, 0x2a, 0x08, 0xff, 0xa1, 0x34, 0x49, 0x3e, 0x3c, 0x1f, 0x79, 0x56, 0x58,
-	0xfa, 0x05, 0x54, 0x52, 0x52, 0xaf, 0xc2, 0xac, 0x7f, 0x05, 0x2b, 0x3b, 0xf8, 0xd4, 0x6c, 0x39,
-	0xac, 0x46, 0xed, 0x1d, 0x12, 0xf8, 0x2d, 0x8f, 0xaf, 0xca, 0x56, 0xcb, 0x6e, 0xe0, 0x9b, 0x1d,
-	0xa1, 0x2f, 0x61, 0x51, 0x49, 0x8e, 0xde, 0x5e, 0xc9, 0x4b, 0x2e, 0x95, 0x14, 0x98, 0xb7, 0x54,
-	0xe1, 0x3b, 0xa9, 0x03, 0x1e, 0xb1, 0xe8, 0xff, 0x51, 0x86, 0xb9, 0xdd, 0x86, 0x8f, 0x83, 0xe0,
-	0x33, 0x93, 0xe1, 0x0b, 0xb3, 0xa3, 0xc4, 0xee, 0x41, 0xd5, 0x6c, 0x31, 0x1a, 0x58, 0xa6, 0x83,
-	0x77, 0x87, 0x9e, 0x6f, 0x17, 0x0f, 0xd2, 0xa1, 0x1c, 0xc1, 0x0e, 0xcd, 0x4b, 0xe5, 0xf2, 0x52,
-	0xb0, 0x34, 0x0d, 0x71, 0x95, 0xfb, 0x4b, 0xc1, 0xd0, 0x73, 0x18, 0xb5, 0xbc, 0x96, 0x38, 0xa0,
-	0xa5, 0xf5, 0xb7, 0x13, 0x96, 0xab, 0xa7, 0x1e, 0x8b, 0x53, 0xca, 0x99, 0x92, 0x4b, 0x3e, 0x39,
-	0xbc, 0xad, 0x59, 0x87, 0x51, 0xec, 0xb6, 0xc5, 0x21, 0x1d, 0xc2, 0x3f, 0x18, 0x9c, 0x18, 0x6d,
-	0xc2, 0x84, 0xb0, 0x1d, 0xd2, 0x03, 0x95, 0xd6, 0xdf, 0x8d, 0xd9, 0x72, 0x16, 0x79, 0x4d, 0x1c,
-	0xe0, 0x48, 0xf5, 0xc5, 0x03, 0x42, 0x30, 0xe6, 0xf2, 0xc3, 0x7b, 0x47, 0x28, 0x97, 0xf8, 0x8d,
-	0x3e, 0x87, 0xb2, 0x4b, 0x6d, 0x5c, 0xc7, 0x0e, 0xb6, 0x18, 0xf5, 0xaf, 0xe4, 0xb3, 0x52, 0x9c,
-	0x39, 0xfe, 0xaf, 0x74, 0x03, 0xff, 0x47, 0x61, 0x59, 0x40, 0x18, 0xd9, 0x3c, 0x3d, 0xe5, 0x66,
-	0xa6, 0x23, 0xde, 0x28, 0x9a, 0x67, 0x59, 0xc8, 0xfe, 0x69, 0x5a, 0x76, 0xdd, 0x21, 0x16, 0x7e,
-	0x71, 0xda, 0x63, 0x88, 0xbe, 0x02, 0xd1, 0x05, 0xac, 0x64, 0xf0, 0xc7, 0xd8, 0x6f, 0xa6, 0x07,
-	0xad, 0x5c, 0x7d, 0xd0, 0x81, 0x42, 0xd1, 0x43, 0x18, 0xf7, 0xa8, 0xcf, 0x02, 0x6d, 0x5a, 0xec,
-	0xeb, 0x42, 0x2c, 0xbd, 0xc6, 0xc1, 0xa1, 0xdf, 0x14, 0x34, 0xe8, 0xe7, 0x50, 0xf4, 0xc3, 0x83,
-	0xa7, 0x7c, 0xed, 0x5c, 0xce, 0x99, 0x14, 0x43, 0xc7, 0x94, 0xe8, 0x23, 0xa8, 0x04, 0xd8, 0xf2,
-	0x31, 0x7b, 0x45, 0x9d, 0x56, 0x13, 0x07, 0x5a, 0x55, 0x8c, 0xb5, 0x18, 0xb3, 0xd6, 0x13, 0x68,
-	0x23, 0x4d, 0x8c, 0x6a, 0x80, 0x02, 0xec, 0xb7, 0x89, 0x85, 0x93, 0xbb, 0x3b, 0x3b, 0xa4, 0xf6,
-	0xe6, 0xf0, 0x72, 0x4d, 0xe4, 0xd1, 0xb5, 0x86, 0xa4, 0x26, 0xf2, 0xdf, 0xe8, 0x21, 0x8c, 0x7d,
-	0xd7, 0xf6, 0x5c, 0x6d, 0x2e, 0xeb, 0x6f, 0xbf, 0xc1, 0x3e, 0x7d, 0x55, 0x3b, 0x52, 0x0b, 0x21,
-	0x88, 0xd0, 0x21, 0x94, 0x18, 0x75, 0xb0, 0xaf, 0xe6, 0x32, 0x7f, 0xf5, 0x8d, 0x49, 0xf2, 0xa3,
-	0x03, 0x98, 0xf1, 0xa9, 0xe3, 0x10, 0xb7, 0x71, 0x68, 0x5e, 0xd6, 0x5b, 0x7e, 0x03, 0x6b, 0x0b,
-	0x42, 0xe4, 0xdd, 0x2e, 0xb7, 0xff, 0xc2, 0x97, 0xd2, 0xf6, 0xa8, 0x5f, 0xdb, 0x12, 0x92, 0xb2,
-	0xac, 0xe8, 0x2b, 0x58, 0x88, 0x41, 0x2f, 0x5d, 0xb3, 0x6d, 0x12, 0x87, 0x1f, 0x7c, 0x6d, 0x71,
-	0x68, 0x99, 0xf9, 0x02, 0xd0, 0x21, 0x54, 0x2c, 0xb1, 0x0c, 0xe1, 0x3e, 0xde, 0xbe, 0xd2, 0x8b,
-	0x1b, 0x69, 0x6e, 0xf4, 0x6b, 0x98, 0x37, 0x6d, 0x9b, 0xf0, 0x35, 0x30, 0x9d, 0xc8, 0x8f, 0x07,
-	0x9a, 0x76, 0x35, 0xa9, 0xb9, 0x42, 0xd0, 0x33, 0x28, 0xfa, 0x2d, 0x77, 0x33, 0x30, 0x28, 0x65,
-	0xda, 0xd2, 0x40, 0xe3, 0x18, 0x13, 0x0b, 0x1f, 0x1b, 0x9b, 0xaf, 0x2b, 0xb9, 0xc9, 0x7f, 0x2f,
-	0xc0, 0xb4, 0x32, 0x84, 0xa1, 0x17, 0x3b, 0x82, 0x39, 0x91, 0xdf, 0xbd, 0xc6, 0xc2, 0x4c, 0x36,
-	0x24, 0x56, 0x79, 0x9c, 0x37, 0xfb, 0x5a, 0x51, 0x03, 0x09, 0xce, 0xdd, 0x24, 0x63, 0xd2, 0xe4,
-	0x8f, 0x0c, 0x6f, 0xf2, 0xff, 0x08, 0xe6, 0xe5, 0x2c, 0x88, 0x9b, 0x9a, 0xc6, 0x58, 0x56, 0x25,
-	0xf6, 0xdd, 0x9c, 0x79, 0xc8, 0x37, 0xd8, 0x4f, 0xb1, 0xea, 0xbf, 0x9f, 0x85, 0xf2, 0x67, 0x0e,
-	0x3d, 0x11, 0xab, 0xce, 0xdf, 0x74, 0x15, 0xc6, 0x4c, 0xdf, 0x3a, 0x53, 0xaf, 0x36, 0x1f, 0xcb,
-	0x8c, 0x13, 0x47, 0x43, 0x50, 0xf0, 0x28, 0x50, 0x6a, 0x02, 0x5f, 0xef, 0x28, 0x87, 0xd1, 0xd6,
-	0x65, 0x14, 0x98, 0x83, 0xe2, 0x4e, 0x5b, 0xe9, 0x8e, 0xe9, 0x10, 0x5b, 0x46, 0x6c, 0xa3, 0x83,
-	0x9d, 0x76, 0x96, 0x07, 0x7d, 0x0e, 0xf7, 0x6c, 0x19, 0x6d, 0xc8, 0x09, 0xbd, 0x22, 0x01, 0x39,
-	0x21, 0x0e, 0x61, 0x9d, 0x3a, 0x66, 0x8c, 0xb8, 0x8d, 0x40, 0xdb, 0x10, 0x19, 0xd6, 0x20, 0x32,
-	0xf4, 0x0a, 0xe6, 0x14, 0xc9, 0x51, 0xd2, 0x81, 0x4d, 0x5c, 0xc1, 0xe9, 0xe4, 0x09, 0x40, 0x2e,
-	0x2c, 0xd9, 0x3d, 0x23, 0x2d, 0xe5, 0xe5, 0x1f, 0xc4, 0xe2, 0x07, 0x45, 0x65, 0x62, 0xa0, 0x3e,
-	0x12, 0x51, 0x0d, 0xaa, 0x76, 0x26, 0xfe, 0x12, 0xe1, 0x78, 0xea, 0x25, 0xf2, 0x23, 0x34, 0x21,
-	0xbb, 0x8b, 0x1b, 0xfd, 0x1a, 0x90, 0x82, 0x1d, 0x27, 0x6c, 0xe4, 0xd3, 0xab, 0xdb, 0xc8, 0x1c,
-	0x31, 0x61, 0x9e, 0x54, 0x8e, 0xf3, 0xa4, 0x55, 0x98, 0x11, 0xf9, 0x4e, 0x2d, 0xce, 0xd9, 0x2b,
-	0x32, 0xa1, 0xce, 0x80, 0xd1, 0x03, 0xa8, 0x46, 0x20, 0xe9, 0x70, 0x02, 0xed, 0x1d, 0xb1, 0xdb,
-	0x5d, 0x70, 0x74, 0x1f, 0xa6, 0x85, 0xd2, 0xc7, 0xda, 0x39, 0x2d, 0xd3, 0xdf, 0x34, 0x94, 0x9b,
-	0x19, 0x87, 0x36, 0x36, 0x83, 0x2f, 0x02, 0xea, 0x6a, 0x6f, 0x0f, 0x36, 0x33, 0x11, 0x31, 0x7a,
-	0x0a, 0x93, 0x0e, 0x6d, 0x34, 0x88, 0xdb, 0x50, 0xbe, 0x2c, 0x61, 0x0c, 0xe4, 0xb9, 0x3a, 0x90,
-	0x68, 0x75, 0x74, 0x42, 0x6a, 0xb4, 0x0d, 0x95, 0x26, 0x0e, 0xce, 0x76, 0x2f, 0x3d, 0xd3, 0x0d,
-	0xf8, 0x41, 0x40, 0x59, 0xf6, 0xc3, 0x24, 0x5a, 0xb1, 0xa7, 0x79, 0xd0, 0x22, 0x4c, 0x70, 0xc0,
-	0xfe, 0x8e, 0xf6, 0x73, 0xf1, 0x5e, 0xea, 0x09, 0xed, 0x40, 0x99, 0xff, 0x3a, 0xc2, 0xec, 0x82,
-	0xfa, 0xe7, 0x81, 0x72, 0x87, 0x83, 0xdd, 0x6c, 0x8a, 0x0b, 0x7d, 0x0a, 0xe5, 0x66, 0xcb, 0x61,
-	0x44, 0x15, 0x0a, 0x94, 0xe7, 0x59, 0x4e, 0xcc, 0x30, 0x81, 0x55, 0x13, 0x4c, 0x71, 0x20, 0x0d,
-	0x26, 0x5d, 0x29, 0x4d, 0xfb, 0xa9, 0x98, 0x60, 0xf8, 0x88, 0x9e, 0xc0, 0xa2, 0x47, 0xed, 0x9d,
-	0xa3, 0x7a, 0x1d, 0x73, 0x63, 0x92, 0xa8, 0x8d, 0x3c, 0x14, 0x7b, 0xd9, 0x03, 0x8b, 0x7e, 0x0b,
-	0xcb, 0xb4, 0x49, 0x58, 0x9d, 0xd8, 0xd8, 0x32, 0xfd, 0x7d, 0xf7, 0x5b, 0x71, 0xde, 0xe4, 0xe0,
-	0x87, 0xa6, 0xa7, 0xdd, 0x1f, 0xb8, 0x79, 0x7d, 0xf9, 0xd1, 0x27, 0x50, 0xa6, 0x6e, 0x5c, 0x91,
-	0x51, 0xbe, 0xb1, 0x9f, 0xbc, 0x14, 0x3d, 0x32, 0x60, 0x91, 0x7a, 0x5c, 0xcf, 0xa9, 0x7f, 0x68,
-	0xba, 0x66, 0x03, 0x7f, 0x89, 0x4f, 0xce, 0x28, 0x3d, 0x0f, 0xb4, 0x77, 0x07, 0x4a, 0xea, 0xc1,
-	0x89, 0x1e, 0xc3, 0xac, 0xe7, 0x13, 0xea, 0x13, 0xd6, 0xd9, 0x76, 0xcc, 0x20, 0x10, 0xc9, 0xf3,
-	0x1b, 0x51, 0xa6, 0xdf, 0x8d, 0x14, 0xe1, 0xa0, 0x4f, 0x2f, 0x3b, 0xda, 0xb2, 0x18, 0x34, 0x19,
-	0x0e, 0x72, 0x70, 0x14, 0x0e, 0xf2, 0x07, 0xf4, 0x14, 0x8a, 0xe2, 0xc7, 0xbe, 0x4b, 0x98, 0xf6,
-	0x66, 0xb6, 0xc4, 0x53, 0x0b, 0x51, 0x8a, 0x29, 0xa6, 0x45, 0xef, 0xc0, 0x68, 0x60, 0x07, 0xda,
-	0xdd, 0x6c, 0x04, 0x59, 0xdf, 0xa9, 0x2b, 0x62, 0x8e, 0x0f, 0x4b, 0x20, 0xf7, 0x86, 0x28, 0x81,
-	0xac, 0xc1, 0x04, 0xf3, 0x4d, 0x0b, 0xfb, 0xda, 0x5b, 0x82, 0x3a, 0x11, 0x5b, 0x1e, 0x0b, 0x78,
-	0x58, 0x67, 0x92, 0x54, 0x68, 0x05, 0x4a, 0xcc, 0x6f, 0x05, 0x6c, 0x87, 0x36, 0x4d, 0xe2, 0x6a,
-	0xba, 0xd0, 0xb1, 0x24, 0x08, 0xad, 0xc3, 0x44, 0x2b, 0xc0, 0x87, 0xdb, 0x35, 0xed, 0x27, 0x03,
-	0xd7, 0x5f, 0x51, 0xa2, 0x35, 0x40, 0x3e, 0x6e, 0x52, 0x86, 0x6b, 0xc4, 0xa1, 0x6c, 0xd3, 0xb6,
-	0xb9, 0xc3, 0xd4, 0x1e, 0x0b, 0xe1, 0x39, 0x18, 0x3e, 0x6b, 0x61, 0x4f, 0x6c, 0xed, 0x49, 0x76,
-	0xd6, 0xfb, 0x02, 0x1e, 0xce, 0x5a, 0x52, 0xa1, 0xf7, 0x60, 0xd6, 0xe3, 0xfc, 0xdb, 0xd8, 0x67,
-	0x35, 0x9f, 0xb6, 0x89, 0x8d, 0x7d, 0xed, 0x99, 0x2c, 0x86, 0x74, 0x21, 0xd0, 0x32, 0x14, 0xbf,
-	0xbd, 0x60, 0xca, 0x26, 0x7e, 0x28, 0x0b, 0x95, 0x11, 0x40, 0xec, 0x01, 0x0b, 0xb4, 0xe7, 0x5d,
-	0x7b, 0x70, 0x1c, 0xef, 0x01, 0x0b, 0xd0, 0x12, 0xcf, 0xc2, 0xdb, 0x44, 0x18, 0x9a, 0x5f, 0xc8,
-	0xfa, 0x5e, 0xf8, 0x8c, 0xb6, 0x60, 0xba, 0x49, 0x5b, 0x2e, 0x3b, 0x64, 0x4e, 0xc0, 0x47, 0x0e,
-	0xb4, 0x8f, 0x06, 0x2e, 0x55, 0x86, 0x43, 0x54, 0x53, 0xcd, 0x70, 0xa5, 0x3e, 0x56, 0xd5, 0xd4,
-	0x10, 0xc0, 0x47, 0xc0, 0x97, 0x0c, 0xfb, 0xae, 0xe9, 0xc8, 0x05, 0xd1, 0x3e, 0x19, 0x3c, 0x42,
-	0x9a, 0x03, 0x7d, 0x0a, 0x15, 0x0b, 0xbb, 0xcc, 0x8f, 0x44, 0xfc, 0x72, 0xa0, 0x88, 0x34, 0x83,
-	0xfe, 0x33, 0x28, 0x46, 0xab, 0xc2, 0x35, 0x47, 0xa5, 0x14, 0x3c, 0x41, 0x52, 0x15, 0xf0, 0x24,
-	0x48, 0x37, 0xa0, 0x9c, 0xdc, 0x3d, 0xf1, 0x12, 0x22, 0x0e, 0xdb, 0x74, 0x4d, 0xa7, 0x13, 0x90,
-	0x60, 0x88, 0xc8, 0x2d, 0xc3, 0xa1, 0x3f, 0x84, 0xb9, 0x1c, 0xa7, 0xc0, 0x43, 0x51, 0x47, 0x94,
-	0x5e, 0x65, 0x78, 0x2a, 0x1f, 0xf4, 0xbf, 0xab, 0xc2, 0x7c, 0x5e, 0x20, 0xf7, 0xff, 0xaa, 0xf6,
-	0xc1, 0xb7, 0xb5, 0x15, 0x30, 0xda, 0xac, 0xcb, 0xa5, 0x57, 0xa1, 0x57, 0xff, 0x6d, 0x4d, 0x32,
-	0x24, 0x43, 0x69, 0xb8, 0x72, 0xf5, 0xa4, 0x74, 0x95, 0xea, 0xc9, 0x56, 0x54, 0x3d, 0x99, 0x11,
-	0x99, 0xef, 0x83, 0xfe, 0x01, 0x77, 0x6e, 0xf9, 0xe4, 0x3e, 0x4c, 0x3b, 0xd4, 0xb4, 0xb7, 0x4c,
-	0xc7, 0x74, 0x2d, 0xec, 0xef, 0xd7, 0xb4, 0xaa, 0x8c, 0x48, 0xd2, 0x50, 0xf4, 0x1c, 0xb4, 0x24,
-	0xa4, 0x2e, 0xa2, 0x32, 0xc3, 0x74, 0x1b, 0x98, 0x27, 0xcd, 0xdc, 0x43, 0xf6, 0xc4, 0xa3, 0x5d,
-	0x40, 0xa9, 0x30, 0x41, 0x94, 0x00, 0x34, 0xd4, 0xaf, 0x32, 0x90, 0xc3, 0x10, 0x55, 0x7a, 0xde,
-	0xeb, 0x53, 0xe9, 0x99, 0xfb, 0x01, 0x2b, 0x3d, 0xf3, 0x3f, 0x62, 0xa5, 0x67, 0xe1, 0x7f, 0xa3,
-	0xd2, 0xb3, 0xf8, 0xa3, 0x56, 0x7a, 0x6e, 0x0f, 0x51, 0xe9, 0xb9, 0x0f, 0x65, 0x1f, 0x7b, 0x0e,
-	0xb1, 0xcc, 0x6d, 0x6e, 0xaf, 0x45, 0x4e, 0x5e, 0x91, 0x9b, 0x91, 0x84, 0xa3, 0xad, 0x64, 0x45,
-	0xe8, 0xce, 0x15, 0xf6, 0xa1, 0x5f, 0x79, 0xe8, 0x8d, 0x9b, 0x97, 0x87, 0x96, 0x7f, 0x80, 0xf2,
-	0xd0, 0x9b, 0x89, 0xf2, 0xd0, 0x13, 0x55, 0x1e, 0x92, 0x21, 0x8b, 0xde, 0xeb, 0xfc, 0x7e, 0xd3,
-	0xf6, 0xdc, 0x54, 0xa5, 0x28, 0xa7, 0xb4, 0x73, 0xef, 0x47, 0x28, 0xed, 0xac, 0xdc, 0xb4, 0xb4,
-	0xb3, 0x01, 0x0b, 0xa1, 0xdb, 0x3c, 0xf6, 0xcd, 0xd3, 0x53, 0x62, 0xa9, 0xb8, 0x41, 0x46, 0x46,
-	0xf9, 0xc8, 0x6c, 0x1d, 0xec, 0x27, 0x37, 0xac, 0x83, 0xfd, 0x0a, 0xca, 0xaa, 0x3e, 0x21, 0x0d,
-	0xcf, 0xdb, 0x57, 0x2b, 0x04, 0xa5, 0x98, 0x7b, 0x56, 0x97, 0xde, 0xf9, 0x21, 0xaa, 0x4b, 0x5d,
-	0x95, 0xb0, 0xfb, 0x37, 0xaa, 0x84, 0xa5, 0x8a, 0x55, 0x3f, 0xfb, 0x1f, 0x2a, 0x56, 0x9d, 0x81,
-	0xd6, 0x4b, 0x79, 0xaf, 0x79, 0x89, 0xb9, 0x08, 0x13, 0x41, 0xeb, 0xf4, 0x94, 0x5c, 0xaa, 0xc1,
-	0xd4, 0x93, 0xfe, 0x67, 0x30, 0x97, 0x93, 0x92, 0x5e, 0x73, 0x10, 0x19, 0x97, 0xef, 0x1f, 0x6c,
-	0x0d, 0x11, 0x45, 0x29, 0x4a, 0xfd, 0xf7, 0x05, 0x40, 0xdd, 0x29, 0xe7, 0x35, 0x27, 0xb0, 0x02,
-	0x25, 0x75, 0x2f, 0x2e, 0xd2, 0x29, 0xf9, 0xaa, 0x49, 0x10, 0x4f, 0x03, 0x1a, 0x22, 0x58, 0x93,
-	0xa9, 0x44, 0x5d, 0xae, 0xc9, 0xa8, 0x4c, 0x03, 0xba, 0x31, 0xe8, 0x0b, 0x40, 0xc4, 0x15, 0x17,
-	0xfa, 0xbb, 0x6e, 0x9b, 0x76, 0xf6, 0x88, 0xc3, 0x93, 0xe6, 0xb1, 0x81, 0x53, 0xca, 0xe1, 0xd2,
-	0xff, 0xa2, 0x00, 0x6f, 0xbc, 0x68, 0xb1, 0x13, 0xda, 0x72, 0xed, 0xd4, 0x61, 0x55, 0xef, 0xfc,
-	0x09, 0x8c, 0x35, 0xa9, 0x2d, 0xa7, 0x3d, 0x9d, 0x0c, 0x44, 0xfa, 0x30, 0xad, 0x1d, 0x52, 0x1b,
-	0x1b, 0x82, 0x4f, 0x5f, 0x85, 0x31, 0xfe, 0x84, 0x2a, 0x50, 0xdc, 0x3c, 0x38, 0x78, 0xf1, 0xe5,
-	0xeb, 0xcd, 0xa3, 0xaf, 0xab, 0xb7, 0xd0, 0x2c, 0x54, 0x8c, 0xdd, 0xcf, 0xf6, 0xeb, 0xc7, 0xc6,
-	0xd7, 0xaf, 0x5f, 0x1c, 0x1d, 0x7c, 0x5d, 0x2d, 0xe8, 0x7f, 0x28, 0x43, 0x49, 0x64, 0x3b, 0x37,
-	0x5a, 0xed, 0xbc, 0x90, 0x75, 0xe4, 0xa6, 0x21, 0x6b, 0x8f, 0x70, 0x34, 0x1b, 0xd6, 0x8e, 0xe5,
-	0x84, 0xb5, 0x59, 0xc7, 0x38, 0xde, 0xc3, 0x31, 0x46, 0x77, 0xf2, 0x13, 0xc9, 0x3b, 0xf9, 0xb7,
-	0xa1, 0x22, 0x12, 0xd0, 0xba, 0xd9, 0xf4, 0xb8, 0x15, 0x16, 0x97, 0x70, 0x05, 0x23, 0x0d, 0x4c,
-	0x5f, 0xb3, 0x14, 0x87, 0xbe, 0x66, 0x59, 0x85, 0x19, 0x69, 0x56, 0xe2, 0x22, 0x04, 0xa8, 0xd6,
-	0x92, 0x34, 0x38, 0x8c, 0xbb, 0x4b, 0xd7, 0x89, 0xbb, 0xb3, 0x81, 0x5c, 0xf9, 0xda, 0x81, 0x9c,
-	0x05, 0xf7, 0xce, 0x31, 0xf6, 0x4c, 0x87, 0xb4, 0xf9, 0xd2, 0xf2, 0xb0, 0x5c, 0x1c, 0x4d, 0x17,
-	0x5b, 0x7c, 0xe0, 0xcd, 0x06, 0x8e, 0xfa, 0x46, 0xb2, 0x3b, 0xbd, 0xa3, 0xba, 0x9e, 0x8c, 0x41,
-	0x12, 0xd0, 0x01, 0x54, 0x6d, 0xec, 0x39, 0xb4, 0xd3, 0xc4, 0x2e, 0x93, 0xa6, 0x52, 0x75, 0x96,
-	0x0c, 0x0e, 0x0e, 0xba, 0x38, 0xb9, 0xa1, 0xb6, 0xa2, 0x8a, 0x11, 0x1a, 0x6c, 0xa8, 0x23, 0xe2,
-	0x44, 0x39, 0x61, 0x7e, 0xe8, 0x72, 0x82, 0x4a, 0x35, 0x16, 0xae, 0x92, 0x6a, 0xe4, 0x04, 0x1c,
-	0xda, 0x8f, 0x10, 0x70, 0xdc, 0xb9, 0xf9, 0x5d, 0x52, 0x2a, 0x74, 0x58, 0xba, 0x61, 0xe8, 0x70,
-	0x06, 0x6f, 0x49, 0x8b, 0x51, 0xe3, 0xcb, 0x69, 0x51, 0xa7, 0xee, 0x12, 0x1e, 0x09, 0xf3, 0x89,
-	0x84, 0x96, 0x4d, 0x05, 0x85, 0xfd, 0x56, 0x7e, 0xb0, 0x10, 0x74, 0x0a, 0x2b, 0x3d, 0x89, 0xf6,
-	0x5d, 0x39, 0xd0, 0x9b, 0x03, 0x07, 0x1a, 0x28, 0x23, 0x27, 0xcd, 0xb9, 0x7b, 0x83, 0x34, 0xe7,
-	0x97, 0x50, 0x96, 0xba, 0x28, 0xf3, 0x3d, 0x15, 0x84, 0xbe, 0x91, 0xc8, 0x01, 0x62, 0x4b, 0xad,
-	0x52, 0xc2, 0x14, 0x03, 0x7a, 0x06, 0xb7, 0xbf, 0xbd, 0x38, 0x0f, 0xb8, 0xf1, 0x71, 0xda, 0xd8,
-	0xdf, 0xbd, 0x64, 0xbe, 0xc9, 0x23, 0x90, 0xed, 0x4d, 0x11, 0x7c, 0x16, 0x8d, 0x5e, 0x68, 0xf4,
-	0x01, 0x4c, 0x7a, 0x4e, 0xab, 0x41, 0xdc, 0x40, 0xd5, 0xe6, 0xee, 0xe4, 0xec, 0xb2, 0x7c, 0x07,
-	0x23, 0xa4, 0x0c, 0xeb, 0xfc, 0x7a, 0x57, 0x3f, 0xd4, 0x4f, 0x06, 0x17, 0x03, 0xf5, 0x7f, 0x2c,
-	0x00, 0x12, 0xef, 0xa3, 0xe2, 0x1b, 0xe5, 0x80, 0xee, 0xc3, 0xb4, 0x8a, 0x10, 0xc3, 0x92, 0x41,
-	0x41, 0xd5, 0xf4, 0x53, 0x50, 0xf4, 0x12, 0x16, 0x48, 0xc4, 0xc8, 0xb8, 0xfa, 0x62, 0xff, 0x30,
-	0xf6, 0x99, 0x89, 0x5e, 0x9f, 0x5c, 0x32, 0x23, 0x9f, 0x9b, 0x7b, 0x97, 0x10, 0xe1, 0x98, 0x41,
-	0xa0, 0xe2, 0x81, 0x14, 0x4c, 0xdf, 0x87, 0x59, 0x31, 0xf1, 0x94, 0xcb, 0xbe, 0x5e, 0x63, 0x0d,
-	0x83, 0x99, 0x63, 0xec, 0xe0, 0x26, 0x66, 0xfe, 0x8d, 0x04, 0xa1, 0x87, 0x30, 0xd2, 0x5e, 0x57,
-	0xb7, 0x6d, 0x09, 0x85, 0x89, 0x84, 0xbf, 0x5a, 0x57, 0x19, 0xcf, 0x48, 0x7b, 0x5d, 0xff, 0xeb,
-	0x51, 0x98, 0xed, 0xc2, 0x5c, 0x73, 0xe0, 0xaf, 0x60, 0xb6, 0x89, 0x99, 0x69, 0x9b, 0xcc, 0x7c,
-	0x8d, 0x2f, 0xad, 0x33, 0xd3, 0x55, 0x2d, 0x6e, 0xa5, 0xf5, 0x87, 0xb9, 0xf3, 0x38, 0x54, 0xd4,
-	0xbb, 0x8a, 0x58, 0xcd, 0xab, 0xda, 0xcc, 0xc0, 0xd1, 0x2e, 0x80, 0xe7, 0xd3, 0x26, 0x66, 0x67,
-	0xb8, 0x15, 0x56, 0xe3, 0xde, 0xc9, 0x15, 0x59, 0x8b, 0xc8, 0x94, 0xb0, 0x04, 0x23, 0xfa, 0x1c,
-	0x4a, 0x01, 0x33, 0xad, 0x73, 0xdb, 0x27, 0x6d, 0xec, 0xab, 0x25, 0xba, 0x9f, 0x2b, 0xa7, 0xce,
-	0xe9, 0x76, 0x04, 0x9d, 0x12, 0x94, 0x64, 0x45, 0x7f, 0x0c, 0xb3, 0xa6, 0x65, 0xe1, 0x20, 0x78,
-	0xed, 0xd0, 0xc6, 0x6b, 0x2f, 0x6e, 0x3d, 0x2d, 0xad, 0x3f, 0xce, 0x95, 0xb7, 0x29, 0xa8, 0x0f,
-	0x68, 0x43, 0x6a, 0x8a, 0x0c, 0xfe, 0x94, 0xe4, 0x19, 0x33, 0x8d, 0xd4, 0x4d, 0x78, 0x6b, 0xe0,
-	0x2a, 0xa1, 0x8f, 0xa0, 0x74, 0x61, 0x06, 0xcd, 0xe1, 0x63, 0xac, 0x24, 0xb9, 0xfe, 0xaf, 0xa3,
-	0xf0, 0x46, 0x9f, 0x65, 0xbb, 0xa6, 0x06, 0xdc, 0x68, 0x4e, 0xe8, 0x37, 0x61, 0x3c, 0xf4, 0x9a,
-	0xb6, 0xb1, 0xef, 0x13, 0x1b, 0xab, 0x2d, 0xda, 0x18, 0x6a, 0xab, 0xd7, 0xe4, 0x9f, 0x17, 0x8a,
-	0xd7, 0x98, 0xb6, 0x52, 0xcf, 0x4b, 0xdf, 0x17, 0x60, 0x3a, 0x4d, 0x82, 0x9e, 0xc3, 0x64, 0xfa,
-	0x82, 0x7f, 0xb0, 0xd3, 0x0e, 0x19, 0xd0, 0xe7, 0xdc, 0x3a, 0x09, 0xd3, 0xaf, 0xae, 0x98, 0xd4,
-	0xeb, 0x0e, 0x16, 0x91, 0xe1, 0x43, 0x5f, 0xc0, 0x0c, 0x55, 0xde, 0x2a, 0x14, 0x35, 0x3a, 0xa4,
-	0xa8, 0x2c, 0xa3, 0xfe, 0x37, 0xe3, 0xb0, 0xdc, 0x4f, 0x8d, 0xaf, 0xb9, 0xb1, 0xcf, 0xe2, 0xcb,
-	0xcf, 0x81, 0x9b, 0x2a, 0xfc, 0x59, 0x74, 0xfb, 0xf9, 0x1c, 0xa0, 0x49, 0x5d, 0xc2, 0x28, 0x9f,
-	0xf8, 0x10, 0x3d, 0x00, 0x09, 0x6a, 0xf4, 0x04, 0xa6, 0x18, 0xf5, 0xa8, 0x43, 0x1b, 0x9d, 0x21,
-	0xb2, 0xab, 0x88, 0x16, 0xed, 0xc0, 0x8c, 0x4d, 0x02, 0x3e, 0xf3, 0x28, 0x94, 0x18, 0x5c, 0x6c,
-	0xce, 0xb2, 0xf0, 0x0d, 0x4e, 0x6b, 0x90, 0x3a, 0xe0, 0x43, 0x6c, 0x70, 0x9a, 0x0f, 0x7d, 0x0b,
-	0x0b, 0xe1, 0x3e, 0x45, 0x76, 0x40, 0xac, 0xe5, 0xa4, 0x70, 0x50, 0x1b, 0xc3, 0x59, 0xa0, 0xb5,
-	0x14, 0xaf, 0x91, 0x2f, 0x12, 0x9d, 0xc1, 0xbc, 0x52, 0xaf, 0xf4, 0x50, 0x53, 0x37, 0x18, 0x2a,
-	0x57, 0xa2, 0xbe, 0x01, 0x95, 0xf4, 0xd0, 0x53, 0x30, 0x76, 0xf4, 0xe2, 0x68, 0xb7, 0x7a, 0x8b,
-	0xff, 0xda, 0x7b, 0x79, 0x70, 0x50, 0x2d, 0xa0, 0x19, 0x28, 0xed, 0x1a, 0xc6, 0x0b, 0xa3, 0x2e,
-	0xb3, 0xcc, 0x11, 0xfd, 0xef, 0x0b, 0x70, 0x7f, 0x38, 0xbb, 0x78, 0x4d, 0x55, 0xfd, 0x0c, 0x66,
-	0x1d, 0xda, 0xf8, 0x92, 0xb8, 0x36, 0xbd, 0x08, 0xd3, 0x0e, 0xa5, 0xb4, 0x7d, 0xf2, 0x92, 0x6e,
-	0x1e, 0x7d, 0x57, 0xf9, 0xf6, 0x64, 0x90, 0x85, 0x1e, 0xc3, 0x5c, 0xd0, 0x3a, 0x09, 0x2c, 0x9f,
-	0x9c, 0x60, 0x3b, 0xee, 0xc0, 0x28, 0x88, 0x42, 0x7d, 0x1e, 0x4a, 0xff, 0xcb, 0x02, 0x94, 0x12,
-	0x05, 0xdb, 0xa8, 0xd8, 0x5e, 0x48, 0x14, 0xdb, 0x11, 0x8c, 0x79, 0xd4, 0x67, 0x62, 0x9a, 0xe3,
-	0x86, 0xf8, 0x8d, 0x96, 0x60, 0x8a, 0x67, 0x5f, 0xe2, 0xd2, 0x6a, 0x54, 0xc0, 0xa3, 0x67, 0x74,
-	0x17, 0x40, 0x36, 0x3e, 0x0b, 0xec, 0x98, 0xc0, 0x26, 0x20, 0x9c, 0xd7, 0x53, 0x91, 0xaa, 0xfa,
-	0x20, 0x22, 0x7a, 0xd6, 0xff, 0x65, 0x12, 0x4a, 0x89, 0xbb, 0x61, 0x2e, 0x8b, 0x27, 0xcc, 0xf2,
-	0x82, 0x5c, 0x75, 0xa4, 0x27, 0x20, 0x3c, 0x05, 0x56, 0xb5, 0x12, 0x75, 0xf7, 0x2a, 0x05, 0xa6,
-	0x81, 0xe8, 0x3d, 0x98, 0xb5, 0x68, 0xd3, 0xa3, 0x2e, 0xcf, 0xbd, 0xc2, 0xef, 0x0b, 0x64, 0x2a,
-	0xdd, 0x8d, 0x88, 0x6f, 0xd8, 0xb6, 0xa9, 0x8f, 0x77, 0x5a, 0x4d, 0x4f, 0x65, 0xcd, 0x43, 0xdc,
-	0xb0, 0x85, 0x1c, 0x7c, 0x27, 0xd4, 0x57, 0x15, 0x2a, 0x02, 0x97, 0x35, 0x48, 0xd9, 0x69, 0x92,
-	0x87, 0xe2, 0xf9, 0x76, 0x08, 0xae, 0xa9, 0x0b, 0x16, 0xd5, 0x79, 0x92, 0x01, 0xc7, 0xc5, 0x80,
-	0xe9, 0x64, 0x31, 0x60, 0x15, 0x66, 0x54, 0x01, 0x27, 0xe2, 0xaf, 0xaa, 0xce, 0x95, 0x34, 0x38,
-	0xf5, 0x91, 0x05, 0xca, 0x7c, 0x64, 0xf1, 0x9c, 0xc7, 0x32, 0xa4, 0x4d, 0x1c, 0xdc, 0xc0, 0xb6,
-	0xba, 0x56, 0xe9, 0x6b, 0x10, 0x63, 0x6a, 0xb4, 0x05, 0xcb, 0x3e, 0x36, 0x6d, 0xe2, 0xe2, 0x20,
-	0xd8, 0x77, 0x09, 0x23, 0xa6, 0xb3, 0x83, 0x1d, 0xb3, 0x53, 0xc7, 0x16, 0x75, 0x6d, 0x79, 0xb1,
-	0x52, 0x31, 0xfa, 0xd2, 0xa0, 0x27, 0xb0, 0x18, 0xe1, 0x6b, 0xd8, 0x27, 0xd4, 0x0e, 0xb9, 0x17,
-	0x04, 0x77, 0x0f, 0x2c, 0xfa, 0x08, 0xee, 0x44, 0x98, 0x3d, 0x93, 0x38, 0x2d, 0x1f, 0x1f, 0x9f,
-	0xf9, 0x38, 0x38, 0xa3, 0x8e, 0x2d, 0x2e, 0x40, 0x2a, 0x46, 0x6f, 0x02, 0xae, 0x65, 0x01, 0x33,
-	0x59, 0x4b, 0x14, 0x7b, 0x45, 0xaf, 0x45, 0xc5, 0x48, 0x40, 0xd2, 0x25, 0x14, 0xed, 0x0a, 0x25,
-	0x94, 0xb0, 0x8d, 0xe0, 0x8e, 0xb0, 0x6f, 0xd5, 0x98, 0x47, 0xc2, 0xa3, 0x06, 0x82, 0x75, 0x98,
-	0x57, 0xbb, 0x1c, 0x1a, 0x78, 0xa9, 0x2f, 0xcb, 0x62, 0x7b, 0x72, 0x71, 0xe8, 0x13, 0x28, 0x3a,
-	0xe4, 0x14, 0x5b, 0x1d, 0xcb, 0xc1, 0xaa, 0xb8, 0x3d, 0xd8, 0xf8, 0xc7, 0x2c, 0xe8, 0x0c, 0xee,
-	0xf1, 0x97, 0xdf, 0xf4, 0x44, 0x9d, 0x89, 0x1b, 0x95, 0x97, 0x2e, 0x23, 0x8e, 0x38, 0x7d, 0x75,
-	0x66, 0xfa, 0x2c, 0xac, 0x6e, 0x0f, 0xf2, 0xa6, 0x83, 0xc4, 0xe8, 0xbf, 0x85, 0x99, 0x4c, 0xfb,
-	0x46, 0xac, 0xc3, 0x85, 0xa4, 0x0e, 0xa7, 0xd6, 0x79, 0x7c, 0xd8, 0x75, 0xd6, 0xb7, 0xe1, 0x76,
-	0x8f, 0x0e, 0x7e, 0x9e, 0xf9, 0x59, 0x5e, 0x2b, 0x2c, 0x61, 0x5b, 0x5e, 0x4b, 0xf6, 0x2a, 0x35,
-	0xa9, 0xdf, 0x09, 0xcb, 0xca, 0xf2, 0x49, 0xff, 0x0c, 0x8a, 0x51, 0xc3, 0x08, 0x7a, 0x0e, 0xe3,
-	0x8c, 0x9e, 0x63, 0x77, 0x58, 0xa7, 0x2a, 0x66, 0x24, 0x59, 0xf4, 0x3f, 0x81, 0x72, 0xf2, 0x86,
-	0x09, 0x2d, 0x43, 0x51, 0x74, 0x29, 0xd4, 0x4c, 0x76, 0xa6, 0x26, 0x12, 0x03, 0x22, 0x83, 0x3b,
-	0x92, 0x30, 0xb8, 0x5c, 0x1d, 0x85, 0x04, 0x51, 0x12, 0x96, 0x99, 0x5d, 0x02, 0xa2, 0xff, 0x6d,
-	0x01, 0x2a, 0x2a, 0xbd, 0x8c, 0x9a, 0x02, 0x4a, 0x66, 0x22, 0xb7, 0x1f, 0x36, 0x5c, 0x4c, 0x32,
-	0xf1, 0x8c, 0x32, 0xbc, 0x97, 0xa9, 0x85, 0xe6, 0xbe, 0x62, 0xa4, 0x60, 0xd1, 0x6c, 0x47, 0xd3,
-	0xee, 0x21, 0xdb, 0xff, 0xac, 0xff, 0xd3, 0x18, 0x2c, 0xe4, 0xf6, 0x36, 0xa1, 0xaf, 0xe0, 0x8e,
-	0x34, 0x95, 0x71, 0x33, 0xd5, 0x56, 0x47, 0x75, 0x04, 0x0e, 0x11, 0x92, 0xf7, 0x66, 0x46, 0x5f,
-	0xc3, 0x9c, 0x8b, 0xdb, 0x58, 0x0d, 0x18, 0x55, 0x14, 0x4b, 0x57, 0xbb, 0x4b, 0xc9, 0x93, 0x21,
-	0x6e, 0x7f, 0x9c, 0x0b, 0xb3, 0x13, 0x64, 0x64, 0x97, 0xaf, 0x7a, 0xfb, 0x93, 0x23, 0x04, 0x1d,
-	0xc0, 0x9c, 0x8f, 0x2f, 0x7c, 0xc2, 0xf0, 0xa6, 0xe7, 0x7d, 0x7e, 0x7c, 0x5c, 0xab, 0xf9, 0xf4,
-	0x04, 0x0b, 0xe3, 0xdd, 0x7f, 0x2d, 0xf2, 0xd8, 0x90, 0x01, 0x73, 0x44, 0xc8, 0xc7, 0xa9, 0x6a,
-	0xcf, 0xb0, 0x9d, 0x77, 0x79, 0xcc, 0x3c, 0xd6, 0xa4, 0x27, 0xa9, 0x17, 0x1f, 0xb6, 0x88, 0x98,
-	0xe1, 0x93, 0x55, 0x8b, 0x6f, 0x65, 0x3d, 0xf5, 0xa5, 0x71, 0x20, 0x2c, 0xb3, 0xa8, 0x5a, 0xc4,
-	0x30, 0xfd, 0xcf, 0x47, 0xa0, 0x9c, 0xec, 0xb2, 0x42, 0x4f, 0x61, 0x92, 0x67, 0x98, 0x36, 0x6d,
-	0x74, 0x37, 0x3a, 0x4b, 0xc2, 0x1d, 0x89, 0x0e, 0x7b, 0x1b, 0x15, 0x35, 0xfa, 0x98, 0x5b, 0xc8,
-	0xc6, 0x19, 0x0b, 0x18, 0xf6, 0x94, 0x6e, 0xdd, 0xcb, 0xb2, 0x1e, 0x70, 0x82, 0x3a, 0xc3, 0x5e,
-	0xd8, 0x57, 0x16, 0x71, 0xa0, 0x0d, 0x98, 0xf8, 0x8e, 0x78, 0xe7, 0x24, 0x6c, 0x0e, 0x5e, 0xce,
-	0xf2, 0x7e, 0x23, 0xb0, 0x61, 0x57, 0x95, 0xa4, 0x45, 0xdb, 0xe9, 0x34, 0x7e, 0x2c, 0xfb, 0xad,
-	0x91, 0x64, 0xad, 0xc7, 0x24, 0x39, 0x19, 0xbc, 0xfe, 0x08, 0xe6, 0x72, 0xde, 0x0c, 0x69, 0x30,
-	0x69, 0xaa, 0xe6, 0x26, 0x69, 0x48, 0xc2, 0x47, 0xbd, 0x0e, 0x0b, 0xb9, 0xef, 0xd3, 0x9b, 0x05,
-	0xad, 0x40, 0x49, 0xa6, 0xf6, 0xc7, 0xc2, 0xd2, 0xa9, 0x9b, 0xa7, 0x04, 0x48, 0x5f, 0x03, 0xd4,
-	0xfd, 0xa2, 0x7d, 0x26, 0xf1, 0x9f, 0x05, 0xb8, 0xdd, 0xe3, 0xf5, 0xd0, 0x63, 0x18, 0xb7, 0xf1,
-	0x49, 0xab, 0x31, 0x44, 0xb0, 0x2c, 0x09, 0xd1, 0x06, 0x2c, 0x34, 0xcd, 0xcb, 0xa3, 0x56, 0xf3,
-	0x04, 0xfb, 0x2f, 0x4e, 0x37, 0x19, 0xf3, 0xc9, 0x49, 0x8b, 0xe1, 0x40, 0x19, 0xa6, 0x7c, 0x24,
-	0x0f, 0x20, 0x92, 0x88, 0xc4, 0x11, 0x90, 0x77, 0x34, 0x3d, 0xb0, 0xe8, 0x39, 0x68, 0x09, 0xcc,
-	0x21, 0x0e, 0x02, 0xb3, 0x11, 0x7e, 0xcd, 0x28, 0x6f, 0x6e, 0x7a, 0xe2, 0xf5, 0xdf, 0x15, 0x00,
-	0xb6, 0xcc, 0x20, 0x34, 0xc6, 0x5f, 0x00, 0x52, 0xd1, 0xa0, 0xb1, 0x73, 0x8c, 0x9b, 0x9e, 0x63,
-	0x32, 0x1c, 0x0c, 0xf1, 0xde, 0x39, 0x5c, 0x3c, 0xbe, 0x6d, 0x47, 0x0d, 0xe7, 0xfc, 0xc4, 0xc8,
-	0x6d, 0x4a, 0x03, 0x51, 0x0d, 0x16, 0x24, 0xaf, 0xe8, 0x14, 0x93, 0xd3, 0xd8, 0x36, 0x76, 0x82,
-	0x21, 0x32, 0xda, 0x7c, 0x46, 0xfd, 0x19, 0x20, 0xd9, 0x75, 0x66, 0x88, 0x3e, 0x43, 0xf5, 0x66,
-	0xd9, 0xe3, 0x5b, 0xc8, 0x39, 0xbe, 0xff, 0x30, 0x0e, 0x13, 0x42, 0x74, 0x80, 0xde, 0x81, 0x51,
-	0xcb, 0x25, 0xea, 0xe4, 0xcd, 0xa5, 0x3e, 0xa3, 0x0d, 0x9b, 0x02, 0x2d, 0x97, 0xa0, 0x0d, 0x98,
-	0x52, 0x65, 0x8b, 0xd0, 0xe9, 0x27, 0x3e, 0xb9, 0x4d, 0x7f, 0x00, 0x61, 0x44, 0x94, 0x68, 0x0d,
-	0x26, 0xe4, 0xe5, 0xa7, 0xca, 0x9e, 0x17, 0xb3, 0x0d, 0xcf, 0xe1, 0xb9, 0x94, 0x54, 0xa2, 0x61,
-	0x85, 0x27, 0x4c, 0xaa, 0x3b, 0x6b, 0x21, 0xb7, 0x58, 0x6d, 0x48, 0x1a, 0xf4, 0x14, 0x8a, 0x2c,
-	0x4c, 0x03, 0x55, 0xef, 0xed, 0x9d, 0x9c, 0xe4, 0x34, 0xb4, 0x19, 0x11, 0x2d, 0xfa, 0x12, 0x16,
-	0x83, 0xb4, 0xdf, 0x53, 0xed, 0xb3, 0xea, 0xf2, 0x29, 0x61, 0x7f, 0x72, 0xfd, 0xa3, 0xd1, 0x83,
-	0x5d, 0x7c, 0xb3, 0xa0, 0x3e, 0x94, 0x8e, 0x22, 0xa4, 0xd9, 0x21, 0xbe, 0x59, 0xc8, 0xf0, 0xa0,
-	0xc7, 0x50, 0x94, 0xdf, 0x6e, 0xf0, 0x9d, 0x99, 0xeb, 0xbd, 0x33, 0x53, 0x82, 0x6a, 0xdb, 0x25,
-	0xa9, 0x9e, 0xcd, 0x85, 0x4c, 0xcf, 0xe6, 0x32, 0x14, 0xe9, 0x45, 0xf8, 0x1d, 0xad, 0x34, 0xe6,
-	0x31, 0x00, 0x3d, 0x05, 0x68, 0xe2, 0x40, 0x7d, 0xad, 0xa1, 0x42, 0xd4, 0x9e, 0xb5, 0xf6, 0x04,
-	0x29, 0x5a, 0x85, 0xb1, 0x13, 0x33, 0xc0, 0x2a, 0xfe, 0x4c, 0x7c, 0xfc, 0x11, 0x9f, 0x32, 0x43,
-	0x50, 0xa0, 0x4f, 0xa1, 0x4c, 0x12, 0x7a, 0xaa, 0x3a, 0x27, 0x96, 0xb3, 0x9d, 0xaf, 0x49, 0x2d,
-	0x36, 0x52, 0x1c, 0xba, 0x06, 0x8b, 0xf9, 0xce, 0x4b, 0xbf, 0x07, 0x6f, 0xf6, 0xf5, 0xe7, 0xfa,
-	0x22, 0xcc, 0xe7, 0x5d, 0x54, 0xe9, 0xb3, 0x30, 0x93, 0xb9, 0x8a, 0xd0, 0x7f, 0x03, 0x95, 0xd4,
-	0xa7, 0x5f, 0x3f, 0x70, 0x4f, 0xc4, 0x0c, 0x54, 0x52, 0xab, 0xf9, 0xe0, 0x8b, 0x1e, 0xb7, 0x0e,
-	0x68, 0x06, 0x4a, 0x2f, 0x8f, 0xea, 0xb5, 0xdd, 0xed, 0xfd, 0xbd, 0xfd, 0xdd, 0x9d, 0xea, 0x2d,
-	0x54, 0x82, 0xc9, 0x9d, 0xdd, 0xbd, 0xcd, 0x97, 0x07, 0xc7, 0xd5, 0x02, 0x02, 0x98, 0xa8, 0x1f,
-	0x1b, 0xfb, 0xdb, 0xc7, 0xd5, 0x11, 0x34, 0x09, 0xa3, 0x2f, 0xf6, 0xf6, 0xaa, 0xa3, 0x0f, 0x5e,
-	0x85, 0x69, 0x0c, 0x47, 0x4b, 0x2f, 0x57, 0xbd, 0x85, 0x2a, 0x09, 0xe7, 0x5a, 0x2d, 0x70, 0x31,
-	0xca, 0xed, 0x56, 0x47, 0xf8, 0x20, 0x09, 0x6f, 0x56, 0x1d, 0x45, 0x73, 0x30, 0x43, 0x3d, 0xec,
-	0x6e, 0x63, 0x37, 0x68, 0x05, 0x9b, 0x0d, 0xec, 0xb2, 0xea, 0xd8, 0xd6, 0xe2, 0x3f, 0x7f, 0x7f,
-	0xf7, 0xd6, 0xef, 0xbe, 0xbf, 0x7b, 0xeb, 0xdf, 0xbe, 0xbf, 0x7b, 0xeb, 0x9b, 0xe8, 0xbf, 0x52,
-	0x9c, 0x4c, 0x88, 0x15, 0xf8, 0xe0, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x5e, 0x46, 0xcf,
-	0xd4, 0x42, 0x00, 0x00,
+	// 4603 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3c, 0x4b, 0x73, 0x1b, 0x47,
+	0x7a, 0x02, 0xdf, 0xf8, 0x00, 0x90, 0x60, 0xf3, 0xa1, 0x16, 0x4d, 0x4b, 0xf4, 0x58, 0xd6, 0xca,
+	0xd6, 0x2e, 0x25, 0xd3, 0x5a, 0x59, 0xd6, 0xda, 0x5e, 0xf3, 0x69, 0xd3, 0x4b, 0x52, 0xc8, 0x80,
+	0x92, 0x1f, 0x9b, 0x5d, 0x65, 0x38, 0xd3, 0x04, 0x5b, 0x1a, 0x4c, 0x4f, 0x66, 0x1a, 0x10, 0xe9,
+	0x4b, 0x2a, 0xa7, 0x9c, 0x92, 0x43, 0x7e, 0x40, 0x72, 0xc8, 0x21, 0x55, 0xa9, 0xca, 0x39, 0x95,
+	0x7f, 0x90, 0xe3, 0x56, 0xaa, 0x72, 0x4f, 0xf9, 0x94, 0x1c, 0x73, 0x48, 0xed, 0x21, 0x97, 0x54,
+	0x3f, 0xe6, 0x89, 0x01, 0x01, 0x92, 0x76, 0x92, 0xca, 0x89, 0x98, 0xef, 0x35, 0xfd, 0xf8, 0xfa,
+	0x7b, 0xf5, 0x37, 0x84, 0xf7, 0xfc, 0x57, 0xad, 0xfb, 0x96, 0x4f, 0xc3, 0xfb, 0x34, 0xe4, 0x94,
+	0xdd, 0xef, 0xbe, 0x6f, 0xb9, 0xfe, 0x89, 0xf5, 0xfe, 0xfd, 0xae, 0xe5, 0x76, 0x48, 0xf8, 0x82,
+	0x9f, 0xf9, 0x24, 0x5c, 0xf5, 0x03, 0xc6, 0x19, 0x9a, 0x8a, 0x90, 0x4b, 0x37, 0x5b, 0x8c, 0xb5,
+	0x5c, 0x72, 0x5f, 0xc2, 0x8f, 0x3a, 0xc7, 0xf7, 0x9d, 0x4e, 0x60, 0x71, 0xca, 0x3c, 0x45, 0xb9,
+	0xf4, 0x59, 0x8b, 0xf2, 0x93, 0xce, 0xd1, 0xaa, 0xcd, 0xda, 0xf7, 0x5b, 0xac, 0xc5, 0x12, 0xc2,
+	0xf8, 0x47, 0x5e, 0xc2, 0xeb, 0xc0, 0xf2, 0x7d, 0x12, 0xe8, 0x77, 0x2d, 0xcd, 0x0b, 0x36, 0xf9,
+	0x53, 0x0a, 0x50, 0x50, 0xc3, 0x04, 0x58, 0x0f, 0xec, 0x93, 0x4d, 0xe6, 0x1d, 0xd3, 0x16, 0x9a,
+	0x87, 0x71, 0xab, 0xed, 0x3c, 0x7a, 0x88, 0x4b, 0x2b, 0xa5, 0xbb, 0x35, 0x53, 0x3d, 0x20, 0x0c,
+	0x93, 0xbe, 0x6f, 0x3f, 0x7a, 0xe8, 0x12, 0x3c, 0x22, 0xe1, 0xd1, 0xa3, 0xa0, 0x0f, 0x3f, 0xf8,
+	0xe8, 0xc1, 0x29, 0x1e, 0x55, 0xf4, 0xf2, 0xc1, 0xf8, 0xfd, 0x18, 0x94, 0x37, 0x0f, 0x76, 0xb5,
+	0xcc, 0x87, 0x30, 0x49, 0x3c, 0xeb, 0xc8, 0x25, 0x8e, 0x94, 0x5a, 0x59, 0x5b, 0x5a, 0x55, 0x23,
+	0x5d, 0x8d, 0x46, 0xba, 0xba, 0xc1, 0x98, 0xfb, 0x5c, 0xac, 0x8e, 0x19, 0x91, 0xa2, 0x3a, 0x8c,
+	0x9e, 0x74, 0x8e, 0xe4, 0xfb, 0xca, 0xa6, 0xf8, 0x89, 0xde, 0x85, 0x51, 0x6e, 0xb5, 0xe4, 0x9b,
+	0x2a, 0x6b, 0xd7, 0x57, 0xa3, 0x95, 0x5b, 0x3d, 0x3c, 0xf3, 0xc9, 0xae, 0xc7, 0x49, 0x70, 0x6c,
+	0xd9, 0xc4, 0x14, 0x34, 0x62, 0x58, 0xb4, 0x6d, 0xb5, 0x08, 0x1e, 0x93, 0xec, 0xea, 0x01, 0xdd,
+	0x04, 0xf0, 0x3b, 0xae, 0xdb, 0x60, 0x2e, 0xb5, 0xcf, 0xf0, 0xb8, 0x44, 0xa5, 0x20, 0x68, 0x19,
+	0xca, 0xb6, 0x47, 0x37, 0xa8, 0xb7, 0x45, 0x03, 0x3c, 0x21, 0xd1, 0x09, 0x40, 0x70, 0xdb, 0x1e,
+	0x15, 0x73, 0x12, 0xe8, 0x49, 0xc5, 0x9d, 0x40, 0xd0, 0x5d, 0x98, 0xd1, 0x4f, 0x3b, 0xd4, 0x25,
+	0x07, 0x56, 0x9b, 0xe0, 0x29, 0x49, 0x94, 0x07, 0xa3, 0x9f, 0xc2, 0x2c, 0x39, 0xb5, 0xdd, 0x8e,
+	0x23, 0x1f, 0x43, 0xdf, 0xb2, 0x49, 0x88, 0xcb, 0x2b, 0xa3, 0x77, 0xcb, 0x66, 0x2f, 0x02, 0xed,
+	0xc1, 0xb4, 0xcf, 0x9c, 0x75, 0xcf, 0x63, 0x5c, 0xea, 0x43, 0x88, 0x41, 0xae, 0xc0, 0x4a, 0x76,
+	0x05, 0xf6, 0x2d, 0xbf, 0xc9, 0x03, 0xea, 0xb5, 0xe2, 0xa5, 0xd8, 0x18, 0xc1, 0x25, 0x33, 0xc7,
+	0x8b, 0xee, 0x42, 0xdd, 0x0f, 0xfd, 0x17, 0xb6, 0xdb, 0x09, 0x39, 0x09, 0x5e, 0x04, 0xcc, 0x25,
+	0xb8, 0x22, 0x87, 0x39, 0xed, 0x87, 0xfe, 0xa6, 0x02, 0x9b, 0xcc, 0x25, 0x68, 0x09, 0xa6, 0x5c,
+	0xd6, 0xda, 0x23, 0x5d, 0xe2, 0xe2, 0xaa, 0xa4, 0x88, 0x9f, 0xd1, 0xfb, 0x30, 0x11, 0x10, 0xdf,
+	0xa2, 0x01, 0xae, 0xc9, 0xb1, 0xdc, 0x48, 0xc6, 0xb2, 0x79, 0xb0, 0x6b, 0x4a, 0x94, 0xda, 0x7d,
+	0x53, 0x13, 0x0a, 0x2d, 0xb0, 0x4f, 0x2c, 0xea, 0x11, 0x07, 0x4f, 0x0f, 0xd6, 0x02, 0x4d, 0x8a,
+	0x56, 0x61, 0x9c, 0x5b, 0xd4, 0xe3, 0x78, 0x46, 0xf2, 0xe0, 0xcc, 0x7b, 0x0e, 0x05, 0x46, 0xbf,
+	0x46, 0x91, 0x19, 0x3b, 0x30, 0x9d, 0x45, 0x5c, 0x4e, 0xfb, 0x8c, 0xbf, 0x18, 0x85, 0x99, 0xdc,
+	0x4c, 0xfe, 0xef, 0xe8, 0xf1, 0x32, 0x94, 0x5d, 0xeb, 0x88, 0xb8, 0x0d, 0xe6, 0x84, 0x52, 0x8d,
+	0xa7, 0xcc, 0x04, 0x80, 0xee, 0x40, 0xd5, 0x0e, 0x88, 0xc5, 0xc9, 0x76, 0x97, 0x78, 0x3c, 0x54,
+	0x8a, 0x2c, 0x75, 0x21, 0x03, 0x17, 0xfa, 0xec, 0x10, 0x97, 0x70, 0x22, 0xc5, 0x4c, 0x4a, 0x31,
+	0x29, 0x88, 0xd0, 0xd2, 0xa3, 0x80, 0xbd, 0x22, 0x5e, 0x83, 0x39, 0x7b, 0x42, 0xfa, 0xaf, 0xc8,
+	0x99, 0xd6, 0xe8, 0x5e, 0x04, 0x7a, 0x00, 0x73, 0x59, 0xa0, 0x5c, 0x06, 0x5c, 0x96, 0xf4, 0x45,
+	0x28, 0x21, 0x9f, 0x7a, 0x54, 0x6c, 0x93, 0xd8, 0x3a, 0x12, 0xc8, 0x13, 0x03, 0x4a, 0x7e, 0x0f,
+	0xc2, 0xf8, 0x1a, 0x96, 0x36, 0x1b, 0xcf, 0x0e, 0xad, 0xa0, 0x45, 0xf8, 0x33, 0x4e, 0x5d, 0xfa,
+	0x9d, 0x54, 0x68, 0xbd, 0x35, 0x4f, 0x00, 0x73, 0x89, 0x5a, 0xef, 0x92, 0xc0, 0x6a, 0x91, 0x14,
+	0x85, 0xdc, 0xab, 0x71, 0xb3, 0x2f, 0xde, 0xf8, 0xaf, 0x12, 0x94, 0x4d, 0x12, 0xb2, 0x4e, 0x20,
+	0x4e, 0xdb, 0x87, 0x30, 0xe1, 0xd2, 0x36, 0xe5, 0x21, 0x2e, 0xad, 0x8c, 0xde, 0xad, 0xac, 0xdd,
+	0x4a, 0xf6, 0x27, 0x26, 0x5a, 0xdd, 0x93, 0x14, 0xdb, 0x1e, 0x0f, 0xce, 0x4c, 0x4d, 0x8e, 0x3e,
+	0x81, 0xa9, 0x80, 0xfc, 0x71, 0x87, 0x84, 0x3c, 0xc4, 0x23, 0x92, 0xf5, 0xad, 0x22, 0x56, 0x53,
+	0xd3, 0x28, 0xe6, 0x98, 0x65, 0xe9, 0x23, 0xa8, 0xa4, 0xa4, 0x0a, 0xad, 0x79, 0x45, 0xce, 0xe4,
+	0xd8, 0xcb, 0xa6, 0xf8, 0x29, 0x54, 0x41, 0xfa, 0x0f, 0xad, 0x49, 0xea, 0xe1, 0xc9, 0xc8, 0xe3,
+	0xd2, 0xd2, 0x2f, 0xa0, 0x96, 0x91, 0x7a, 0x11, 0x66, 0xe3, 0x6b, 0x58, 0xd9, 0x22, 0xc7, 0x56,
+	0xc7, 0xe5, 0x0d, 0xe6, 0x6c, 0xd1, 0x30, 0xe8, 0xf8, 0x62, 0x55, 0x36, 0x3a, 0x4e, 0x8b, 0x5c,
+	0xed, 0x08, 0x7d, 0x05, 0x8b, 0x5a, 0x72, 0x3c, 0x7b, 0x2d, 0x2f, 0xbd, 0x54, 0x4a, 0x60, 0xd1,
+	0x52, 0x45, 0x73, 0xd2, 0x07, 0x3c, 0x66, 0x31, 0xfe, 0xa3, 0x0a, 0x73, 0xdb, 0xad, 0x80, 0x84,
+	0xe1, 0xe7, 0x16, 0x27, 0xaf, 0xad, 0x33, 0x2d, 0x76, 0x07, 0xea, 0x56, 0x87, 0xb3, 0xd0, 0xb6,
+	0x5c, 0xb2, 0x3d, 0xf4, 0x78, 0x7b, 0x78, 0x90, 0x01, 0xd5, 0x18, 0xb6, 0x6f, 0x9d, 0x6a, 0x97,
+	0x97, 0x81, 0x65, 0x69, 0xa8, 0xa7, 0xdd, 0x5f, 0x06, 0x86, 0x9e, 0xc0, 0xa8, 0xed, 0x77, 0xe4,
+	0x01, 0xad, 0xac, 0xdd, 0x4e, 0x59, 0xae, 0xbe, 0x7a, 0x2c, 0x4f, 0xa9, 0x60, 0x4a, 0x2f, 0xf9,
+	0xe4, 0xf0, 0xb6, 0x66, 0x0d, 0x46, 0x89, 0xd7, 0xc5, 0x53, 0xc3, 0xf9, 0x07, 0x53, 0x10, 0xa3,
+	0x75, 0x98, 0x90, 0xb6, 0x43, 0x79, 0xa0, 0xca, 0xda, 0xbb, 0x09, 0x5b, 0xc1, 0x22, 0xaf, 0xca,
+	0x03, 0x1c, 0xab, 0xbe, 0x7c, 0x40, 0x08, 0xc6, 0x3c, 0x71, 0x78, 0x6f, 0x48, 0xe5, 0x92, 0xbf,
+	0xd1, 0x17, 0x50, 0xf5, 0x98, 0x43, 0x9a, 0xc4, 0x25, 0x36, 0x67, 0xc1, 0x85, 0x7c, 0x56, 0x86,
+	0xb3, 0xc0, 0xff, 0x55, 0xae, 0xe0, 0xff, 0x18, 0x2c, 0x4b, 0x08, 0xa7, 0xeb, 0xc7, 0xc7, 0xc2,
+	0xcc, 0x9c, 0xc9, 0x19, 0xc5, 0xe3, 0xac, 0x4a, 0xd9, 0x3f, 0xc9, 0xca, 0x6e, 0xba, 0xd4, 0x26,
+	0x4f, 0x8f, 0xfb, 0xbc, 0xe2, 0x5c, 0x81, 0xe8, 0x35, 0xac, 0xe4, 0xf0, 0x87, 0x24, 0x68, 0x67,
+	0x5f, 0x5a, 0xbb, 0xf8, 0x4b, 0x07, 0x0a, 0x45, 0xf7, 0x60, 0xdc, 0x67, 0x01, 0x0f, 0xf1, 0xb4,
+	0xdc, 0xd7, 0x85, 0x44, 0x7a, 0x43, 0x80, 0x23, 0xbf, 0x29, 0x69, 0xd0, 0xcf, 0xa1, 0x1c, 0x44,
+	0x07, 0x4f, 0xfb, 0xda, 0xb9, 0x82, 0x33, 0x29, 0x5f, 0x9d, 0x50, 0xa2, 0x8f, 0xa1, 0x16, 0x12,
+	0x3b, 0x20, 0xfc, 0x39, 0x73, 0x3b, 0x6d, 0x12, 0xe2, 0xba, 0x7c, 0xd7, 0x62, 0xc2, 0xda, 0x4c,
+	0xa1, 0xcd, 0x2c, 0x31, 0x6a, 0x00, 0x0a, 0x49, 0xd0, 0xa5, 0x36, 0x49, 0xef, 0xee, 0xec, 0x90,
+	0xda, 0x5b, 0xc0, 0x2b, 0x34, 0x51, 0x44, 0xd7, 0x18, 0x29, 0x4d, 0x14, 0xbf, 0xd1, 0x3d, 0x18,
+	0xfb, 0xae, 0xeb, 0x7b, 0x78, 0x2e, 0xef, 0x6f, 0xbf, 0x25, 0x01, 0x7b, 0xde, 0x38, 0xd0, 0x0b,
+	0x21, 0x89, 0xd0, 0x3e, 0x54, 0x38, 0x73, 0x49, 0xa0, 0xc7, 0x32, 0x7f, 0xf1, 0x8d, 0x49, 0xf3,
+	0xa3, 0x3d, 0x98, 0x09, 0x98, 0xeb, 0x52, 0xaf, 0xb5, 0x6f, 0x9d, 0x36, 0x3b, 0x41, 0x8b, 0xe0,
+	0x05, 0x29, 0xf2, 0x66, 0x8f, 0xdb, 0x7f, 0x1a, 0x28, 0x69, 0x3b, 0x2c, 0x68, 0x6c, 0x48, 0x49,
+	0x79, 0x56, 0xf4, 0x35, 0x2c, 0x24, 0xa0, 0x67, 0x9e, 0xd5, 0xb5, 0xa8, 0x2b, 0x0e, 0x3e, 0x5e,
+	0x1c, 0x5a, 0x66, 0xb1, 0x00, 0xb4, 0x0f, 0x35, 0x5b, 0x2e, 0x43, 0xb4, 0x8f, 0xd7, 0x2f, 0x34,
+	0x71, 0x33, 0xcb, 0x8d, 0x7e, 0x0d, 0xf3, 0x96, 0xe3, 0x50, 0xb1, 0x06, 0x96, 0x1b, 0xfb, 0xf1,
+	0x10, 0xe3, 0x8b, 0x49, 0x2d, 0x14, 0x82, 0x1e, 0x43, 0x39, 0xe8, 0x78, 0xeb, 0xa1, 0xc9, 0x18,
+	0xc7, 0x4b, 0x03, 0x8d, 0x63, 0x42, 0x2c, 0x7d, 0x6c, 0x62, 0xbe, 0x2e, 0xe4, 0x26, 0xff, 0xbd,
+	0x04, 0xd3, 0xda, 0x10, 0x46, 0x5e, 0xec, 0x00, 0xe6, 0x64, 0x7e, 0xf7, 0x82, 0x48, 0x33, 0xd9,
+	0x52, 0x58, 0xed, 0x71, 0xde, 0x3c, 0xd7, 0x8a, 0x9a, 0x48, 0x72, 0x6e, 0xa7, 0x19, 0xd3, 0x26,
+	0x7f, 0x64, 0x78, 0x93, 0xff, 0x07, 0x30, 0xaf, 0x46, 0x41, 0xbd, 0xcc, 0x30, 0xc6, 0xf2, 0x2a,
+	0xb1, 0xeb, 0x15, 0x8c, 0x43, 0xcd, 0x60, 0x37, 0xc3, 0x6a, 0xfc, 0x1d, 0x82, 0xea, 0xe7, 0x2e,
+	0x3b, 0xb2, 0x5c, 0x3d, 0xd3, 0xbb, 0x30, 0x66, 0x05, 0xf6, 0x89, 0x9e, 0xda, 0x7c, 0x22, 0x33,
+	0x49, 0x1c, 0x4d, 0x49, 0x21, 0xa2, 0x40, 0xa5, 0x09, 0x62, 0xbd, 0xe3, 0x1c, 0x06, 0xaf, 0xa9,
+	0x28, 0xb0, 0x00, 0x25, 0x9c, 0xb6, 0xd6, 0x1d, 0xcb, 0xa5, 0x8e, 0x8a, 0xd8, 0x46, 0x07, 0x3b,
+	0xed, 0x3c, 0x0f, 0xfa, 0x02, 0x6e, 0x39, 0x2a, 0xda, 0x50, 0x03, 0x7a, 0x4e, 0x43, 0x7a, 0x44,
+	0x5d, 0xca, 0xcf, 0x9a, 0x84, 0x73, 0xea, 0xb5, 0x42, 0xfc, 0x50, 0x66, 0x58, 0x83, 0xc8, 0xd0,
+	0x73, 0x98, 0xd3, 0x24, 0x07, 0x69, 0x07, 0x36, 0x71, 0x01, 0xa7, 0x53, 0x24, 0x00, 0x79, 0xb0,
+	0xe4, 0xf4, 0x8d, 0xb4, 0xb4, 0x97, 0x7f, 0x2f, 0x11, 0x3f, 0x28, 0x2a, 0x93, 0x2f, 0x3a, 0x47,
+	0x22, 0x6a, 0x40, 0xdd, 0xc9, 0xc5, 0x5f, 0xb8, 0x9c, 0x9f, 0x44, 0x71, 0x84, 0x26, 0x65, 0xf7,
+	0x70, 0xa3, 0x5f, 0x03, 0xd2, 0xb0, 0xc3, 0x94, 0x8d, 0xfc, 0xf0, 0xe2, 0x36, 0xb2, 0x40, 0x4c,
+	0x94, 0x27, 0x55, 0x93, 0x3c, 0xe9, 0x2e, 0xcc, 0xc8, 0x7c, 0xa7, 0x91, 0xe4, 0xec, 0x35, 0x95,
+	0x50, 0xe7, 0xc0, 0xe8, 0x3d, 0xa8, 0xc7, 0x20, 0xe5, 0x70, 0x42, 0xfc, 0x8e, 0xdc, 0xed, 0x1e,
+	0x38, 0xba, 0x03, 0xd3, 0x52, 0xe9, 0x13, 0xed, 0x9c, 0x56, 0xe9, 0x6f, 0x16, 0x2a, 0xcc, 0x8c,
+	0xcb, 0x5a, 0xeb, 0xe1, 0x97, 0x21, 0xf3, 0xf0, 0xed, 0xc1, 0x66, 0x26, 0x26, 0x46, 0x1f, 0xc2,
+	0xa4, 0xcb, 0x5a, 0x2d, 0xea, 0xb5, 0xf0, 0x6c, 0xde, 0x18, 0xa8, 0x73, 0xb5, 0xa7, 0xd0, 0xfa,
+	0xe8, 0x44, 0xd4, 0x68, 0x13, 0x6a, 0x6d, 0x12, 0x9e, 0x6c, 0x9f, 0xfa, 0x96, 0x17, 0x8a, 0x83,
+	0x80, 0xf2, 0xec, 0xfb, 0x69, 0xb4, 0x66, 0xcf, 0xf2, 0xa0, 0x45, 0x98, 0x10, 0x80, 0xdd, 0x2d,
+	0xfc, 0x73, 0x39, 0x2f, 0xfd, 0x84, 0xb6, 0xa0, 0x2a, 0x7e, 0x1d, 0x10, 0xfe, 0x9a, 0x05, 0xaf,
+	0x42, 0x3c, 0x97, 0x57, 0x85, 0x3e, 0x6e, 0x36, 0xc3, 0x85, 0x3e, 0x83, 0x6a, 0xbb, 0xe3, 0x72,
+	0xaa, 0x0b, 0x05, 0xda, 0xf3, 0x2c, 0xa7, 0x46, 0x98, 0xc2, 0xea, 0x01, 0x66, 0x38, 0x44, 0x2d,
+	0xc9, 0x53, 0xd2, 0xf0, 0x4f, 0xe4, 0x00, 0xa3, 0x47, 0xf4, 0x08, 0x16, 0x7d, 0xe6, 0x6c, 0x1d,
+	0x34, 0x9b, 0x44, 0x18, 0x93, 0x54, 0x6d, 0xe4, 0x9e, 0xdc, 0xcb, 0x3e, 0x58, 0xf4, 0x5b, 0x58,
+	0x66, 0x6d, 0xca, 0x9b, 0xd4, 0x21, 0xb6, 0x15, 0xec, 0x7a, 0x2f, 0xe5, 0x79, 0x53, 0x2f, 0xdf,
+	0xb7, 0x7c, 0x7c, 0x67, 0xe0, 0xe6, 0x9d, 0xcb, 0x8f, 0x3e, 0x85, 0x2a, 0xf3, 0x92, 0x8a, 0x0c,
+	0xbe, 0x3e, 0x50, 0x5e, 0x86, 0x1e, 0x99, 0xb0, 0xc8, 0x7c, 0xa1, 0xe7, 0x2c, 0xd8, 0xb7, 0x3c,
+	0xab, 0x45, 0xbe, 0x22, 0x47, 0x27, 0x8c, 0xbd, 0x0a, 0xf1, 0xbb, 0x03, 0x25, 0xf5, 0xe1, 0x44,
+	0x0f, 0x60, 0xd6, 0x0f, 0x28, 0x0b, 0x28, 0x3f, 0xdb, 0x74, 0xad, 0x30, 0x94, 0xc9, 0xf3, 0x1b,
+	0x71, 0xa6, 0xdf, 0x8b, 0x94, 0xe1, 0x60, 0xc0, 0x4e, 0xcf, 0xf0, 0xf2, 0x4a, 0x29, 0x17, 0x0e,
+	0x0a, 0x70, 0x1c, 0x0e, 0x8a, 0x07, 0xf4, 0x21, 0x94, 0xe5, 0x8f, 0x5d, 0x8f, 0x72, 0xfc, 0x66,
+	0xbe, 0xc4, 0xd3, 0x88, 0x50, 0x9a, 0x29, 0xa1, 0x45, 0xef, 0xc0, 0x68, 0xe8, 0x84, 0xf8, 0x66,
+	0x3e, 0x82, 0x6c, 0x6e, 0x35, 0x35, 0xb1, 0xc0, 0x47, 0x25, 0x90, 0x5b, 0x43, 0x94, 0x40, 0x56,
+	0x61, 0x82, 0x07, 0x96, 0x4d, 0x02, 0xfc, 0xd6, 0x4a, 0x29, 0x1b, 0x5b, 0x1e, 0x4a, 0x78, 0x54,
+	0x67, 0x52, 0x54, 0x68, 0x05, 0x2a, 0x3c, 0xe8, 0x84, 0x7c, 0x8b, 0xb5, 0x2d, 0xea, 0x61, 0x43,
+	0xea, 0x58, 0x1a, 0x84, 0xd6, 0x60, 0xa2, 0x13, 0x92, 0xfd, 0xcd, 0x06, 0x7e, 0x7b, 0xe0, 0xfa,
+	0x6b, 0x4a, 0xb4, 0x0a, 0x28, 0x20, 0x6d, 0xc6, 0x49, 0x83, 0xba, 0x8c, 0xaf, 0x3b, 0x8e, 0x70,
+	0x98, 0xf8, 0x81, 0x14, 0x5e, 0x80, 0x11, 0xa3, 0x96, 0xf6, 0xc4, 0xc1, 0x8f, 0xf2, 0xa3, 0xde,
+	0x95, 0xf0, 0x68, 0xd4, 0x8a, 0x4a, 0x14, 0x43, 0x7c, 0xc1, 0xbf, 0x49, 0x02, 0xde, 0x08, 0x58,
+	0x97, 0x3a, 0x24, 0xc0, 0x8f, 0x55, 0x31, 0xa4, 0x07, 0x21, 0x0a, 0x40, 0x2f, 0x5f, 0x73, 0x6d,
+	0x13, 0x3f, 0x92, 0x54, 0x09, 0x40, 0xee, 0x01, 0x0f, 0xf1, 0x93, 0x9e, 0x3d, 0x38, 0x4c, 0xf6,
+	0x80, 0x87, 0xa2, 0xbe, 0x17, 0x90, 0x2e, 0x95, 0x86, 0xe6, 0x17, 0xaa, 0xbe, 0x17, 0x3d, 0xa3,
+	0x0d, 0x98, 0x6e, 0xb3, 0x8e, 0xc7, 0xf7, 0xb9, 0x1b, 0x8a, 0x37, 0x87, 0xf8, 0xe3, 0x81, 0x4b,
+	0x95, 0xe3, 0x10, 0x83, 0xb4, 0xad, 0x68, 0xa5, 0x3e, 0x51, 0x83, 0x8c, 0x01, 0xe2, 0x0d, 0xe4,
+	0x94, 0x93, 0xc0, 0xb3, 0x5c, 0xb5, 0x20, 0xf8, 0xd3, 0xc1, 0x6f, 0xc8, 0x72, 0xa0, 0xcf, 0xa0,
+	0x66, 0x13, 0x8f, 0x07, 0xb1, 0x88, 0x5f, 0x0e, 0x14, 0x91, 0x65, 0x10, 0x12, 0xb4, 0xf5, 0xd1,
+	0x8b, 0xf9, 0xd9, 0x60, 0x09, 0x19, 0x06, 0xe3, 0x67, 0x50, 0x8e, 0xd7, 0x55, 0xe8, 0x9e, 0x4e,
+	0x4a, 0x44, 0x8a, 0xa5, 0x6b, 0xe8, 0x69, 0x90, 0x61, 0x42, 0x35, 0xbd, 0xff, 0x72, 0x19, 0x64,
+	0x24, 0xb7, 0xee, 0x59, 0xee, 0x59, 0x48, 0xc3, 0x21, 0x62, 0xbf, 0x1c, 0x87, 0x71, 0x0f, 0xe6,
+	0x0a, 0xdc, 0x8a, 0x08, 0x66, 0x5d, 0x59, 0xbc, 0x55, 0x01, 0xae, 0x7a, 0x30, 0xfe, 0xa6, 0x0e,
+	0xf3, 0x45, 0xa1, 0xe0, 0xff, 0xab, 0xea, 0x89, 0x50, 0x8c, 0x4e, 0xc8, 0x59, 0xbb, 0xa9, 0x96,
+	0x1e, 0x4f, 0x0c, 0x9c, 0x48, 0x96, 0x21, 0x1d, 0x8c, 0xc3, 0x85, 0xeb, 0x2f, 0x95, 0x8b, 0xd4,
+	0x5f, 0x36, 0xe2, 0xfa, 0xcb, 0xcc, 0xca, 0x68, 0x36, 0x04, 0xdc, 0xf5, 0x86, 0x2c, 0xc0, 0xdc,
+	0x81, 0x69, 0x97, 0x59, 0xce, 0x86, 0xe5, 0x5a, 0x9e, 0x4d, 0x82, 0xdd, 0x06, 0xae, 0xab, 0x98,
+	0x26, 0x0b, 0x15, 0x65, 0xd2, 0x34, 0xa4, 0x29, 0xe3, 0x3a, 0xd3, 0xf2, 0x5a, 0x44, 0xa4, 0xdd,
+	0xc2, 0xc7, 0xf6, 0xc5, 0xa3, 0x6d, 0x40, 0x99, 0x40, 0x43, 0x16, 0x11, 0x30, 0x3a, 0xaf, 0xb6,
+	0x50, 0xc0, 0x10, 0xd7, 0x8a, 0x7e, 0x7a, 0x4e, 0xad, 0x68, 0xee, 0x07, 0xac, 0x15, 0xcd, 0xff,
+	0x88, 0xb5, 0xa2, 0x85, 0xff, 0x8d, 0x5a, 0xd1, 0xe2, 0x8f, 0x5a, 0x2b, 0xba, 0x3e, 0x44, 0xad,
+	0xe8, 0x0e, 0x54, 0x03, 0xe2, 0xbb, 0xd4, 0xb6, 0x36, 0x85, 0xc5, 0x97, 0x59, 0x7d, 0x4d, 0x6d,
+	0x46, 0x1a, 0x8e, 0x36, 0xd2, 0x35, 0xa5, 0x1b, 0x17, 0xd8, 0x87, 0xf3, 0x0a, 0x4c, 0x6f, 0x5c,
+	0xbd, 0xc0, 0xb4, 0xfc, 0x03, 0x14, 0x98, 0xde, 0x4c, 0x15, 0x98, 0x1e, 0xe9, 0x02, 0x93, 0x0a,
+	0x7a, 0x8c, 0x7e, 0xe7, 0xf7, 0xdb, 0xae, 0xef, 0x65, 0x6a, 0x4d, 0x05, 0xc5, 0xa1, 0x5b, 0x3f,
+	0x42, 0x71, 0x68, 0xe5, 0xaa, 0xc5, 0xa1, 0x87, 0xb0, 0x10, 0x39, 0xde, 0xc3, 0xc0, 0x3a, 0x3e,
+	0xa6, 0xb6, 0x76, 0x96, 0x2a, 0xb6, 0x2a, 0x46, 0xe6, 0x2b, 0x69, 0x6f, 0x5f, 0xb1, 0x92, 0xf6,
+	0x2b, 0xa8, 0xea, 0x0a, 0x87, 0x32, 0x3c, 0xb7, 0x2f, 0x24, 0xcf, 0xcc, 0x30, 0xf7, 0xad, 0x4f,
+	0xbd, 0xf3, 0x43, 0xd4, 0xa7, 0x7a, 0x6a, 0x69, 0x77, 0xae, 0x54, 0x4b, 0xcb, 0x94, 0xbb, 0x7e,
+	0xf6, 0x3f, 0x54, 0xee, 0x3a, 0x01, 0xdc, 0x4f, 0x79, 0x2f, 0x79, 0x0d, 0xba, 0x08, 0x13, 0x61,
+	0xe7, 0xf8, 0x98, 0x9e, 0xea, 0x97, 0xe9, 0x27, 0xe3, 0x4f, 0x60, 0xae, 0x20, 0xa9, 0xbd, 0xe4,
+	0x4b, 0x54, 0x64, 0xbf, 0xbb, 0xb7, 0x31, 0x44, 0x14, 0xa5, 0x29, 0x8d, 0x7f, 0x2b, 0x01, 0xea,
+	0x4d, 0x5a, 0x2f, 0x39, 0x80, 0x15, 0xa8, 0xe8, 0x9b, 0x75, 0x99, 0x90, 0xa9, 0xa9, 0xa6, 0x41,
+	0x22, 0x91, 0x68, 0xc9, 0x60, 0x4d, 0x25, 0x23, 0x4d, 0xb5, 0x26, 0xa3, 0x92, 0xb0, 0x00, 0x83,
+	0xbe, 0x04, 0x44, 0x3d, 0xd9, 0x12, 0xb0, 0xed, 0x75, 0xd9, 0xd9, 0x0e, 0x75, 0x45, 0xda, 0x3d,
+	0x36, 0x70, 0x48, 0x05, 0x5c, 0xc6, 0x9f, 0x95, 0xe0, 0x8d, 0xa7, 0x1d, 0x7e, 0xc4, 0x3a, 0x9e,
+	0x93, 0x39, 0xac, 0x7a, 0xce, 0x9f, 0xc2, 0x58, 0x9b, 0x39, 0x6a, 0xd8, 0xd3, 0xe9, 0x40, 0xe4,
+	0x1c, 0xa6, 0xd5, 0x7d, 0xe6, 0x10, 0x53, 0xf2, 0x19, 0x77, 0x61, 0x4c, 0x3c, 0xa1, 0x1a, 0x94,
+	0xd7, 0xf7, 0xf6, 0x9e, 0x7e, 0xf5, 0x62, 0xfd, 0xe0, 0x9b, 0xfa, 0x35, 0x34, 0x0b, 0x35, 0x73,
+	0xfb, 0xf3, 0xdd, 0xe6, 0xa1, 0xf9, 0xcd, 0x8b, 0xa7, 0x07, 0x7b, 0xdf, 0xd4, 0x4b, 0xc6, 0xef,
+	0xab, 0x50, 0x91, 0xf9, 0xd2, 0x95, 0x56, 0xbb, 0x28, 0x64, 0x1d, 0xb9, 0x6a, 0xc8, 0xda, 0x27,
+	0x1c, 0xcd, 0x87, 0xb5, 0x63, 0x05, 0x61, 0x6d, 0xde, 0x31, 0x8e, 0xf7, 0x71, 0x8c, 0xf1, 0xad,
+	0xfe, 0x44, 0xfa, 0x56, 0xff, 0x36, 0xd4, 0x64, 0x0a, 0xdb, 0xb4, 0xda, 0xbe, 0xb0, 0xc2, 0xf2,
+	0x1a, 0xaf, 0x64, 0x66, 0x81, 0xd9, 0x8b, 0x9a, 0xf2, 0xd0, 0x17, 0x35, 0xa2, 0x39, 0x45, 0x2e,
+	0x75, 0x52, 0xc6, 0x00, 0xdd, 0x9c, 0x92, 0x05, 0x47, 0x71, 0x77, 0xe5, 0x32, 0x71, 0x77, 0x3e,
+	0x90, 0xab, 0x5e, 0x3a, 0x90, 0xb3, 0xe1, 0xd6, 0x2b, 0x42, 0x7c, 0xcb, 0xa5, 0x5d, 0xb1, 0xb4,
+	0x22, 0x2c, 0x97, 0x47, 0xd3, 0x23, 0xb6, 0x78, 0xf1, 0x7a, 0x8b, 0xc4, 0x9d, 0x27, 0xf9, 0x9d,
+	0xde, 0xd2, 0x7d, 0x53, 0xe6, 0x20, 0x09, 0x68, 0x4f, 0x54, 0x48, 0x7d, 0x97, 0x9d, 0xb5, 0x89,
+	0xc7, 0x95, 0xa9, 0xc4, 0xd3, 0xc3, 0x0d, 0xd9, 0xec, 0xe1, 0x14, 0x86, 0xda, 0x8e, 0x6b, 0x4e,
+	0x68, 0xb0, 0xa1, 0x8e, 0x89, 0x53, 0x05, 0x89, 0xf9, 0xa1, 0x0b, 0x12, 0x3a, 0xd5, 0x58, 0xb8,
+	0x48, 0xaa, 0x51, 0x10, 0x70, 0xe0, 0x1f, 0x21, 0xe0, 0xb8, 0x71, 0xf5, 0xdb, 0xa8, 0x4c, 0xe8,
+	0xb0, 0x74, 0xc5, 0xd0, 0xe1, 0x04, 0xde, 0x52, 0x16, 0xa3, 0x21, 0x96, 0xd3, 0x66, 0x6e, 0xd3,
+	0xa3, 0xc7, 0xc7, 0x6a, 0x20, 0x91, 0x65, 0xc3, 0xcb, 0x03, 0x57, 0x7e, 0xb0, 0x10, 0x74, 0x0c,
+	0x2b, 0x7d, 0x89, 0x76, 0x3d, 0xf5, 0xa2, 0x37, 0x07, 0xbe, 0x68, 0xa0, 0x8c, 0x82, 0x34, 0xe7,
+	0xe6, 0x15, 0xd2, 0x9c, 0x5f, 0x42, 0x55, 0xe9, 0xa2, 0xca, 0xf7, 0x74, 0x10, 0xfa, 0x46, 0x2a,
+	0x07, 0x48, 0x2c, 0xb5, 0x22, 0x31, 0x33, 0x0c, 0xe8, 0x31, 0x5c, 0x7f, 0xf9, 0xfa, 0x55, 0x28,
+	0x8c, 0x8f, 0xdb, 0x25, 0xc1, 0xf6, 0x29, 0x0f, 0x2c, 0x11, 0x81, 0x6c, 0xae, 0xcb, 0xe0, 0xb3,
+	0x6c, 0xf6, 0x43, 0xa3, 0x0f, 0x60, 0xd2, 0x77, 0x3b, 0x2d, 0xea, 0x85, 0xf8, 0xad, 0x7c, 0x95,
+	0x31, 0xde, 0x65, 0x35, 0x07, 0x33, 0xa2, 0x8c, 0x6e, 0x0a, 0x8c, 0x9e, 0x8e, 0xaa, 0xb7, 0x07,
+	0x97, 0x13, 0x8d, 0x7f, 0x28, 0x01, 0x92, 0xf3, 0xd1, 0xf1, 0x8d, 0x76, 0x40, 0xe2, 0x56, 0x40,
+	0x01, 0xa2, 0x92, 0x41, 0x49, 0xdf, 0x0a, 0x64, 0xa0, 0xe8, 0x19, 0x2c, 0xd0, 0x98, 0x91, 0x0b,
+	0xf5, 0x25, 0xc1, 0x7e, 0xe2, 0x33, 0x53, 0xdd, 0x42, 0x85, 0x64, 0x66, 0x31, 0xb7, 0xf0, 0x2e,
+	0x11, 0xc2, 0xb5, 0xc2, 0x50, 0xc7, 0x03, 0x19, 0x98, 0xb1, 0x0b, 0xb3, 0x72, 0xe0, 0x19, 0x97,
+	0x7d, 0xb9, 0xd6, 0x1c, 0x0e, 0x33, 0x87, 0xc4, 0x25, 0x6d, 0xc2, 0x83, 0x2b, 0x09, 0x42, 0xf7,
+	0x60, 0xa4, 0xbb, 0x86, 0x47, 0xf3, 0x0a, 0x13, 0x0b, 0x7f, 0xbe, 0xa6, 0x33, 0x9e, 0x91, 0xee,
+	0x9a, 0xf1, 0x97, 0xa3, 0x30, 0xdb, 0x83, 0xb9, 0xe4, 0x8b, 0xbf, 0x86, 0xd9, 0x36, 0xe1, 0x96,
+	0x63, 0x71, 0xeb, 0x05, 0x39, 0xb5, 0x4f, 0x2c, 0x4f, 0x37, 0xc9, 0x55, 0xd6, 0xee, 0x15, 0x8e,
+	0x63, 0x5f, 0x53, 0x6f, 0x6b, 0x62, 0x3d, 0xae, 0x7a, 0x3b, 0x07, 0x47, 0xdb, 0x00, 0x7e, 0xc0,
+	0xda, 0x84, 0x9f, 0x90, 0x4e, 0x54, 0x8d, 0x7b, 0xa7, 0x50, 0x64, 0x23, 0x26, 0xd3, 0xc2, 0x52,
+	0x8c, 0xe8, 0x0b, 0xa8, 0x84, 0xdc, 0xb2, 0x5f, 0x39, 0x01, 0xed, 0x92, 0x40, 0x2f, 0xd1, 0x9d,
+	0x42, 0x39, 0x4d, 0x41, 0xb7, 0x25, 0xe9, 0xb4, 0xa0, 0x34, 0x2b, 0xfa, 0x43, 0x98, 0xb5, 0x6c,
+	0x9b, 0x84, 0xe1, 0x0b, 0x97, 0xb5, 0x5e, 0xf8, 0x49, 0xf3, 0x6a, 0x65, 0xed, 0x41, 0xa1, 0xbc,
+	0x75, 0x49, 0xbd, 0xc7, 0x5a, 0x4a, 0x53, 0x54, 0xf0, 0xa7, 0x25, 0xcf, 0x58, 0x59, 0xa4, 0x61,
+	0xc1, 0x5b, 0x03, 0x57, 0x09, 0x7d, 0x0c, 0x95, 0xd7, 0x56, 0xd8, 0x1e, 0x3e, 0xc6, 0x4a, 0x93,
+	0x1b, 0xff, 0x32, 0x0a, 0x6f, 0x9c, 0xb3, 0x6c, 0x97, 0xd4, 0x80, 0x2b, 0x8d, 0x09, 0xfd, 0x26,
+	0x8a, 0x87, 0x5e, 0xb0, 0x2e, 0x09, 0x02, 0xea, 0x10, 0xbd, 0x45, 0x0f, 0x87, 0xda, 0xea, 0x55,
+	0xf5, 0xe7, 0xa9, 0xe6, 0x35, 0xa7, 0xed, 0xcc, 0xf3, 0xd2, 0xf7, 0x25, 0x98, 0xce, 0x92, 0xa0,
+	0x27, 0x30, 0x99, 0x6d, 0x11, 0x18, 0xec, 0xb4, 0x23, 0x06, 0xf4, 0x85, 0xb0, 0x4e, 0xd2, 0xf4,
+	0xeb, 0x4b, 0x2a, 0x3c, 0x32, 0xa4, 0x88, 0x1c, 0x1f, 0xfa, 0x12, 0x66, 0x58, 0x87, 0xa7, 0x41,
+	0x78, 0x74, 0x48, 0x51, 0x79, 0x46, 0xe3, 0xaf, 0xc6, 0x61, 0xf9, 0x3c, 0x35, 0xbe, 0xe4, 0xc6,
+	0x3e, 0x4e, 0xae, 0x4f, 0x07, 0x6e, 0xaa, 0xf4, 0x67, 0x11, 0x39, 0x7a, 0x02, 0xd0, 0x66, 0x1e,
+	0xe5, 0x4c, 0x0c, 0x7c, 0x88, 0x2e, 0x82, 0x14, 0x35, 0x7a, 0x04, 0x53, 0x9c, 0xf9, 0xcc, 0x65,
+	0xad, 0xb3, 0x21, 0xb2, 0xab, 0x98, 0x16, 0x6d, 0xc1, 0x8c, 0x43, 0x43, 0x31, 0xf2, 0x38, 0x94,
+	0x18, 0x5c, 0x6c, 0xce, 0xb3, 0x88, 0x0d, 0xce, 0x6a, 0x10, 0x1e, 0x1f, 0x72, 0x57, 0x72, 0x7c,
+	0xe8, 0x25, 0x2c, 0x44, 0xfb, 0x14, 0xdb, 0x01, 0xb9, 0x96, 0x93, 0xd2, 0x41, 0x3d, 0x1c, 0xce,
+	0x02, 0xad, 0x66, 0x78, 0xcd, 0x62, 0x91, 0xe8, 0x04, 0xe6, 0xa9, 0xd7, 0x0b, 0xc7, 0x53, 0x57,
+	0x78, 0x55, 0xa1, 0x44, 0xe3, 0x21, 0xd4, 0xb2, 0xaf, 0x9e, 0x82, 0xb1, 0x83, 0xa7, 0x07, 0xdb,
+	0xf5, 0x6b, 0xe2, 0xd7, 0xce, 0xb3, 0xbd, 0xbd, 0x7a, 0x09, 0xcd, 0x40, 0x65, 0xdb, 0x34, 0x9f,
+	0x9a, 0x4d, 0x95, 0x65, 0x8e, 0x18, 0x7f, 0x5b, 0x82, 0x3b, 0xc3, 0xd9, 0xc5, 0x4b, 0xaa, 0xea,
+	0xe7, 0x30, 0xeb, 0xb2, 0xd6, 0x57, 0xd4, 0x73, 0xd8, 0xeb, 0x28, 0xed, 0xc0, 0x23, 0x83, 0xf2,
+	0x92, 0x5e, 0x1e, 0x63, 0x5b, 0xfb, 0xf6, 0x74, 0x90, 0x25, 0x9a, 0x69, 0xc2, 0xce, 0x51, 0x68,
+	0x07, 0xf4, 0x88, 0x38, 0x49, 0x0f, 0x47, 0x49, 0x16, 0xea, 0x8b, 0x50, 0xc6, 0x9f, 0x97, 0xa0,
+	0x92, 0x2a, 0xd8, 0xc6, 0xc5, 0xf6, 0x52, 0xaa, 0xd8, 0x8e, 0x60, 0x4c, 0x94, 0x71, 0xe5, 0x30,
+	0xc7, 0x4d, 0xf9, 0x5b, 0x5c, 0x05, 0x8a, 0xec, 0x4b, 0xb0, 0xca, 0x63, 0x33, 0x6e, 0xc6, 0xcf,
+	0xa2, 0x4d, 0x5c, 0xb5, 0x4e, 0x4b, 0xec, 0x98, 0xc4, 0xa6, 0x20, 0x82, 0xd7, 0xd7, 0x91, 0xaa,
+	0xfe, 0xa4, 0x22, 0x7e, 0x36, 0xfe, 0x79, 0x12, 0x2a, 0xa9, 0xdb, 0x65, 0x21, 0x4b, 0x24, 0xcc,
+	0xea, 0x8a, 0x5d, 0xf7, 0xb4, 0xa7, 0x20, 0x22, 0x05, 0xd6, 0xb5, 0x12, 0x7d, 0x7b, 0xab, 0x04,
+	0x66, 0x81, 0xe2, 0xae, 0xd4, 0x66, 0x6d, 0x9f, 0x79, 0x22, 0xf7, 0x8a, 0xbe, 0x50, 0x50, 0xa9,
+	0x74, 0x2f, 0x22, 0xb9, 0x61, 0xdb, 0x64, 0x01, 0xd9, 0xea, 0xb4, 0x7d, 0x5c, 0x1e, 0xb8, 0xc1,
+	0x39, 0x0e, 0xb1, 0x13, 0xfa, 0xbb, 0x0c, 0x1d, 0x81, 0xab, 0x1a, 0xa4, 0xea, 0x55, 0x29, 0x42,
+	0x89, 0x7c, 0x3b, 0x02, 0x37, 0xf4, 0x05, 0x8b, 0xee, 0x5d, 0xc9, 0x81, 0x93, 0x62, 0xc0, 0x74,
+	0xba, 0x18, 0x20, 0x7a, 0x5f, 0xbc, 0x2c, 0xbf, 0xba, 0xd2, 0xc9, 0x83, 0x33, 0x9f, 0x69, 0xa0,
+	0xdc, 0x67, 0x1a, 0x4f, 0x44, 0x2c, 0x43, 0xbb, 0xd4, 0x25, 0x2d, 0xe2, 0xe0, 0xb9, 0x81, 0xf3,
+	0x4e, 0x51, 0xa3, 0x0d, 0x58, 0x0e, 0x88, 0xe5, 0x50, 0x8f, 0x84, 0xa1, 0xb8, 0xda, 0xa7, 0x96,
+	0xbb, 0x45, 0x5c, 0xeb, 0xac, 0x49, 0x6c, 0xe6, 0x39, 0xea, 0x62, 0xa5, 0x66, 0x9e, 0x4b, 0x23,
+	0x3a, 0x3a, 0x62, 0x7c, 0x83, 0x04, 0x94, 0x39, 0x11, 0xf7, 0x82, 0xe4, 0xee, 0x83, 0x45, 0x1f,
+	0xc3, 0x8d, 0x18, 0xb3, 0x63, 0x51, 0xb7, 0x13, 0x90, 0xc3, 0x93, 0x80, 0x84, 0x27, 0xcc, 0x75,
+	0xe4, 0x05, 0x48, 0xcd, 0xec, 0x4f, 0x20, 0xb4, 0x2c, 0xe4, 0x16, 0xef, 0xc8, 0x62, 0xaf, 0xec,
+	0xd6, 0xa8, 0x99, 0x29, 0x48, 0xb6, 0x84, 0x82, 0x2f, 0x50, 0x42, 0x89, 0x1a, 0x11, 0x6e, 0x48,
+	0xfb, 0x56, 0x4f, 0x78, 0x14, 0x3c, 0x6e, 0x41, 0x58, 0x83, 0x79, 0xbd, 0xcb, 0x91, 0x81, 0x57,
+	0xfa, 0xb2, 0x2c, 0xb7, 0xa7, 0x10, 0x87, 0x3e, 0x85, 0xb2, 0x4b, 0x8f, 0x89, 0x7d, 0x66, 0xbb,
+	0x04, 0xdf, 0x1e, 0xd2, 0xf8, 0x27, 0x2c, 0xe8, 0x04, 0x6e, 0x89, 0xc9, 0xaf, 0xfb, 0xb2, 0xce,
+	0x24, 0x8c, 0xca, 0x33, 0x8f, 0x53, 0x57, 0x9e, 0xbe, 0x26, 0xb7, 0x02, 0x1e, 0x55, 0xb7, 0x07,
+	0x79, 0xd3, 0x41, 0x62, 0x8c, 0xdf, 0xc2, 0x4c, 0xae, 0x01, 0x24, 0xd1, 0xe1, 0x52, 0x5a, 0x87,
+	0x33, 0xeb, 0x3c, 0x3e, 0xec, 0x3a, 0x1b, 0x9b, 0x70, 0xbd, 0xcf, 0x37, 0x00, 0xa8, 0xae, 0x6a,
+	0x53, 0xba, 0x84, 0x2d, 0x2a, 0x4e, 0xb2, 0xdb, 0xa9, 0xcd, 0x82, 0xb3, 0xa8, 0xac, 0xac, 0x9e,
+	0x8c, 0xcf, 0xa1, 0x1c, 0xb7, 0x9c, 0xa0, 0x27, 0x30, 0xce, 0xc5, 0xf7, 0x27, 0xc3, 0x3a, 0x55,
+	0x39, 0x22, 0xc5, 0x62, 0xfc, 0x11, 0x54, 0xd3, 0x37, 0x4c, 0xa2, 0xab, 0x41, 0xf6, 0x39, 0x34,
+	0x2c, 0x7e, 0xa2, 0x07, 0x92, 0x00, 0x62, 0x83, 0x3b, 0x92, 0x32, 0xb8, 0x42, 0x1d, 0xa5, 0x04,
+	0x59, 0x12, 0x56, 0x99, 0x5d, 0x0a, 0x62, 0xfc, 0x75, 0x09, 0x6a, 0x3a, 0xbd, 0x8c, 0x9b, 0x02,
+	0x2a, 0x56, 0x2a, 0xb7, 0x1f, 0x36, 0x5c, 0x4c, 0x33, 0x89, 0x8c, 0x32, 0xba, 0x97, 0x69, 0x44,
+	0xe6, 0xbe, 0x66, 0x66, 0x60, 0xf1, 0x68, 0x47, 0xb3, 0xee, 0x21, 0xdf, 0x41, 0x6d, 0xfc, 0xe3,
+	0x18, 0x2c, 0x14, 0x76, 0x47, 0xa1, 0xaf, 0xe1, 0x86, 0x32, 0x95, 0x49, 0x3b, 0xd6, 0xc6, 0x99,
+	0xee, 0x29, 0x1c, 0x22, 0x24, 0xef, 0xcf, 0x8c, 0xbe, 0x81, 0x39, 0x8f, 0x74, 0x89, 0x7e, 0x61,
+	0x5c, 0x51, 0xac, 0x5c, 0xec, 0x2e, 0xa5, 0x48, 0x86, 0xbc, 0xfd, 0x71, 0x45, 0x23, 0x6f, 0x4e,
+	0x76, 0xf5, 0xa2, 0xb7, 0x3f, 0x05, 0x42, 0xd0, 0x1e, 0xcc, 0x05, 0xe4, 0x75, 0x40, 0x39, 0x59,
+	0xf7, 0xfd, 0x2f, 0x0e, 0x0f, 0x1b, 0x8d, 0x80, 0x1d, 0x11, 0x5c, 0x1f, 0xb8, 0x16, 0x45, 0x6c,
+	0xc8, 0x84, 0x39, 0x2a, 0xe5, 0x93, 0x4c, 0xb5, 0x67, 0xd8, 0xde, 0xbd, 0x22, 0x66, 0x11, 0x6b,
+	0xb2, 0xa3, 0xcc, 0xc4, 0x87, 0x2d, 0x22, 0xe6, 0xf8, 0x54, 0xd5, 0xe2, 0xa5, 0xaa, 0xa7, 0x3e,
+	0x33, 0xf7, 0xf0, 0x62, 0x54, 0xb5, 0x48, 0x60, 0xc6, 0x9f, 0x8e, 0x40, 0x35, 0xdd, 0xa7, 0x25,
+	0xba, 0x23, 0x45, 0x86, 0xe9, 0xb0, 0x56, 0x6f, 0xab, 0xb4, 0x22, 0xdc, 0x52, 0xe8, 0xa8, 0x3b,
+	0x52, 0x53, 0xa3, 0x4f, 0x84, 0x85, 0x6c, 0x9d, 0xf0, 0x90, 0x13, 0x5f, 0xeb, 0xd6, 0xad, 0x3c,
+	0xeb, 0x9e, 0x20, 0x68, 0x72, 0xe2, 0x6b, 0xe6, 0x84, 0x03, 0x3d, 0x84, 0x89, 0xef, 0xa8, 0xff,
+	0x8a, 0x46, 0xed, 0xc5, 0xcb, 0x79, 0xde, 0x6f, 0x25, 0x36, 0xea, 0xcb, 0x52, 0xb4, 0x68, 0x33,
+	0x9b, 0xc6, 0x8f, 0xe5, 0xbf, 0x56, 0x52, 0xac, 0xcd, 0x84, 0xa4, 0x20, 0x83, 0x37, 0xee, 0xc3,
+	0x5c, 0xc1, 0xcc, 0x44, 0x27, 0xa4, 0xa5, 0xdb, 0xa3, 0x94, 0x21, 0x89, 0x1e, 0x8d, 0x26, 0x2c,
+	0x14, 0xce, 0xa7, 0x3f, 0x8b, 0xb8, 0x79, 0x52, 0xa9, 0xfd, 0xa1, 0xb4, 0x74, 0xfa, 0xe6, 0x29,
+	0x05, 0x32, 0x56, 0x01, 0xf5, 0x4e, 0xf4, 0x9c, 0x41, 0xfc, 0x67, 0x09, 0xae, 0xf7, 0x99, 0x1e,
+	0x7a, 0x00, 0xe3, 0x0e, 0x39, 0xea, 0xb4, 0x86, 0x08, 0x96, 0x15, 0xa1, 0xb8, 0x44, 0x6e, 0x5b,
+	0xa7, 0x07, 0x9d, 0xf6, 0x11, 0x09, 0x9e, 0x1e, 0xaf, 0x73, 0x1e, 0xd0, 0xa3, 0x0e, 0x27, 0xa1,
+	0x36, 0x4c, 0xc5, 0x48, 0x11, 0x40, 0xa4, 0x11, 0xa9, 0x23, 0xa0, 0xee, 0x68, 0xfa, 0x60, 0x45,
+	0xa3, 0x4b, 0x0a, 0xb3, 0x4f, 0xc2, 0xd0, 0x6a, 0x45, 0xdf, 0x43, 0xaa, 0x9b, 0x9b, 0xbe, 0x78,
+	0xe3, 0x77, 0x25, 0x80, 0x0d, 0x2b, 0x8c, 0x8c, 0xf1, 0x97, 0x80, 0x74, 0x34, 0x68, 0x6e, 0x1d,
+	0x92, 0xb6, 0xef, 0x5a, 0x9c, 0x84, 0x43, 0xcc, 0xbb, 0x80, 0x4b, 0xc4, 0xb7, 0xdd, 0xb8, 0x65,
+	0x5d, 0x9c, 0x18, 0xb5, 0x4d, 0x59, 0x20, 0x6a, 0xc0, 0x82, 0xe2, 0x95, 0x9d, 0x62, 0x6a, 0x18,
+	0x9b, 0xe6, 0x56, 0x38, 0x44, 0x46, 0x5b, 0xcc, 0x68, 0x3c, 0x06, 0x24, 0x41, 0x8e, 0x29, 0x3b,
+	0x15, 0xf5, 0xcc, 0xf2, 0xc7, 0xb7, 0x54, 0x70, 0x7c, 0xff, 0x7e, 0x1c, 0x26, 0xa4, 0xe8, 0x50,
+	0xb4, 0x15, 0xda, 0x1e, 0xc5, 0x23, 0x79, 0x47, 0x1e, 0x7f, 0xe8, 0x6d, 0x0a, 0x3c, 0x7a, 0x08,
+	0x53, 0xba, 0x6c, 0x11, 0x39, 0xfd, 0xd4, 0x47, 0xbb, 0xd9, 0x4f, 0x28, 0xcc, 0x98, 0x52, 0xf4,
+	0x4b, 0xaa, 0xcb, 0x4f, 0x9d, 0x3d, 0x2f, 0xe6, 0x5b, 0xa6, 0xa3, 0x73, 0xa9, 0xa8, 0x64, 0xc3,
+	0x8a, 0x48, 0x98, 0x74, 0x77, 0xd6, 0x42, 0x61, 0xb1, 0xda, 0x54, 0x34, 0xa2, 0x9b, 0x95, 0x47,
+	0x69, 0x20, 0xbe, 0xde, 0x53, 0x67, 0xce, 0x56, 0x42, 0xcd, 0x84, 0x16, 0x7d, 0x05, 0x8b, 0x61,
+	0xd6, 0xef, 0xe9, 0x06, 0x5c, 0x5c, 0xcb, 0xdb, 0x9f, 0x42, 0xff, 0x68, 0xf6, 0x61, 0x97, 0x5f,
+	0x3d, 0xe8, 0x4f, 0xad, 0xe3, 0x08, 0x69, 0x76, 0x88, 0xaf, 0x1e, 0x72, 0x3c, 0xe8, 0x01, 0x94,
+	0xd5, 0xd7, 0x1f, 0x62, 0x67, 0xe6, 0xfa, 0xef, 0xcc, 0x94, 0xa4, 0xda, 0xf4, 0x68, 0xa6, 0xeb,
+	0x73, 0x21, 0xd7, 0xf5, 0xb9, 0x0c, 0x65, 0xf6, 0x3a, 0xfa, 0x12, 0x57, 0x19, 0xf3, 0x04, 0x80,
+	0x3e, 0x04, 0x10, 0xfd, 0x5c, 0x4a, 0x22, 0xbe, 0x7d, 0x7e, 0xad, 0x3d, 0x45, 0x2a, 0x3e, 0x1f,
+	0x39, 0xb2, 0x42, 0x82, 0xdf, 0xc9, 0x7f, 0x3e, 0x92, 0x9c, 0x32, 0x53, 0x52, 0x88, 0xee, 0x72,
+	0x9a, 0xd2, 0x53, 0x7c, 0x27, 0x6f, 0xa9, 0x7b, 0xb5, 0xd8, 0xcc, 0x70, 0x18, 0x18, 0x16, 0x8b,
+	0x9d, 0x97, 0x71, 0x0b, 0xde, 0x3c, 0xd7, 0x9f, 0x1b, 0x8b, 0x30, 0x5f, 0x74, 0x51, 0x65, 0xcc,
+	0xc2, 0x4c, 0xee, 0x2a, 0xc2, 0xf8, 0x0d, 0xd4, 0x32, 0x1f, 0x8f, 0xfd, 0xc0, 0x3d, 0x11, 0x33,
+	0x50, 0xcb, 0xac, 0xe6, 0x7b, 0x5f, 0xf6, 0xb9, 0x75, 0x10, 0x25, 0x8f, 0x67, 0x07, 0xcd, 0xc6,
+	0xf6, 0xe6, 0xee, 0xce, 0xee, 0xf6, 0x56, 0xfd, 0x1a, 0xaa, 0xc0, 0xe4, 0xd6, 0xf6, 0xce, 0xfa,
+	0xb3, 0xbd, 0xc3, 0x7a, 0x09, 0x01, 0x4c, 0x34, 0x0f, 0xcd, 0xdd, 0xcd, 0xc3, 0xfa, 0x08, 0x9a,
+	0x84, 0xd1, 0xa7, 0x3b, 0x3b, 0xf5, 0xd1, 0xf7, 0x9e, 0x47, 0x69, 0x8c, 0x40, 0x2b, 0x2f, 0x57,
+	0xbf, 0x26, 0xae, 0xec, 0x63, 0x57, 0x59, 0x2f, 0x09, 0x31, 0xda, 0xed, 0xd6, 0x47, 0xc4, 0x4b,
+	0x52, 0xde, 0xac, 0x3e, 0x8a, 0xe6, 0x60, 0x86, 0xf9, 0xc4, 0xdb, 0x24, 0x5e, 0xd8, 0x09, 0xd7,
+	0x5b, 0xc4, 0xe3, 0xf5, 0xb1, 0x8d, 0xc5, 0x7f, 0xfa, 0xfe, 0xe6, 0xb5, 0xdf, 0x7d, 0x7f, 0xf3,
+	0xda, 0xbf, 0x7e, 0x7f, 0xf3, 0xda, 0xb7, 0xf1, 0xff, 0xb5, 0x38, 0x9a, 0x90, 0x2b, 0xf0, 0xc1,
+	0x7f, 0x0f, 0x00, 0xfe, 0xe0, 0xca, 0xd2, 0x16, 0x43, 0x00, 0x00,
 }
@@ -20,6 +20,7 @@ import (
 	"strings"
 	"time"
 
+	v1 "k8s.io/api/core/v1"
 	errors2 "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -33,6 +34,7 @@ import (
 	"istio.io/istio/operator/pkg/object"
 	"istio.io/istio/operator/pkg/util"
 	"istio.io/istio/operator/pkg/util/progress"
+	"istio.io/istio/operator/pkg/validate"
 )
 
 const fieldOwnerOperator = "istio-operator"
@@ -89,6 +91,8 @@ func (h *HelmReconciler) ApplyManifest(manifest name.Manifest, serverSideApply b
 		scope.Infof("Generated manifest objects are the same as cached for component %s.", cname)
 	}
 
+	h.warnOnResourceQuotas(changedObjects)
+
 	// Objects are applied in groups: namespaces, CRDs, everything else, with wait for ready in between.
 	nsObjs := object.KindObjects(changedObjects, name.NamespaceStr)
 	crdObjs := object.KindObjects(changedObjects, name.CRDStr)
@@ -145,6 +149,56 @@ func (h *HelmReconciler) ApplyManifest(manifest name.Manifest, serverSideApply b
 	return processedObjects, deployedObjects, nil
 }
 
+// warnOnResourceQuotas logs a warning for every ResourceQuota that the given objects would exceed once applied.
+func (h *HelmReconciler) warnOnResourceQuotas(objs object.K8sObjects) {
+	namespaces := make(map[string]bool)
+	for _, obj := range objs {
+		if obj.Namespace != "" {
+			namespaces[obj.Namespace] = true
+		}
+	}
+	var existing object.K8sObjects
+	for ns := range namespaces {
+		quotas := &v1.ResourceQuotaList{}
+		if err := h.client.List(context.TODO(), quotas, client.InNamespace(ns)); err != nil {
+			scope.Warnf("failed to list resource quotas in namespace %s, skipping quota check: %v", ns, err)
+			continue
+		}
+		if len(quotas.Items) == 0 {
+			continue
+		}
+		if existing == nil {
+			existing = h.existingObjects(objs)
+		}
+		for i := range quotas.Items {
+			for _, err := range validate.CheckResourceQuota(objs, existing, &quotas.Items[i]) {
+				scope.Warnf("%v", err)
+			}
+		}
+	}
+}
+
+// existingObjects returns the in-cluster versions of the given namespaced objects which already exist.
+func (h *HelmReconciler) existingObjects(objs object.K8sObjects) object.K8sObjects {
+	out := object.K8sObjects{}
+	for _, obj := range objs {
+		if obj.Namespace == "" {
+			continue
+		}
+		receiver := &unstructured.Unstructured{}
+		receiver.SetGroupVersionKind(obj.GroupVersionKind())
+		key := client.ObjectKey{Namespace: obj.Namespace, Name: obj.Name}
+		if err := h.client.Get(context.TODO(), key, receiver); err != nil {
+			if !errors2.IsNotFound(err) {
+				scope.Warnf("failed to get %s for the resource quota check: %v", obj.Hash(), err)
+			}
+			continue
+		}
+		out = append(out, object.NewK8sObject(receiver, nil, nil))
+	}
+	return out
+}
+
 // ApplyObject creates or updates an object in the API server depending on whether it already exists.
 // It mutates obj.
 func (h *HelmReconciler) ApplyObject(obj *unstructured.Unstructured, serverSideApply bool) error {
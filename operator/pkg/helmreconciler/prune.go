@@ -42,6 +42,7 @@ var (
 	NamespacedResources = []schema.GroupVersionKind{
 		{Group: "autoscaling", Version: "v2beta1", Kind: name.HPAStr},
 		{Group: "policy", Version: "v1beta1", Kind: name.PDBStr},
+		{Group: "networking.k8s.io", Version: "v1", Kind: name.NetworkPolicyStr},
 		{Group: "apps", Version: "v1", Kind: name.DeploymentStr},
 		{Group: "apps", Version: "v1", Kind: name.DaemonSetStr},
 		{Group: "", Version: "v1", Kind: name.ServiceStr},
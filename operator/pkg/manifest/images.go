@@ -0,0 +1,135 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"istio.io/istio/operator/pkg/name"
+	"istio.io/istio/operator/pkg/object"
+	"istio.io/istio/operator/pkg/tpath"
+	"istio.io/istio/operator/pkg/util"
+)
+
+// imageOverride describes where the image of a component can be overridden in values and which container of the
+// generated workload it should end up on.
+type imageOverride struct {
+	imagePath string
+	// hubPath and tagPath are consulted before global.hub and global.tag, if set.
+	hubPath   string
+	tagPath   string
+	kind      string
+	container string
+}
+
+var imageOverrides = map[name.ComponentName]imageOverride{
+	name.PilotComponentName: {
+		imagePath: "pilot.image",
+		hubPath:   "pilot.hub",
+		tagPath:   "pilot.tag",
+		kind:      name.DeploymentStr,
+		container: "discovery",
+	},
+	name.CNIComponentName: {
+		imagePath: "cni.image",
+		hubPath:   "cni.hub",
+		tagPath:   "cni.tag",
+		kind:      name.DaemonSetStr,
+		container: "install-cni",
+	},
+	name.IngressComponentName: {
+		imagePath: "global.proxy.image",
+		kind:      name.DeploymentStr,
+		container: "istio-proxy",
+	},
+	name.EgressComponentName: {
+		imagePath: "global.proxy.image",
+		kind:      name.DeploymentStr,
+		container: "istio-proxy",
+	},
+}
+
+// CheckImageOverrides verifies that the component images overridden through values landed on the right container of
+// the generated workloads. It returns an error for every workload whose image does not match the override.
+func CheckImageOverrides(values map[string]interface{}, manifests name.ManifestMap) (errs util.Errors) {
+	for cn, io := range imageOverrides {
+		override := valueString(values, io.imagePath)
+		if override == "" {
+			continue
+		}
+		want := override
+		if !strings.Contains(override, "/") {
+			hub := valueString(values, io.hubPath)
+			if hub == "" {
+				hub = valueString(values, "global.hub")
+			}
+			tag := valueString(values, io.tagPath)
+			if tag == "" {
+				tag = valueString(values, "global.tag")
+			}
+			want = fmt.Sprintf("%s/%s:%s", hub, override, tag)
+		}
+		for _, m := range manifests[cn] {
+			objs, err := object.ParseK8sObjectsFromYAMLManifest(m)
+			if err != nil {
+				errs = util.AppendErr(errs, err)
+				continue
+			}
+			for _, obj := range object.KindObjects(objs, io.kind) {
+				got, found := containerImage(obj.UnstructuredObject(), io.container)
+				if !found {
+					continue
+				}
+				if got != want {
+					errs = util.AppendErr(errs, fmt.Errorf("component %s: %s container %s has image %s, want %s",
+						cn, obj.Hash(), io.container, got, want))
+				}
+			}
+		}
+	}
+	return errs
+}
+
+// valueString returns the string value at the given path of the values tree, or an empty string if it is not set.
+func valueString(values map[string]interface{}, path string) string {
+	if path == "" {
+		return ""
+	}
+	v, found, err := tpath.Find(values, util.PathFromString(path))
+	if err != nil || !found || v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+// containerImage returns the image of the named container in the pod template of the given workload.
+func containerImage(u *unstructured.Unstructured, container string) (string, bool) {
+	containers, found, err := unstructured.NestedSlice(u.Object, "spec", "template", "spec", "containers")
+	if err != nil || !found {
+		return "", false
+	}
+	for _, c := range containers {
+		cm, ok := c.(map[string]interface{})
+		if !ok || cm["name"] != container {
+			continue
+		}
+		image, _ := cm["image"].(string)
+		return image, true
+	}
+	return "", false
+}
@@ -0,0 +1,65 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"istio.io/istio/operator/pkg/name"
+	"istio.io/istio/operator/pkg/util/clog"
+	"istio.io/istio/pkg/test/env"
+)
+
+func TestCheckImageOverrides(t *testing.T) {
+	const image = "docker.io/custom/pilot:1.0"
+	setFlags := []string{
+		"installPackagePath=" + filepath.Join(env.IstioSrc, "manifests"),
+		"values.pilot.image=" + image,
+	}
+	manifests, iop, err := GenManifests(nil, setFlags, false, nil, clog.NewDefaultLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errs := CheckImageOverrides(iop.Spec.Values, manifests); len(errs) != 0 {
+		t.Fatalf("expected image override to propagate, got: %v", errs)
+	}
+
+	var found bool
+	for _, m := range manifests[name.PilotComponentName] {
+		if strings.Contains(m, "image: "+image) {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected istiod deployment to use image %s", image)
+	}
+
+	// Simulate a chart ignoring the override.
+	mismatched := name.ManifestMap{}
+	for cn, ms := range manifests {
+		for _, m := range ms {
+			mismatched[cn] = append(mismatched[cn], strings.ReplaceAll(m, image, "docker.io/istio/pilot:latest"))
+		}
+	}
+	errs := CheckImageOverrides(iop.Spec.Values, mismatched)
+	if len(errs) != 1 {
+		t.Fatalf("expected one mismatch, got: %v", errs)
+	}
+	if !strings.Contains(errs[0].Error(), "Deployment:istio-system:istiod") {
+		t.Errorf("expected mismatch on the istiod deployment, got: %v", errs[0])
+	}
+}
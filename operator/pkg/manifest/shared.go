@@ -197,6 +197,52 @@ func GenIOPFromProfile(profileOrPath, fileOverlayYAML string, setFlags []string,
 	return util.ToYAMLWithJSONPB(finalIOP), finalIOP, nil
 }
 
+// EnabledComponents returns the names of the components enabled by the given profile, using the profiles found under
+// manifestsPath, or the compiled in profiles if manifestsPath is empty. The profile is resolved the same way as for
+// manifest generate, but no manifests are rendered. Gateway components are reported if any gateway of that type is
+// enabled.
+func EnabledComponents(profile, manifestsPath string, l clog.Logger) ([]name.ComponentName, error) {
+	var setFlags []string
+	if manifestsPath != "" {
+		setFlags = append(setFlags, "installPackagePath="+manifestsPath)
+	}
+	_, iop, err := GenIOPFromProfile(profile, "", setFlags, false, false, nil, l)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []name.ComponentName
+	for _, cn := range name.AllCoreComponentNames {
+		enabled, err := translate.IsComponentEnabledInSpec(cn, iop.Spec)
+		if err != nil {
+			return nil, err
+		}
+		if enabled {
+			out = append(out, cn)
+		}
+	}
+	if iop.Spec.Components == nil {
+		return out, nil
+	}
+	if anyGatewayEnabled(iop.Spec.Components.IngressGateways) {
+		out = append(out, name.IngressComponentName)
+	}
+	if anyGatewayEnabled(iop.Spec.Components.EgressGateways) {
+		out = append(out, name.EgressComponentName)
+	}
+	return out, nil
+}
+
+// anyGatewayEnabled reports whether any of the given gateways is enabled.
+func anyGatewayEnabled(gateways []*v1alpha1.GatewaySpec) bool {
+	for _, g := range gateways {
+		if g != nil && g.Enabled != nil && g.Enabled.Value {
+			return true
+		}
+	}
+	return false
+}
+
 // ReadYamlProfile gets the overlay yaml file from list of files and return profile value from file overlay and set overlay.
 func ReadYamlProfile(inFilenames []string, setFlags []string, force bool, l clog.Logger) (string, string, error) {
 	profile := name.DefaultProfileName
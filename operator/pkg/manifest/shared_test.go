@@ -21,7 +21,11 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
+	"istio.io/istio/operator/pkg/name"
 	"istio.io/istio/operator/pkg/util"
+	"istio.io/istio/operator/pkg/util/clog"
 	"istio.io/istio/pkg/test/env"
 )
 
@@ -96,3 +100,15 @@ func TestReadLayeredYAMLs(t *testing.T) {
 		})
 	}
 }
+
+func TestEnabledComponents(t *testing.T) {
+	manifests := filepath.Join(env.IstioSrc, "manifests")
+	got, err := EnabledComponents("default", manifests, clog.NewDefaultLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []name.ComponentName{name.IstioBaseComponentName, name.PilotComponentName, name.IngressComponentName}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected enabled components (-want +got):\n%s", diff)
+	}
+}
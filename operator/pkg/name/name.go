@@ -42,6 +42,7 @@ const (
 	IngressStr                        = "Ingress"
 	MutatingWebhookConfigurationStr   = "MutatingWebhookConfiguration"
 	NamespaceStr                      = "Namespace"
+	NetworkPolicyStr                  = "NetworkPolicy"
 	PVCStr                            = "PersistentVolumeClaim"
 	PodStr                            = "Pod"
 	PDBStr                            = "PodDisruptionBudget"
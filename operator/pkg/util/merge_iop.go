@@ -238,3 +238,47 @@ func OverlayIOP(base, overlay string) (string, error) {
 
 	return string(my), nil
 }
+
+// MergeIOPSpecs merges an ordered list of IstioOperatorSpecs, typically a base profile followed by user overlays, and
+// returns the effective spec. Later specs take precedence over earlier ones. Scalars are replaced, maps are merged and
+// lists are merged by key where a merge key is defined (e.g. gateways by name), otherwise they are replaced.
+func MergeIOPSpecs(specs ...*v1alpha1.IstioOperatorSpec) (*v1alpha1.IstioOperatorSpec, error) {
+	merged := ""
+	for i, spec := range specs {
+		if spec == nil {
+			continue
+		}
+		sy, err := MarshalWithJSONPB(spec)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal spec %d: %v", i, err)
+		}
+		specTree := make(map[string]interface{})
+		if err := yaml2.Unmarshal([]byte(sy), &specTree); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal spec %d: %v", i, err)
+		}
+		iy, err := yaml2.Marshal(map[string]interface{}{"spec": specTree})
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal spec %d: %v", i, err)
+		}
+		if merged, err = OverlayIOP(merged, string(iy)); err != nil {
+			return nil, fmt.Errorf("failed to overlay spec %d: %v", i, err)
+		}
+	}
+
+	out := &v1alpha1.IstioOperatorSpec{}
+	tree := make(map[string]interface{})
+	if err := yaml2.Unmarshal([]byte(merged), &tree); err != nil {
+		return nil, err
+	}
+	if tree["spec"] == nil {
+		return out, nil
+	}
+	sy, err := yaml2.Marshal(tree["spec"])
+	if err != nil {
+		return nil, err
+	}
+	if err := UnmarshalWithJSONPB(string(sy), out, false); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal merged spec: %v", err)
+	}
+	return out, nil
+}
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/ghodss/yaml"
+	"github.com/gogo/protobuf/types"
 
 	v1alpha12 "istio.io/api/operator/v1alpha1"
 	"istio.io/istio/operator/pkg/apis/istio/v1alpha1"
@@ -65,3 +66,73 @@ func TestOverlayIOPDefaultMeshConfig(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestMergeIOPSpecs(t *testing.T) {
+	base := &v1alpha12.IstioOperatorSpec{
+		Hub: "docker.io/istio",
+		Tag: "1.8.0",
+		Components: &v1alpha12.IstioComponentSetSpec{
+			Pilot: &v1alpha12.ComponentSpec{Enabled: &v1alpha12.BoolValueForPB{BoolValue: types.BoolValue{Value: true}}},
+			IngressGateways: []*v1alpha12.GatewaySpec{{
+				Name:    "istio-ingressgateway",
+				Enabled: &v1alpha12.BoolValueForPB{BoolValue: types.BoolValue{Value: true}},
+			}},
+		},
+	}
+	overlay1 := &v1alpha12.IstioOperatorSpec{
+		Tag: "1.8.1",
+		Components: &v1alpha12.IstioComponentSetSpec{
+			IngressGateways: []*v1alpha12.GatewaySpec{{
+				Name:      "istio-ingressgateway",
+				Namespace: "istio-ingress",
+			}},
+		},
+	}
+	overlay2 := &v1alpha12.IstioOperatorSpec{
+		Tag: "1.8.2",
+		Components: &v1alpha12.IstioComponentSetSpec{
+			IngressGateways: []*v1alpha12.GatewaySpec{{
+				Name:    "ilb-gateway",
+				Enabled: &v1alpha12.BoolValueForPB{BoolValue: types.BoolValue{Value: true}},
+			}},
+		},
+	}
+
+	got, err := MergeIOPSpecs(base, nil, overlay1, overlay2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Hub != "docker.io/istio" {
+		t.Errorf("expected hub from base, got %q", got.Hub)
+	}
+	if got.Tag != "1.8.2" {
+		t.Errorf("expected tag from last overlay, got %q", got.Tag)
+	}
+	if got.Components.Pilot.Enabled == nil || !got.Components.Pilot.Enabled.Value {
+		t.Errorf("expected pilot to stay enabled")
+	}
+	gateways := map[string]*v1alpha12.GatewaySpec{}
+	for _, g := range got.Components.IngressGateways {
+		gateways[g.Name] = g
+	}
+	if len(gateways) != 2 {
+		t.Fatalf("expected gateways to be merged by name, got %v", got.Components.IngressGateways)
+	}
+	ingress := gateways["istio-ingressgateway"]
+	if ingress == nil || ingress.Enabled == nil || !ingress.Enabled.Value || ingress.Namespace != "istio-ingress" {
+		t.Errorf("expected istio-ingressgateway merged across overlays, got %v", ingress)
+	}
+	if ilb := gateways["ilb-gateway"]; ilb == nil || ilb.Enabled == nil || !ilb.Enabled.Value {
+		t.Errorf("expected ilb-gateway to be added, got %v", ilb)
+	}
+}
+
+func TestMergeIOPSpecsEmpty(t *testing.T) {
+	got, err := MergeIOPSpecs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got == nil || got.Hub != "" {
+		t.Errorf("expected empty spec, got %v", got)
+	}
+}
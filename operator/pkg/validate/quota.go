@@ -0,0 +1,142 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"fmt"
+	"sort"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"istio.io/istio/operator/pkg/name"
+	"istio.io/istio/operator/pkg/object"
+	"istio.io/istio/operator/pkg/util"
+)
+
+// objectCountResources maps kinds to the quota resource counting objects of that kind.
+var objectCountResources = map[string]v1.ResourceName{
+	name.ServiceStr:    v1.ResourceServices,
+	name.CMStr:         v1.ResourceConfigMaps,
+	name.SecretStr:     v1.ResourceSecrets,
+	name.PVCStr:        v1.ResourcePersistentVolumeClaims,
+	name.PodStr:        v1.ResourcePods,
+	name.DeploymentStr: v1.ResourceName("count/deployments.apps"),
+}
+
+// CheckResourceQuota checks whether the resources requested by the generated objects in the quota's namespace fit
+// in what is left of the ResourceQuota. existing holds the in-cluster versions of the generated objects; their
+// resources are already part of the quota usage and are replaced rather than added to. Each returned error
+// describes a quota that would be exceeded; callers are expected to surface them as warnings before applying
+// the objects.
+func CheckResourceQuota(objs, existing object.K8sObjects, quota *v1.ResourceQuota) (errs util.Errors) {
+	if quota == nil {
+		return nil
+	}
+	requested, err := requestedResources(objs, quota.Namespace)
+	if err != nil {
+		return util.NewErrs(err)
+	}
+	used, err := requestedResources(existing, quota.Namespace)
+	if err != nil {
+		return util.NewErrs(err)
+	}
+	for rn, q := range used {
+		req := requested[rn]
+		req.Sub(q)
+		requested[rn] = req
+	}
+
+	resourceNames := make([]string, 0, len(quota.Spec.Hard))
+	for rn := range quota.Spec.Hard {
+		resourceNames = append(resourceNames, string(rn))
+	}
+	sort.Strings(resourceNames)
+	for _, rn := range resourceNames {
+		req, ok := requested[v1.ResourceName(rn)]
+		if !ok || req.Sign() <= 0 {
+			continue
+		}
+		hard := quota.Spec.Hard[v1.ResourceName(rn)]
+		total := quota.Status.Used[v1.ResourceName(rn)].DeepCopy()
+		total.Add(req)
+		if total.Cmp(hard) > 0 {
+			used := quota.Status.Used[v1.ResourceName(rn)]
+			errs = util.AppendErr(errs, fmt.Errorf("resource quota %s/%s exceeded for %s: requested %s, used %s, hard limit %s",
+				quota.Namespace, quota.Name, rn, req.String(), used.String(), hard.String()))
+		}
+	}
+	return errs
+}
+
+// requestedResources sums up the quota resources requested by the objects in the given namespace.
+func requestedResources(objs object.K8sObjects, namespace string) (v1.ResourceList, error) {
+	out := v1.ResourceList{}
+	add := func(rn v1.ResourceName, q resource.Quantity) {
+		cur := out[rn]
+		cur.Add(q)
+		out[rn] = cur
+	}
+	for _, obj := range objs {
+		if obj.Namespace != namespace {
+			continue
+		}
+		if rn, ok := objectCountResources[obj.Kind]; ok {
+			add(rn, *resource.NewQuantity(1, resource.DecimalSI))
+		}
+		var podSpec *v1.PodSpec
+		replicas := int64(1)
+		switch obj.Kind {
+		case name.DeploymentStr:
+			deployment := &appsv1.Deployment{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredObject().Object, deployment); err != nil {
+				return nil, fmt.Errorf("failed to convert %s: %v", obj.Hash(), err)
+			}
+			if deployment.Spec.Replicas != nil {
+				replicas = int64(*deployment.Spec.Replicas)
+			}
+			podSpec = &deployment.Spec.Template.Spec
+			add(v1.ResourcePods, *resource.NewQuantity(replicas, resource.DecimalSI))
+		case name.PodStr:
+			pod := &v1.Pod{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredObject().Object, pod); err != nil {
+				return nil, fmt.Errorf("failed to convert %s: %v", obj.Hash(), err)
+			}
+			podSpec = &pod.Spec
+		}
+		if podSpec == nil {
+			continue
+		}
+		for _, c := range podSpec.Containers {
+			for rn, q := range c.Resources.Requests {
+				q = q.DeepCopy()
+				q.Mul(replicas)
+				add(v1.ResourceName("requests."+string(rn)), q)
+				// Quotas on plain cpu and memory apply to requests.
+				if rn == v1.ResourceCPU || rn == v1.ResourceMemory {
+					add(rn, q)
+				}
+			}
+			for rn, q := range c.Resources.Limits {
+				q = q.DeepCopy()
+				q.Mul(replicas)
+				add(v1.ResourceName("limits."+string(rn)), q)
+			}
+		}
+	}
+	return out, nil
+}
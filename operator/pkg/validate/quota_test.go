@@ -0,0 +1,135 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"istio.io/istio/operator/pkg/object"
+)
+
+const quotaTestManifest = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: istiod
+  namespace: istio-system
+spec:
+  replicas: 2
+  template:
+    spec:
+      containers:
+      - name: discovery
+        resources:
+          requests:
+            cpu: 500m
+            memory: 1Gi
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: istiod
+  namespace: istio-system
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: other
+  namespace: other-namespace
+`
+
+func TestCheckResourceQuota(t *testing.T) {
+	objs, err := object.ParseK8sObjectsFromYAMLManifest(quotaTestManifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		desc     string
+		hard     v1.ResourceList
+		used     v1.ResourceList
+		existing bool
+		wantErrs []string
+	}{
+		{
+			desc: "fits",
+			hard: v1.ResourceList{
+				v1.ResourceRequestsCPU: resource.MustParse("2"),
+				v1.ResourcePods:        resource.MustParse("10"),
+				v1.ResourceServices:    resource.MustParse("1"),
+			},
+		},
+		{
+			desc: "exceeded",
+			hard: v1.ResourceList{
+				v1.ResourceRequestsCPU:    resource.MustParse("1"),
+				v1.ResourceRequestsMemory: resource.MustParse("4Gi"),
+				v1.ResourcePods:           resource.MustParse("10"),
+				v1.ResourceServices:       resource.MustParse("1"),
+			},
+			used: v1.ResourceList{
+				v1.ResourceRequestsCPU: resource.MustParse("200m"),
+				v1.ResourceServices:    resource.MustParse("1"),
+			},
+			wantErrs: []string{"requests.cpu", "services"},
+		},
+		{
+			desc: "re-applying existing objects",
+			hard: v1.ResourceList{
+				v1.ResourceRequestsCPU: resource.MustParse("1"),
+				v1.ResourcePods:        resource.MustParse("2"),
+				v1.ResourceServices:    resource.MustParse("1"),
+			},
+			used: v1.ResourceList{
+				v1.ResourceRequestsCPU: resource.MustParse("1"),
+				v1.ResourcePods:        resource.MustParse("2"),
+				v1.ResourceServices:    resource.MustParse("1"),
+			},
+			existing: true,
+		},
+		{
+			desc: "unrelated quota resources",
+			hard: v1.ResourceList{
+				v1.ResourceLimitsCPU: resource.MustParse("1"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			quota := &v1.ResourceQuota{
+				ObjectMeta: meta_v1.ObjectMeta{Name: "quota", Namespace: "istio-system"},
+				Spec:       v1.ResourceQuotaSpec{Hard: tt.hard},
+				Status:     v1.ResourceQuotaStatus{Hard: tt.hard, Used: tt.used},
+			}
+			var existing object.K8sObjects
+			if tt.existing {
+				existing = objs
+			}
+			errs := CheckResourceQuota(objs, existing, quota)
+			if len(errs) != len(tt.wantErrs) {
+				t.Fatalf("got %d warnings, want %d: %v", len(errs), len(tt.wantErrs), errs)
+			}
+			for i, want := range tt.wantErrs {
+				if !strings.Contains(errs[i].Error(), "exceeded for "+want+":") {
+					t.Errorf("warning %d: got %q, want quota %s exceeded", i, errs[i], want)
+				}
+			}
+		})
+	}
+}
@@ -150,6 +150,9 @@ func init() {
 		"HTTP address to use for pilot's self-monitoring information")
 	discoveryCmd.PersistentFlags().BoolVar(&serverArgs.ServerOptions.EnableProfiling, "profile", true,
 		"Enable profiling via web interface host:port/debug/pprof")
+	discoveryCmd.PersistentFlags().StringToStringVar(&serverArgs.ServerOptions.StaticResources, "staticResources", nil,
+		"Serve the resources of a type from a YAML file instead of generating them, in the form <type URL>=<file>. "+
+			"Intended for testing and bootstrapping")
 
 	// Use TLS certificates if provided.
 	discoveryCmd.PersistentFlags().StringVar(&serverArgs.ServerOptions.TLSOptions.CaCertFile, "caCertFile", "",
@@ -249,10 +249,12 @@ func (s *Server) initStatusController(args *PilotArgs, writeStatus bool) {
 	if writeStatus {
 		s.addTerminatingStartFunc(func(stop <-chan struct{}) error {
 			controller := status.NewController(*s.kubeRestConfig, args.Namespace)
+			acceptance := status.NewAcceptanceController(*s.kubeRestConfig, s.XDSServer.GlobalPushContext)
 			leaderelection.
 				NewLeaderElection(args.Namespace, args.PodName, leaderelection.StatusController, s.kubeClient).
 				AddRunFunction(func(stop <-chan struct{}) {
 					controller.Start(stop)
+					acceptance.Start(stop)
 				}).Run(stop)
 			return nil
 		})
@@ -92,6 +92,10 @@ type DiscoveryServerOptions struct {
 	// The listening address for secured gRPC. If the port in the address is empty or "0" (as in "127.0.0.1:" or "[::1]:0")
 	// a port number is automatically chosen.
 	SecureGRPCAddr string
+
+	// StaticResources maps XDS type URLs to YAML files of resources that are served as is to every proxy,
+	// instead of being generated. Intended for testing and bootstrapping.
+	StaticResources map[string]string
 }
 
 type InjectionOptions struct {
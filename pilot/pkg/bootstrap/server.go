@@ -180,6 +180,9 @@ type Server struct {
 
 // NewServer creates a new Server instance based on the provided arguments.
 func NewServer(args *PilotArgs) (*Server, error) {
+	if err := features.ValidateHTTP2WindowSizes(); err != nil {
+		return nil, err
+	}
 	e := &model.Environment{
 		PushContext:  model.NewPushContext(),
 		DomainSuffix: args.RegistryOptions.KubeOptions.DomainSuffix,
@@ -293,6 +296,10 @@ func NewServer(args *PilotArgs) (*Server, error) {
 
 	s.initSDSServer(args)
 
+	if err := s.initStaticResources(args); err != nil {
+		return nil, fmt.Errorf("error initializing static resources: %v", err)
+	}
+
 	// Notice that the order of authenticators matters, since at runtime
 	// authenticators are activated sequentially and the first successful attempt
 	// is used as the authentication result.
@@ -441,6 +448,19 @@ func (s *Server) initSDSServer(args *PilotArgs) {
 	}
 }
 
+// initStaticResources replaces the generators of the types with static resources configured.
+func (s *Server) initStaticResources(args *PilotArgs) error {
+	for typeURL, file := range args.ServerOptions.StaticResources {
+		gen, err := xds.NewStaticGenerator(typeURL, file)
+		if err != nil {
+			return err
+		}
+		log.Infof("serving static %s resources from %s", typeURL, file)
+		s.XDSServer.Generators[typeURL] = gen
+	}
+	return nil
+}
+
 // initKubeClient creates the k8s client if running in an k8s environment.
 // This is determined by the presence of a kube registry, which
 // uses in-context k8s, or a config source of type k8s.
@@ -794,6 +814,8 @@ func (s *Server) initRegistryEventHandlers() {
 			}: {}},
 			Reason: []model.TriggerReason{model.ServiceUpdate},
 		}
+		// Synthetic DNS service entries depend on the services of the other registries.
+		s.serviceEntryStore.RefreshAutoDNSServiceEntries()
 		s.XDSServer.ConfigUpdate(pushReq)
 	}
 	s.ServiceController().AppendServiceHandler(serviceHandler)
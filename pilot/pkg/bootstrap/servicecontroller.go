@@ -17,6 +17,7 @@ package bootstrap
 import (
 	"fmt"
 
+	"istio.io/istio/pilot/pkg/features"
 	"istio.io/istio/pilot/pkg/model"
 	"istio.io/istio/pilot/pkg/serviceregistry"
 	"istio.io/istio/pilot/pkg/serviceregistry/aggregate"
@@ -59,6 +60,21 @@ func (s *Server) initServiceControllers(args *PilotArgs) error {
 		}
 	}
 
+	if features.EnableAutoDNSServiceEntries {
+		// Synthetic DNS service entries must not shadow the services of the other registries.
+		s.serviceEntryStore.SetMeshHostFunc(func(h host.Name) bool {
+			for _, r := range serviceControllers.GetRegistries() {
+				if r.Provider() == serviceregistry.External {
+					continue
+				}
+				if svc, _ := r.GetService(h); svc != nil {
+					return true
+				}
+			}
+			return false
+		})
+	}
+
 	// Defer running of the service controllers.
 	s.addStartFunc(func(stop <-chan struct{}) error {
 		go serviceControllers.Run(stop)
@@ -16,17 +16,34 @@ package memory
 
 import (
 	"errors"
+	"sync"
 
 	"istio.io/istio/pilot/pkg/model"
 	"istio.io/istio/pkg/config"
 	"istio.io/istio/pkg/config/schema/collection"
+	"istio.io/pkg/log"
 )
 
+// Resyncer is implemented by the controllers of this package.
+type Resyncer interface {
+	// ForceResync re-reads all configs from the store and notifies the event handlers of the difference
+	// with the configs last announced to them: configs still present are announced as updated, new ones
+	// as added, and the ones that are gone as deleted. This is useful when the store was refilled without
+	// going through the controller, for example after reconnecting to a config source.
+	ForceResync()
+}
+
 type controller struct {
 	monitor     Monitor
 	configStore model.ConfigStore
+
+	// announcedMu guards announced, the configs last announced to the event handlers.
+	announcedMu sync.Mutex
+	announced   map[model.ConfigKey]config.Config
 }
 
+var _ Resyncer = &controller{}
+
 // NewController return an implementation of model.ConfigStoreCache
 // This is a client-side monitor that dispatches events as the changes are being
 // made on the client.
@@ -34,6 +51,7 @@ func NewController(cs model.ConfigStore) model.ConfigStoreCache {
 	out := &controller{
 		configStore: cs,
 		monitor:     NewMonitor(cs),
+		announced:   map[model.ConfigKey]config.Config{},
 	}
 	return out
 }
@@ -43,6 +61,7 @@ func NewSyncController(cs model.ConfigStore) model.ConfigStoreCache {
 	out := &controller{
 		configStore: cs,
 		monitor:     NewSyncMonitor(cs),
+		announced:   map[model.ConfigKey]config.Config{},
 	}
 	return out
 }
@@ -70,7 +89,7 @@ func (c *controller) Get(kind config.GroupVersionKind, key, namespace string) *c
 
 func (c *controller) Create(config config.Config) (revision string, err error) {
 	if revision, err = c.configStore.Create(config); err == nil {
-		c.monitor.ScheduleProcessEvent(ConfigEvent{
+		c.announce(ConfigEvent{
 			config: config,
 			event:  model.EventAdd,
 		})
@@ -81,7 +100,7 @@ func (c *controller) Create(config config.Config) (revision string, err error) {
 func (c *controller) Update(config config.Config) (newRevision string, err error) {
 	oldconfig := c.configStore.Get(config.GroupVersionKind, config.Name, config.Namespace)
 	if newRevision, err = c.configStore.Update(config); err == nil {
-		c.monitor.ScheduleProcessEvent(ConfigEvent{
+		c.announce(ConfigEvent{
 			old:    *oldconfig,
 			config: config,
 			event:  model.EventUpdate,
@@ -93,7 +112,7 @@ func (c *controller) Update(config config.Config) (newRevision string, err error
 func (c *controller) UpdateStatus(config config.Config) (newRevision string, err error) {
 	oldconfig := c.configStore.Get(config.GroupVersionKind, config.Name, config.Namespace)
 	if newRevision, err = c.configStore.UpdateStatus(config); err == nil {
-		c.monitor.ScheduleProcessEvent(ConfigEvent{
+		c.announce(ConfigEvent{
 			old:    *oldconfig,
 			config: config,
 			event:  model.EventUpdate,
@@ -109,7 +128,7 @@ func (c *controller) Patch(typ config.GroupVersionKind, name, namespace string,
 	}
 	cfg := patchFn(oldconfig.DeepCopy())
 	if newRevision, err = c.configStore.Update(cfg); err == nil {
-		c.monitor.ScheduleProcessEvent(ConfigEvent{
+		c.announce(ConfigEvent{
 			old:    *oldconfig,
 			config: cfg,
 			event:  model.EventUpdate,
@@ -121,7 +140,7 @@ func (c *controller) Patch(typ config.GroupVersionKind, name, namespace string,
 func (c *controller) Delete(kind config.GroupVersionKind, key, namespace string) (err error) {
 	if config := c.Get(kind, key, namespace); config != nil {
 		if err = c.configStore.Delete(kind, key, namespace); err == nil {
-			c.monitor.ScheduleProcessEvent(ConfigEvent{
+			c.announce(ConfigEvent{
 				config: *config,
 				event:  model.EventDelete,
 			})
@@ -134,3 +153,62 @@ func (c *controller) Delete(kind config.GroupVersionKind, key, namespace string)
 func (c *controller) List(kind config.GroupVersionKind, namespace string) ([]config.Config, error) {
 	return c.configStore.List(kind, namespace)
 }
+
+func (c *controller) ForceResync() {
+	c.announcedMu.Lock()
+	var events []ConfigEvent
+	current := map[model.ConfigKey]struct{}{}
+	for _, s := range c.configStore.Schemas().All() {
+		kind := s.Resource().GroupVersionKind()
+		configs, err := c.configStore.List(kind, model.NamespaceAll)
+		if err != nil {
+			log.Warnf("Failed to list %s for resync: %v", kind, err)
+			// Keep what was announced, rather than deleting configs that could not be listed.
+			for key := range c.announced {
+				if key.Kind == kind {
+					current[key] = struct{}{}
+				}
+			}
+			continue
+		}
+		for _, cfg := range configs {
+			key := configKey(cfg)
+			current[key] = struct{}{}
+			if old, f := c.announced[key]; f {
+				events = append(events, ConfigEvent{old: old, config: cfg, event: model.EventUpdate})
+			} else {
+				events = append(events, ConfigEvent{config: cfg, event: model.EventAdd})
+			}
+			c.announced[key] = cfg
+		}
+	}
+	for key, cfg := range c.announced {
+		if _, f := current[key]; !f {
+			events = append(events, ConfigEvent{config: cfg, event: model.EventDelete})
+			delete(c.announced, key)
+		}
+	}
+	c.announcedMu.Unlock()
+
+	for _, event := range events {
+		c.monitor.ScheduleProcessEvent(event)
+	}
+}
+
+// announce records the config of the event as the last one announced to the event handlers, and
+// schedules the event for them.
+func (c *controller) announce(event ConfigEvent) {
+	key := configKey(event.config)
+	c.announcedMu.Lock()
+	if event.event == model.EventDelete {
+		delete(c.announced, key)
+	} else {
+		c.announced[key] = event.config
+	}
+	c.announcedMu.Unlock()
+	c.monitor.ScheduleProcessEvent(event)
+}
+
+func configKey(cfg config.Config) model.ConfigKey {
+	return model.ConfigKey{Kind: cfg.GroupVersionKind, Name: cfg.Name, Namespace: cfg.Namespace}
+}
@@ -15,10 +15,13 @@
 package memory_test
 
 import (
+	"reflect"
 	"testing"
 
 	"istio.io/istio/pilot/pkg/config/memory"
+	"istio.io/istio/pilot/pkg/model"
 	"istio.io/istio/pilot/test/mock"
+	"istio.io/istio/pkg/config"
 	"istio.io/istio/pkg/config/schema/collections"
 )
 
@@ -45,3 +48,85 @@ func TestControllerClientSync(t *testing.T) {
 	ctl := memory.NewController(store)
 	mock.CheckCacheSync(store, ctl, TestNamespace, 5, t)
 }
+
+// recordEvents registers a handler that records the events the monitor emits for the mock kind, keyed by config name.
+func recordEvents(ctl model.ConfigStoreCache) map[string]model.Event {
+	events := map[string]model.Event{}
+	ctl.RegisterEventHandler(collections.Mock.Resource().GroupVersionKind(), func(_, curr config.Config, event model.Event) {
+		events[curr.Name] = event
+	})
+	return events
+}
+
+func TestControllerForceResync(t *testing.T) {
+	store := memory.Make(collections.Mocks)
+	ctl := memory.NewSyncController(store)
+	kind := collections.Mock.Resource().GroupVersionKind()
+
+	for i := 0; i < 3; i++ {
+		if _, err := ctl.Create(mock.Make(TestNamespace, i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	events := recordEvents(ctl)
+
+	// Write directly to the store, as when it is refilled after a reconnect, so no events are sent.
+	if err := store.Delete(kind, mock.Make(TestNamespace, 0).Name, TestNamespace); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Create(mock.Make(TestNamespace, 3)); err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 0 {
+		t.Fatalf("unexpected events before resync: %v", events)
+	}
+
+	ctl.(memory.Resyncer).ForceResync()
+	want := map[string]model.Event{
+		mock.Make(TestNamespace, 0).Name: model.EventDelete,
+		mock.Make(TestNamespace, 1).Name: model.EventUpdate,
+		mock.Make(TestNamespace, 2).Name: model.EventUpdate,
+		mock.Make(TestNamespace, 3).Name: model.EventAdd,
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Fatalf("got events %v, want %v", events, want)
+	}
+}
+
+func TestControllerForceResyncAllDeleted(t *testing.T) {
+	store := memory.Make(collections.Mocks)
+	ctl := memory.NewSyncController(store)
+	kind := collections.Mock.Resource().GroupVersionKind()
+
+	for i := 0; i < 2; i++ {
+		if _, err := ctl.Create(mock.Make(TestNamespace, i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	events := recordEvents(ctl)
+
+	// Every config was removed while disconnected, which must still be announced.
+	for i := 0; i < 2; i++ {
+		if err := store.Delete(kind, mock.Make(TestNamespace, i).Name, TestNamespace); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctl.(memory.Resyncer).ForceResync()
+	want := map[string]model.Event{
+		mock.Make(TestNamespace, 0).Name: model.EventDelete,
+		mock.Make(TestNamespace, 1).Name: model.EventDelete,
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Fatalf("got events %v, want %v", events, want)
+	}
+
+	// The deletes are only announced once.
+	for name := range events {
+		delete(events, name)
+	}
+	ctl.(memory.Resyncer).ForceResync()
+	if len(events) != 0 {
+		t.Fatalf("unexpected events on a second resync: %v", events)
+	}
+}
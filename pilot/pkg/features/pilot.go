@@ -15,6 +15,7 @@
 package features
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
@@ -53,6 +54,20 @@ var (
 		"Limits the number of concurrent pushes allowed. On larger machines this can be increased for faster pushes",
 	).Get()
 
+	PushSheddingThreshold = env.RegisterIntVar(
+		"PILOT_PUSH_SHEDDING_THRESHOLD",
+		0,
+		"If the number of proxies waiting for a push exceeds this threshold, pushes are sent to the proxies "+
+			"that were pushed least recently first, deferring the others. Zero disables load shedding.",
+	).Get()
+
+	PushGatewayBurst = env.RegisterIntVar(
+		"PILOT_PUSH_GATEWAY_BURST",
+		10,
+		"The number of gateway pushes sent ahead of each waiting sidecar push. Gateways are pushed first, "+
+			"but at most this many in a row while sidecars are waiting, so that sidecars are not starved.",
+	).Get()
+
 	// MaxRecvMsgSize The max receive buffer size of gRPC received channel of Pilot in bytes.
 	MaxRecvMsgSize = env.RegisterIntVar(
 		"ISTIO_GPRC_MAXRECVMSGSIZE",
@@ -97,6 +112,43 @@ var (
 		"Enables the use of HTTP 1.0 in the outbound HTTP listeners, to support legacy applications.",
 	).Get()
 
+	HTTPConnectionIdleTimeout = env.RegisterDurationVar(
+		"PILOT_HTTP_CONNECTION_IDLE_TIMEOUT",
+		0,
+		"If set, the default idle timeout of HTTP connections without active requests, for both downstream "+
+			"connections and upstream clusters. The IDLE_TIMEOUT proxy metadata and DestinationRule "+
+			"connectionPool.http.idleTimeout take precedence.",
+	).Get()
+
+	HTTPStreamIdleTimeout = env.RegisterDurationVar(
+		"PILOT_HTTP_STREAM_IDLE_TIMEOUT",
+		0,
+		"If set, the idle timeout of individual HTTP requests on downstream connections, after which a request "+
+			"seeing no activity is reset. By default, streams have no idle timeout.",
+	).Get()
+
+	HTTP2MaxConcurrentStreams = env.RegisterIntVar(
+		"PILOT_HTTP2_MAX_CONCURRENT_STREAMS",
+		0,
+		"If set, the maximum number of concurrent streams allowed on an HTTP/2 connection, applied to both "+
+			"upstream clusters and inbound listeners. By default upstream connections allow 1073741824 streams, "+
+			"and inbound listeners use the Envoy default.",
+	).Get()
+
+	HTTP2InitialStreamWindowSize = env.RegisterIntVar(
+		"PILOT_HTTP2_INITIAL_STREAM_WINDOW_SIZE",
+		0,
+		"If set, the initial HTTP/2 flow control window size of each stream in bytes, applied to both upstream "+
+			"clusters and inbound listeners. Envoy accepts values between 65535 and 2147483647.",
+	).Get()
+
+	HTTP2InitialConnectionWindowSize = env.RegisterIntVar(
+		"PILOT_HTTP2_INITIAL_CONNECTION_WINDOW_SIZE",
+		0,
+		"If set, the initial HTTP/2 flow control window size of each connection in bytes, applied to both upstream "+
+			"clusters and inbound listeners. Envoy accepts values between 65535 and 2147483647.",
+	).Get()
+
 	initialFetchTimeoutVar = env.RegisterDurationVar(
 		"PILOT_INITIAL_FETCH_TIMEOUT",
 		0,
@@ -184,6 +236,14 @@ var (
 		"If enabled, Wasm-based telemetry will be enabled.",
 	).Get()
 
+	EnableRemoteWasmECDS = env.RegisterBoolVar(
+		"PILOT_ENABLE_REMOTE_WASM_ECDS",
+		false,
+		"If enabled, WASM HTTP filters with a remote code source inserted by EnvoyFilters are served over ECDS, "+
+			"so that fetching the module does not block listener updates. Only applies to proxies of version 1.9 "+
+			"and above; older proxies keep receiving the filter inline.",
+	).Get()
+
 	ScopeGatewayToNamespace = env.RegisterBoolVar(
 		"PILOT_SCOPE_GATEWAY_TO_NAMESPACE",
 		false,
@@ -277,6 +337,20 @@ var (
 			"See https://godoc.org/k8s.io/client-go/rest#Config Burst",
 	).Get()
 
+	StatusBatchInterval = env.RegisterDurationVar(
+		"PILOT_STATUS_BATCH_INTERVAL",
+		time.Second,
+		"If status is enabled, controls how often queued status updates are written. Updates to the same "+
+			"resource within an interval are coalesced, so each resource is written at most once per interval.",
+	).Get()
+
+	StatusMaxBatchSize = env.RegisterIntVar(
+		"PILOT_STATUS_MAX_BATCH_SIZE",
+		100,
+		"If status is enabled, controls the maximum number of status updates written per batch interval. "+
+			"Remaining updates are written in later intervals.",
+	).Get()
+
 	// IstiodServiceCustomHost allow user to bring a custom address for istiod server
 	// for examples: istiod.mycompany.com
 	IstiodServiceCustomHost = env.RegisterStringVar("ISTIOD_CUSTOM_HOST", "",
@@ -342,6 +416,9 @@ var (
 	EnableK8SServiceSelectWorkloadEntries = env.RegisterBoolVar("PILOT_ENABLE_K8S_SELECT_WORKLOAD_ENTRIES", true,
 		"If enabled, Kubernetes services with selectors will select workload entries with matching labels. "+
 			"It is safe to disable it if you are quite sure you don't need this feature").Get()
+	EnableAutoDNSServiceEntries = env.RegisterBoolVar("PILOT_ENABLE_AUTO_DNS_SERVICE_ENTRIES", false,
+		"If enabled, external hosts that VirtualServices route to but that no ServiceEntry declares "+
+			"get a synthetic DNS resolved ServiceEntry, so that routing to them works.").Get()
 	InjectionWebhookConfigName = env.RegisterStringVar("INJECTION_WEBHOOK_CONFIG_NAME", "istio-sidecar-injector",
 		"Name of the mutatingwebhookconfiguration to patch, if istioctl is not used.")
 
@@ -357,6 +434,10 @@ var (
 	EnableXDSCaching = env.RegisterBoolVar("PILOT_ENABLE_XDS_CACHE", true,
 		"If true, Pilot will cache XDS responses.").Get()
 
+	EnableRDSCaching = env.RegisterBoolVar("PILOT_ENABLE_RDS_CACHE", true,
+		"If true, Pilot will cache outbound sidecar route configurations and reuse them across proxies with the same "+
+			"inputs. Has no effect if PILOT_ENABLE_XDS_CACHE is not set.").Get()
+
 	EnableXDSCacheMetrics = env.RegisterBoolVar("PILOT_XDS_CACHE_STATS", false,
 		"If true, Pilot will collect metrics for XDS cache efficiency.").Get()
 
@@ -384,10 +465,32 @@ var (
 		"The timeout to send the XDS configuration to proxies. After this timeout is reached, Pilot will discard that push.",
 	).Get()
 
+	XDSWarmupTimeout = env.RegisterDurationVar(
+		"PILOT_XDS_WARMUP_TIMEOUT",
+		0,
+		"If set, XDS streams established before Istiod has completed its initial sync of services and config "+
+			"are held for up to this duration waiting for the sync, instead of being rejected immediately.",
+	).Get()
+
+	XDSNonceFormat = env.RegisterStringVar("PILOT_XDS_NONCE_FORMAT", "uuid",
+		"The format of the unique part of nonces sent in XDS responses, following the push version. "+
+			"Supported values are \"uuid\" and \"counter\", a per-process monotonically increasing counter.",
+	).Get()
+
 	EndpointTelemetryLabel = env.RegisterBoolVar("PILOT_ENDPOINT_TELEMETRY_LABEL", true,
 		"If true, pilot will add telemetry related metadata to Endpoint resource, which will be consumed by telemetry filter.",
 	).Get()
 
+	EnableEDSHealthGating = env.RegisterBoolVar("PILOT_ENABLE_EDS_HEALTH_GATING", false,
+		"If enabled, endpoints are only included in EDS once the service registry has marked them as healthy. "+
+			"Endpoints failing their health check are always excluded.",
+	).Get()
+
+	EDSHealthGatingFailOpen = env.RegisterBoolVar("PILOT_EDS_HEALTH_GATING_FAIL_OPEN", false,
+		"If true, endpoints which have not been health checked yet are included in EDS (fail-open). Otherwise they are "+
+			"excluded until they are marked as healthy (fail-closed). Depends on PILOT_ENABLE_EDS_HEALTH_GATING.",
+	).Get()
+
 	WorkloadEntryAutoRegistration = env.RegisterBoolVar("PILOT_ENABLE_WORKLOAD_ENTRY_AUTOREGISTRATION", false,
 		"Enables auto-registering WorkloadEntries based on associated WorkloadGroups upon XDS connection by the workload.").Get()
 
@@ -412,7 +515,51 @@ var (
 		"If set, the max amount of time to delay a push by. Depends on PILOT_ENABLE_FLOW_CONTROL.",
 	).Get()
 
+	MinimumProxyVersion = env.RegisterStringVar("PILOT_MINIMUM_PROXY_VERSION", "",
+		"If set, proxies reporting an ISTIO_VERSION older than this version (for example 1.8) are refused when they "+
+			"connect, instead of being sent configuration they may not understand. Proxies without a version are "+
+			"always accepted. By default, all versions are accepted and config is generated for the proxy version.").Get()
+
+	XDSIdleTimeout = env.RegisterDurationVar("PILOT_XDS_IDLE_TIMEOUT", 0,
+		"If set, XDS connections which have not sent their first request, or have not ACKed or NACKed a response, "+
+			"for this duration are closed. This cleans up leaked streams; healthy proxies reconnect. Connections "+
+			"without pending responses are never closed. By default, idle connections are kept.").Get()
+
+	MaxStreamsPerIP = env.RegisterIntVar("PILOT_MAX_STREAMS_PER_IP", 0,
+		"If set, limits the number of concurrent XDS streams accepted from a single proxy IP. Excess streams are "+
+			"rejected, protecting istiod from a misbehaving proxy opening many streams. The limit applies to the source "+
+			"IP seen by istiod, so all proxies behind a NAT, or connecting through an east-west gateway, share it; set it "+
+			"above the number of such proxies. By default, there is no limit.").Get()
+
+	OrderRouteMatchesBySpecificity = env.RegisterBoolVar("PILOT_ORDER_ROUTE_MATCHES_BY_SPECIFICITY", false,
+		"If enabled, the match conditions of each HTTP route of a virtual service are emitted from most to least "+
+			"specific (exact, longest prefix, regex, catch all) rather than in the order they are written. Enabling "+
+			"it changes the generated routes of existing virtual services.").Get()
+
 	PilotEnableLoopBlockers = env.RegisterBoolVar("PILOT_ENABLE_LOOP_BLOCKER", true,
 		"If enabled, Envoy will be configured to prevent traffic directly the the inbound/outbound "+
 			"ports (15001/15006). This prevents traffic loops. This option will be removed, and considered always enabled, in 1.9.").Get()
 )
+
+const (
+	// minHTTP2WindowSize and maxHTTP2WindowSize bound the HTTP/2 initial window sizes Envoy accepts.
+	minHTTP2WindowSize = 65535
+	maxHTTP2WindowSize = 2147483647
+)
+
+// ValidateHTTP2WindowSizes returns an error if an HTTP/2 initial window size is set outside of the range
+// Envoy accepts, which would otherwise get every cluster and listener using it rejected.
+func ValidateHTTP2WindowSizes() error {
+	for _, v := range []struct {
+		name string
+		size int
+	}{
+		{"PILOT_HTTP2_INITIAL_STREAM_WINDOW_SIZE", HTTP2InitialStreamWindowSize},
+		{"PILOT_HTTP2_INITIAL_CONNECTION_WINDOW_SIZE", HTTP2InitialConnectionWindowSize},
+	} {
+		if v.size != 0 && (v.size < minHTTP2WindowSize || v.size > maxHTTP2WindowSize) {
+			return fmt.Errorf("%s is %d, it must be between %d and %d", v.name, v.size, minHTTP2WindowSize, maxHTTP2WindowSize)
+		}
+	}
+	return nil
+}
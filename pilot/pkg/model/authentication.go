@@ -219,6 +219,24 @@ func (policy *AuthenticationPolicies) GetRootNamespace() string {
 	return policy.rootNamespace
 }
 
+// requestAuthenticationConfigs returns all RequestAuthentication configs tracked by the policy object.
+func (policy *AuthenticationPolicies) requestAuthenticationConfigs() []config.Config {
+	return flattenConfigs(policy.requestAuthentications)
+}
+
+// peerAuthenticationConfigs returns all PeerAuthentication configs tracked by the policy object.
+func (policy *AuthenticationPolicies) peerAuthenticationConfigs() []config.Config {
+	return flattenConfigs(policy.peerAuthentications)
+}
+
+func flattenConfigs(configsByNamespace map[string][]config.Config) []config.Config {
+	out := make([]config.Config, 0)
+	for _, configs := range configsByNamespace {
+		out = append(out, configs...)
+	}
+	return out
+}
+
 func getConfigsForWorkload(configsByNamespace map[string][]config.Config,
 	rootNamespace string,
 	namespace string,
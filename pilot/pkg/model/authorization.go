@@ -16,6 +16,7 @@ package model
 
 import (
 	authpb "istio.io/api/security/v1beta1"
+	"istio.io/istio/pkg/config"
 	"istio.io/istio/pkg/config/labels"
 	"istio.io/istio/pkg/config/schema/collections"
 	istiolog "istio.io/pkg/log"
@@ -42,15 +43,20 @@ type AuthorizationPolicies struct {
 
 // GetAuthorizationPolicies returns the AuthorizationPolicies for the given environment.
 func GetAuthorizationPolicies(env *Environment) (*AuthorizationPolicies, error) {
-	policy := &AuthorizationPolicies{
-		NamespaceToPolicies: map[string][]AuthorizationPolicy{},
-		RootNamespace:       env.Mesh().GetRootNamespace(),
-	}
-
 	policies, err := env.List(collections.IstioSecurityV1Beta1Authorizationpolicies.Resource().GroupVersionKind(), NamespaceAll)
 	if err != nil {
 		return nil, err
 	}
+	return newAuthorizationPolicies(env.Mesh().GetRootNamespace(), policies), nil
+}
+
+// newAuthorizationPolicies organizes the AuthorizationPolicy configs by namespace.
+func newAuthorizationPolicies(rootNamespace string, policies []config.Config) *AuthorizationPolicies {
+	policy := &AuthorizationPolicies{
+		NamespaceToPolicies: map[string][]AuthorizationPolicy{},
+		RootNamespace:       rootNamespace,
+	}
+
 	sortConfigByCreationTime(policies)
 	for _, config := range policies {
 		authzConfig := AuthorizationPolicy{
@@ -62,7 +68,7 @@ func GetAuthorizationPolicies(env *Environment) (*AuthorizationPolicies, error)
 			append(policy.NamespaceToPolicies[config.Namespace], authzConfig)
 	}
 
-	return policy, nil
+	return policy
 }
 
 type AuthorizationPoliciesResult struct {
@@ -72,6 +78,23 @@ type AuthorizationPoliciesResult struct {
 	Audit  []AuthorizationPolicy
 }
 
+// customProviders returns the names of the extension providers referenced by CUSTOM policies in the
+// namespace or the root namespace.
+func (policy *AuthorizationPolicies) customProviders(namespace string) map[string]bool {
+	if policy == nil {
+		return nil
+	}
+	out := map[string]bool{}
+	for _, ns := range []string{policy.RootNamespace, namespace} {
+		for _, config := range policy.NamespaceToPolicies[ns] {
+			if config.Spec.GetAction() == authpb.AuthorizationPolicy_CUSTOM {
+				out[config.Spec.GetProvider().GetName()] = true
+			}
+		}
+	}
+	return out
+}
+
 // ListAuthorizationPolicies returns authorization policies applied to the workload in the given namespace.
 func (policy *AuthorizationPolicies) ListAuthorizationPolicies(namespace string, workload labels.Collection) AuthorizationPoliciesResult {
 	ret := AuthorizationPoliciesResult{}
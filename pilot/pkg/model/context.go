@@ -148,8 +148,17 @@ func (e *Environment) SetLedger(l ledger.Ledger) {
 	e.ledger = l
 }
 
-// Request is an alias for array of marshaled resources.
-type Resources = []*any.Any
+// Resources is an alias for array of marshaled resources, each with the name clients reference it by.
+type Resources = []*discovery.Resource
+
+// ResourcesToAny returns the marshaled resources, without their names.
+func ResourcesToAny(r Resources) []*any.Any {
+	a := make([]*any.Any, 0, len(r))
+	for _, rr := range r {
+		a = append(a, rr.Resource)
+	}
+	return a
+}
 
 // XdsUpdates include information about the subset of updated resources.
 // See for example EDS incremental updates.
@@ -216,6 +225,9 @@ type Proxy struct {
 	// Istio version associated with the Proxy
 	IstioVersion *IstioVersion
 
+	// XDSCapabilities are the optional XDS features the proxy advertised support for in its metadata.
+	XDSCapabilities XDSCapabilities
+
 	// VerifiedIdentity determines whether a proxy had its identity verified. This
 	// generally occurs by JWT or mTLS authentication. This can be false when
 	// connecting over plaintext. If this is set to true, we can verify the proxy has
@@ -276,6 +288,9 @@ type WatchedResource struct {
 	// LastSent tracks the time of the generated push, to determine the time it takes the client to ack.
 	LastSent time.Time
 
+	// AckLatency is the time between sending the last ACKed response and receiving its ACK.
+	AckLatency time.Duration
+
 	// Updates count the number of generated updates for the resource
 	Updates int
 
@@ -516,6 +531,14 @@ type NodeMetadata struct {
 	// UnprivilegedPod is used to determine whether a Gateway Pod can open ports < 1024
 	UnprivilegedPod string `json:"UNPRIVILEGED_POD,omitempty"`
 
+	// ProxyProtocol enables the PROXY protocol listener filter on gateway listeners, for gateways behind an
+	// L4 load balancer that sends the original client address using PROXY protocol v1 or v2.
+	ProxyProtocol StringBool `json:"PROXY_PROTOCOL,omitempty"`
+
+	// XDSCapabilities lists optional XDS features supported by the proxy, for example "delta".
+	// The server only uses these features for proxies that advertise them.
+	XDSCapabilities StringList `json:"XDS_CAPABILITIES,omitempty"`
+
 	// Contains a copy of the raw metadata. This is needed to lookup arbitrary values.
 	// If a value is known ahead of time it should be added to the struct rather than reading from here,
 	Raw map[string]interface{} `json:"-"`
@@ -601,6 +624,17 @@ func (pversion *IstioVersion) Compare(inv *IstioVersion) int {
 	return 0
 }
 
+// AtLeast returns true if the version is greater than or equal to inv. A nil version is unknown and,
+// like dev builds, compares as the newest version.
+func (pversion *IstioVersion) AtLeast(inv *IstioVersion) bool {
+	return pversion == nil || pversion.Compare(inv) >= 0
+}
+
+// InRange returns true if the version is in the half open range [min, max).
+func (pversion *IstioVersion) InRange(min, max *IstioVersion) bool {
+	return pversion.AtLeast(min) && !pversion.AtLeast(max)
+}
+
 func compareVersion(ov, nv int) int {
 	if ov == nv {
 		return 0
@@ -836,13 +870,47 @@ func ParseServiceNodeWithMetadata(s string, metadata *NodeMetadata) (*Proxy, err
 		log.Warnf("Istio Version is not found in metadata for %v, which may have undesirable side effects", out.ID)
 	}
 	out.IstioVersion = ParseIstioVersion(metadata.IstioVersion)
+	out.XDSCapabilities = ParseXDSCapabilities(metadata.XDSCapabilities)
 	return out, nil
 }
 
+// XDSCapability is an optional XDS feature a proxy can advertise support for.
+type XDSCapability string
+
+const (
+	// XDSCapabilityDelta indicates the proxy can consume the incremental (delta) XDS protocol.
+	XDSCapabilityDelta XDSCapability = "delta"
+)
+
+// XDSCapabilities is the set of XDS capabilities advertised by a proxy.
+type XDSCapabilities map[XDSCapability]struct{}
+
+// ParseXDSCapabilities parses the capabilities listed in node metadata. Entries are case insensitive
+// and unknown entries are kept, so that newer proxies can advertise features this server does not use.
+func ParseXDSCapabilities(l StringList) XDSCapabilities {
+	if len(l) == 0 {
+		return nil
+	}
+	out := XDSCapabilities{}
+	for _, c := range l {
+		c = strings.ToLower(strings.TrimSpace(c))
+		if c != "" {
+			out[XDSCapability(c)] = struct{}{}
+		}
+	}
+	return out
+}
+
+// Has returns true if the capability was advertised.
+func (c XDSCapabilities) Has(capability XDSCapability) bool {
+	_, f := c[capability]
+	return f
+}
+
 // ParseIstioVersion parses a version string and returns IstioVersion struct
 func ParseIstioVersion(ver string) *IstioVersion {
 	// strip the release- prefix if any and extract the version string
-	ver = istioVersionRegexp.FindString(strings.TrimPrefix(ver, "release-"))
+	ver = istioVersionRegexp.FindString(strings.TrimPrefix(strings.TrimSpace(ver), "release-"))
 
 	if ver == "" {
 		// return very large values assuming latest version
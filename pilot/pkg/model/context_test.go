@@ -168,6 +168,19 @@ func TestStringList(t *testing.T) {
 	}
 }
 
+func TestParseXDSCapabilities(t *testing.T) {
+	caps := model.ParseXDSCapabilities(model.StringList{"Delta", " ecds", ""})
+	if !caps.Has(model.XDSCapabilityDelta) || !caps.Has("ecds") {
+		t.Fatalf("expected delta and ecds capabilities, got %v", caps)
+	}
+	if caps.Has("unknown") {
+		t.Fatalf("unexpected capability in %v", caps)
+	}
+	if model.ParseXDSCapabilities(nil).Has(model.XDSCapabilityDelta) {
+		t.Fatalf("expected no capabilities without metadata")
+	}
+}
+
 func TestPodPortList(t *testing.T) {
 	cases := []struct {
 		name   string
@@ -511,6 +524,45 @@ func Test_parseIstioVersion(t *testing.T) {
 	}
 }
 
+func TestIstioVersionRanges(t *testing.T) {
+	v18 := &model.IstioVersion{Major: 1, Minor: 8, Patch: -1}
+	v19 := &model.IstioVersion{Major: 1, Minor: 9, Patch: -1}
+	tests := []struct {
+		version string
+		atLeast bool
+		inRange bool
+	}{
+		{"1.7.5", false, false},
+		{"1.8", true, true},
+		{"1.8.3", true, true},
+		{" 1.8.1 ", true, true},
+		{"release-1.8-20201020", true, true},
+		{"1.9.0", true, false},
+		{"1.10.0", true, false},
+		{"2.0.0", true, false},
+		// dev and unknown versions compare as the newest version
+		{"master-123214234", true, false},
+		{"", true, false},
+		{"junk-garbage", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			v := model.ParseIstioVersion(tt.version)
+			if got := v.AtLeast(v18); got != tt.atLeast {
+				t.Errorf("%v.AtLeast(1.8) = %v, want %v", v, got, tt.atLeast)
+			}
+			if got := v.InRange(v18, v19); got != tt.inRange {
+				t.Errorf("%v.InRange(1.8, 1.9) = %v, want %v", v, got, tt.inRange)
+			}
+		})
+	}
+
+	var unknown *model.IstioVersion
+	if !unknown.AtLeast(model.MaxIstioVersion) {
+		t.Errorf("expected a nil version to compare as the newest version")
+	}
+}
+
 func TestSetServiceInstances(t *testing.T) {
 	tnow := time.Now()
 	instances := []*model.ServiceInstance{
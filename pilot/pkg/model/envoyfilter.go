@@ -17,16 +17,21 @@ import (
 	"regexp"
 	"strings"
 
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	"github.com/gogo/protobuf/proto"
 
 	networking "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/features"
 	"istio.io/istio/pkg/config"
 	"istio.io/istio/pkg/config/labels"
+	"istio.io/istio/pkg/config/schema/gvk"
 	"istio.io/istio/pkg/config/xds"
 )
 
 // EnvoyFilterWrapper is a wrapper for the EnvoyFilter api object with pre-processed data
 type EnvoyFilterWrapper struct {
+	Name             string
+	Namespace        string
 	workloadSelector labels.Instance
 	Patches          map[networking.EnvoyFilter_ApplyTo][]*EnvoyFilterConfigPatchWrapper
 }
@@ -44,6 +49,11 @@ type EnvoyFilterConfigPatchWrapper struct {
 	// regex match, but as an optimization we can reduce this to a prefix match for common cases.
 	// If this is set, ProxyVersionRegex is ignored.
 	ProxyPrefixMatch string
+	// ExtensionConfig is the filter configuration served over ECDS when Value only references
+	// it by name, as is done for WASM filters with a remote code source.
+	ExtensionConfig *core.TypedExtensionConfig
+	// inlineValue is the original filter, used instead of Value for proxies that do not support ECDS.
+	inlineValue proto.Message
 }
 
 // wellKnownVersions defines a mapping of well known regex matches to prefix matches
@@ -59,11 +69,13 @@ var wellKnownVersions = map[string]string{
 	// Hopefully we have a better API by 1.10. If not, add it here
 }
 
-// convertToEnvoyFilterWrapper converts from EnvoyFilter config to EnvoyFilterWrapper object
-func convertToEnvoyFilterWrapper(local *config.Config) *EnvoyFilterWrapper {
+// convertToEnvoyFilterWrapper converts from EnvoyFilter config to EnvoyFilterWrapper object.
+// Patches that fail to convert are counted in rejections, if set.
+func convertToEnvoyFilterWrapper(local *config.Config, rejections configRejectionCounts) *EnvoyFilterWrapper {
 	localEnvoyFilter := local.Spec.(*networking.EnvoyFilter)
 
-	out := &EnvoyFilterWrapper{}
+	failOpen := local.Annotations[WasmFailOpenAnnotation] != "false"
+	out := &EnvoyFilterWrapper{Name: local.Name, Namespace: local.Namespace}
 	if localEnvoyFilter.WorkloadSelector != nil {
 		out.workloadSelector = localEnvoyFilter.WorkloadSelector.Labels
 	}
@@ -82,6 +94,10 @@ func convertToEnvoyFilterWrapper(local *config.Config) *EnvoyFilterWrapper {
 		// Should only happen in tests or without validation
 		if err != nil {
 			log.Errorf("failed to build envoy filter value: %v", err)
+			rejections.add(gvk.EnvoyFilter, "invalid_patch")
+		}
+		if cp.ApplyTo == networking.EnvoyFilter_HTTP_FILTER && features.EnableRemoteWasmECDS {
+			toExtensionConfigPatch(cpw, failOpen)
 		}
 		if cp.Match == nil {
 			// create a match all object
@@ -17,6 +17,9 @@ package model
 import (
 	"testing"
 
+	"github.com/gogo/protobuf/types"
+	"go.opencensus.io/stats/view"
+
 	networking "istio.io/api/networking/v1alpha3"
 	"istio.io/istio/pkg/config"
 )
@@ -98,7 +101,7 @@ func TestEnvoyFilterMatch(t *testing.T) {
 		got := convertToEnvoyFilterWrapper(&config.Config{
 			Meta: config.Meta{},
 			Spec: tt.config,
-		})
+		}, nil)
 		if len(got.Patches[networking.EnvoyFilter_INVALID]) != 1 {
 			t.Fatalf("unexpected patches: %v", got.Patches)
 		}
@@ -114,3 +117,47 @@ func TestEnvoyFilterMatch(t *testing.T) {
 		}
 	}
 }
+
+func TestEnvoyFilterRejectionMetric(t *testing.T) {
+	rejections := func() float64 {
+		rows, err := view.RetrieveData("pilot_config_rejections")
+		if err != nil {
+			t.Fatalf("failed to get value for pilot_config_rejections: %v", err)
+		}
+		for _, row := range rows {
+			tags := map[string]string{}
+			for _, tag := range row.Tags {
+				tags[tag.Key.Name()] = tag.Value
+			}
+			if tags["type"] == "EnvoyFilter" && tags["reason"] == "invalid_patch" {
+				return row.Data.(*view.LastValueData).Value
+			}
+		}
+		return 0
+	}
+
+	ps := NewPushContext()
+	convertToEnvoyFilterWrapper(&config.Config{
+		Meta: config.Meta{Name: "invalid", Namespace: "default"},
+		Spec: &networking.EnvoyFilter{
+			ConfigPatches: []*networking.EnvoyFilter_EnvoyConfigObjectPatch{{
+				ApplyTo: networking.EnvoyFilter_INVALID,
+				Patch: &networking.EnvoyFilter_Patch{
+					Operation: networking.EnvoyFilter_Patch_MERGE,
+					Value:     &types.Struct{},
+				},
+			}},
+		},
+	}, ps.rejections())
+	// Recording the same push context repeatedly does not grow the count.
+	ps.UpdateMetrics()
+	ps.UpdateMetrics()
+	if got := rejections(); got != 1 {
+		t.Fatalf("expected the rejection to be counted once, got %v", got)
+	}
+
+	NewPushContext().UpdateMetrics()
+	if got := rejections(); got != 0 {
+		t.Fatalf("expected the rejection to be reset, got %v", got)
+	}
+}
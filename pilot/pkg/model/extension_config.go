@@ -0,0 +1,121 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	rbac "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	rbachttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	wasm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/wasm/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+
+	networking "istio.io/api/networking/v1alpha3"
+)
+
+const (
+	// WasmFailOpenAnnotation can be set to "false" on an EnvoyFilter to reject traffic when a remote
+	// WASM module it inserts cannot be fetched. By default such filters fail open.
+	WasmFailOpenAnnotation = "wasm.istio.io/fail-open"
+
+	wasmFilterType = "type.googleapis.com/envoy.extensions.filters.http.wasm.v3.Wasm"
+	rbacFilterType = "type.googleapis.com/envoy.extensions.filters.http.rbac.v3.RBAC"
+)
+
+// ecdsMinimumVersion is the first proxy version that supports HTTP filters served over ECDS.
+var ecdsMinimumVersion = &IstioVersion{Major: 1, Minor: 9, Patch: -1}
+
+// toExtensionConfigPatch rewrites an HTTP filter patch that inserts a WASM filter with a remote code
+// source so that the listener only references the filter by name, and the filter configuration itself
+// is served over ECDS. This keeps slow or failing module fetches from blocking listener updates. The
+// listener still waits for the module to be fetched; the default config only applies if that fails.
+func toExtensionConfigPatch(cpw *EnvoyFilterConfigPatchWrapper, failOpen bool) {
+	filter, ok := cpw.Value.(*hcm.HttpFilter)
+	if !ok || filter.GetTypedConfig().GetTypeUrl() != wasmFilterType {
+		return
+	}
+	w := &wasm.Wasm{}
+	if err := ptypes.UnmarshalAny(filter.GetTypedConfig(), w); err != nil {
+		log.Errorf("failed to unmarshal wasm filter %s: %v", filter.Name, err)
+		return
+	}
+	if w.GetConfig().GetVmConfig().GetCode().GetRemote() == nil {
+		return
+	}
+	defaultConfig, err := wasmDefaultConfig(failOpen)
+	if err != nil {
+		log.Errorf("failed to build default config for wasm filter %s: %v", filter.Name, err)
+		return
+	}
+
+	cpw.inlineValue = cpw.Value
+	cpw.ExtensionConfig = &core.TypedExtensionConfig{
+		Name:        filter.Name,
+		TypedConfig: filter.GetTypedConfig(),
+	}
+	cpw.Value = &hcm.HttpFilter{
+		Name: filter.Name,
+		ConfigType: &hcm.HttpFilter_ConfigDiscovery{
+			ConfigDiscovery: &core.ExtensionConfigSource{
+				ConfigSource: &core.ConfigSource{
+					ConfigSourceSpecifier: &core.ConfigSource_Ads{
+						Ads: &core.AggregatedConfigSource{},
+					},
+					ResourceApiVersion: core.ApiVersion_V3,
+				},
+				DefaultConfig: defaultConfig,
+				TypeUrls:      []string{wasmFilterType, rbacFilterType},
+			},
+		},
+	}
+}
+
+// wasmDefaultConfig returns the filter applied until the remote module has been fetched, or when
+// fetching it fails. An RBAC filter without rules allows all requests, while one with an empty
+// ALLOW policy set denies all of them.
+func wasmDefaultConfig(failOpen bool) (*any.Any, error) {
+	if failOpen {
+		return ptypes.MarshalAny(&rbachttp.RBAC{})
+	}
+	return ptypes.MarshalAny(&rbachttp.RBAC{Rules: &rbac.RBAC{}})
+}
+
+// forProxy returns the patch to apply to the proxy, which inlines the filter again if the proxy does
+// not support ECDS.
+func (cpw *EnvoyFilterConfigPatchWrapper) forProxy(proxy *Proxy) *EnvoyFilterConfigPatchWrapper {
+	if cpw.ExtensionConfig == nil || proxy.IstioVersion.AtLeast(ecdsMinimumVersion) {
+		return cpw
+	}
+	inline := *cpw
+	inline.Value = cpw.inlineValue
+	inline.ExtensionConfig = nil
+	return &inline
+}
+
+// ExtensionConfigs returns the extension configs served over ECDS to the given proxy, keyed by name.
+func (ps *PushContext) ExtensionConfigs(proxy *Proxy) map[string]*core.TypedExtensionConfig {
+	efw := ps.EnvoyFilters(proxy)
+	if efw == nil {
+		return nil
+	}
+	out := map[string]*core.TypedExtensionConfig{}
+	for _, cp := range efw.Patches[networking.EnvoyFilter_HTTP_FILTER] {
+		if cp.ExtensionConfig != nil {
+			out[cp.ExtensionConfig.Name] = cp.ExtensionConfig
+		}
+	}
+	return out
+}
@@ -0,0 +1,93 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	meshconfig "istio.io/api/mesh/v1alpha1"
+	"istio.io/istio/pkg/config/host"
+)
+
+// ExtensionProviderService resolves the service backing a mesh config extension provider. The service
+// is either given as <Namespace>/<Hostname>, or as <Hostname> if the hostname is unique across namespaces.
+func (ps *PushContext) ExtensionProviderService(service string) (*Service, error) {
+	if parts := strings.Split(service, "/"); len(parts) == 2 {
+		namespace, name := parts[0], parts[1]
+		if svc := ps.ServiceIndex.HostnameAndNamespace[host.Name(name)][namespace]; svc != nil {
+			return svc, nil
+		}
+	} else {
+		namespaceToServices := ps.ServiceIndex.HostnameAndNamespace[host.Name(service)]
+		var namespaces []string
+		for k := range namespaceToServices {
+			namespaces = append(namespaces, k)
+		}
+		// If namespace is omitted, return successfully if there is only one such host name in the service index.
+		if len(namespaces) == 1 {
+			return namespaceToServices[namespaces[0]], nil
+		} else if len(namespaces) > 1 {
+			sort.Strings(namespaces)
+			return nil, fmt.Errorf("found %s in multiple namespaces %v, specify the namespace explicitly in "+
+				"the format of <Namespace>/<Hostname>", service, namespaces)
+		}
+	}
+	return nil, fmt.Errorf("could not find service %s in Istio service registry", service)
+}
+
+// extensionProviderServices returns the services backing the extension providers referenced by CUSTOM
+// authorization policies in the namespace or the root namespace, and visible from the namespace.
+// Providers that cannot be resolved are skipped; the filters referencing them report the error.
+func (ps *PushContext) extensionProviderServices(namespace string) []*Service {
+	if ps.Mesh == nil || len(ps.Mesh.ExtensionProviders) == 0 {
+		return nil
+	}
+	referenced := ps.AuthzPolicies.customProviders(namespace)
+	if len(referenced) == 0 {
+		return nil
+	}
+	var out []*Service
+	for _, provider := range ps.Mesh.ExtensionProviders {
+		if !referenced[provider.Name] {
+			continue
+		}
+		var service string
+		switch p := provider.Provider.(type) {
+		case *meshconfig.MeshConfig_ExtensionProvider_EnvoyExtAuthzHttp:
+			service = p.EnvoyExtAuthzHttp.GetService()
+		case *meshconfig.MeshConfig_ExtensionProvider_EnvoyExtAuthzGrpc:
+			service = p.EnvoyExtAuthzGrpc.GetService()
+		}
+		if service == "" {
+			continue
+		}
+		if svc, err := ps.ExtensionProviderService(service); err == nil && ps.serviceVisibleFrom(svc, namespace) {
+			out = append(out, svc)
+		}
+	}
+	return out
+}
+
+// serviceVisibleFrom returns true if the service is exported to the namespace.
+func (ps *PushContext) serviceVisibleFrom(svc *Service, namespace string) bool {
+	for _, s := range ps.Services(&Proxy{ConfigNamespace: namespace}) {
+		if s == svc {
+			return true
+		}
+	}
+	return false
+}
@@ -51,6 +51,10 @@ type MergedGateway struct {
 
 	// SNIHostsByServer maps server to SNI Hosts so that recomputation is avoided on listener generation.
 	SNIHostsByServer map[*networking.Server][]string
+
+	// RejectedServers maps servers that could not be merged, because they conflict with a server
+	// already bound to the same port, to the reason they were rejected.
+	RejectedServers map[*networking.Server]string
 }
 
 var (
@@ -86,6 +90,7 @@ func MergeGateways(gateways ...config.Config) *MergedGateway {
 	gatewayNameForServer := make(map[*networking.Server]string)
 	tlsHostsByPort := map[uint32]map[string]struct{}{} // port -> host -> exists
 	sniHostsByServer := make(map[*networking.Server][]string)
+	rejectedServers := make(map[*networking.Server]string)
 
 	log.Debugf("MergeGateways: merging %d gateways", len(gateways))
 	for _, gatewayConfig := range gateways {
@@ -120,6 +125,7 @@ func MergeGateways(gateways ...config.Config) *MergedGateway {
 				if duplicateHosts := checkDuplicates(s.Hosts, tlsHostsByPort[s.Port.Number]); len(duplicateHosts) != 0 {
 					log.Debugf("skipping server on gateway %s, duplicate host names: %v", gatewayName, duplicateHosts)
 					recordRejectedConfig(gatewayName)
+					rejectedServers[s] = fmt.Sprintf("duplicate host names on port %d: %v", s.Port.Number, duplicateHosts)
 					continue
 				}
 			}
@@ -137,6 +143,7 @@ func MergeGateways(gateways ...config.Config) *MergedGateway {
 						log.Debugf("skipping server on gateway %s port %s.%d.%s: conflict with existing server %s.%d.%s",
 							gatewayConfig.Name, s.Port.Name, s.Port.Number, s.Port.Protocol, server[0].Port.Name, server[0].Port.Number, server[0].Port.Protocol)
 						recordRejectedConfig(gatewayName)
+						rejectedServers[s] = fmt.Sprintf("protocol %s conflicts with %s on port %d", s.Port.Protocol, server[0].Port.Protocol, s.Port.Number)
 						continue
 					}
 					routeName := gatewayRDSRouteName(s, gatewayConfig)
@@ -144,6 +151,7 @@ func MergeGateways(gateways ...config.Config) *MergedGateway {
 						log.Debugf("skipping server on gateway %s port %s.%d.%s: could not build RDS name from server",
 							gatewayConfig.Name, s.Port.Name, s.Port.Number, s.Port.Protocol)
 						recordRejectedConfig(gatewayName)
+						rejectedServers[s] = fmt.Sprintf("could not build RDS name for port %d", s.Port.Number)
 						continue
 					}
 					serversByRouteName[routeName] = append(serversByRouteName[routeName], s)
@@ -157,6 +165,7 @@ func MergeGateways(gateways ...config.Config) *MergedGateway {
 							log.Debugf("skipping server on gateway %s port %s.%d.%s: could not build RDS name from server",
 								gatewayConfig.Name, s.Port.Name, s.Port.Number, s.Port.Protocol)
 							recordRejectedConfig(gatewayName)
+							rejectedServers[s] = fmt.Sprintf("could not build RDS name for port %d", s.Port.Number)
 							continue
 						}
 
@@ -170,6 +179,7 @@ func MergeGateways(gateways ...config.Config) *MergedGateway {
 							log.Infof("skipping server on gateway %s port %s.%d.%s: non unique port name for HTTPS port",
 								gatewayConfig.Name, s.Port.Name, s.Port.Number, s.Port.Protocol)
 							recordRejectedConfig(gatewayName)
+							rejectedServers[s] = fmt.Sprintf("non unique port name %s for HTTPS port %d", s.Port.Name, s.Port.Number)
 							continue
 						}
 						serversByRouteName[routeName] = []*networking.Server{s}
@@ -179,6 +189,7 @@ func MergeGateways(gateways ...config.Config) *MergedGateway {
 					// We have another TLS server on the same port. Can differentiate servers using SNI
 					if s.Tls == nil {
 						log.Warnf("TLS server without TLS options %s %s", gatewayName, s.String())
+						rejectedServers[s] = fmt.Sprintf("server without TLS options conflicts with TLS servers on port %d", s.Port.Number)
 						continue
 					}
 
@@ -216,6 +227,7 @@ func MergeGateways(gateways ...config.Config) *MergedGateway {
 		ServersByRouteName:   serversByRouteName,
 		RouteNamesByServer:   routeNamesByServer,
 		SNIHostsByServer:     sniHostsByServer,
+		RejectedServers:      rejectedServers,
 	}
 }
 
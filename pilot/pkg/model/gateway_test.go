@@ -16,6 +16,7 @@ package model
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	networking "istio.io/api/networking/v1alpha3"
@@ -110,6 +111,51 @@ func TestMergeGateways(t *testing.T) {
 	}
 }
 
+func TestMergeGatewaysSameWorkload(t *testing.T) {
+	gwHTTP := makeConfig("foo", "ns", "foo.bar.com", "http", "HTTP", 80, "ingressgateway")
+	gwTCP := makeConfig("bar", "ns", "*", "tcp", "TCP", 9000, "ingressgateway")
+	gwConflict := makeConfig("baz", "ns", "*", "tcp", "TCP", 80, "ingressgateway")
+
+	mgw := MergeGateways(gwHTTP, gwTCP)
+	if len(mgw.Servers) != 2 || len(mgw.Servers[80]) != 1 || len(mgw.Servers[9000]) != 1 {
+		t.Fatalf("expected servers merged on ports 80 and 9000, got %v", mgw.Servers)
+	}
+	if _, f := mgw.ServersByRouteName["http.80"]; !f {
+		t.Errorf("expected route http.80, got %v", mgw.ServersByRouteName)
+	}
+	if len(mgw.RejectedServers) != 0 {
+		t.Errorf("expected no rejected servers, got %v", mgw.RejectedServers)
+	}
+
+	mgw = MergeGateways(gwHTTP, gwTCP, gwConflict)
+	if len(mgw.Servers[80]) != 1 || mgw.Servers[80][0].Port.Protocol != "HTTP" {
+		t.Fatalf("expected the HTTP server to own port 80, got %v", mgw.Servers[80])
+	}
+	conflicting := gwConflict.Spec.(*networking.Gateway).Servers[0]
+	if reason, f := mgw.RejectedServers[conflicting]; !f || len(mgw.RejectedServers) != 1 {
+		t.Errorf("expected only the conflicting TCP server to be rejected, got %v", mgw.RejectedServers)
+	} else if !strings.Contains(reason, "port 80") {
+		t.Errorf("expected the rejection reason to name the port, got %q", reason)
+	}
+}
+
+func TestMergeGatewaysRejectedServersMetric(t *testing.T) {
+	ps := NewPushContext()
+	ps.gatewayIndex.all = []config.Config{
+		makeConfig("foo", "ns", "foo.bar.com", "http", "HTTP", 80, "ingressgateway"),
+		makeConfig("baz", "ns", "*", "tcp", "TCP", 80, "ingressgateway"),
+	}
+	proxy := &Proxy{ID: "gateway.ns", Metadata: &NodeMetadata{Labels: map[string]string{"istio": "ingressgateway"}}}
+	ps.mergeGateways(proxy)
+
+	rejected := ps.ProxyStatus[RejectedGatewayServers.Name()]
+	if status, f := rejected["ns/baz/80"]; !f || len(rejected) != 1 {
+		t.Fatalf("expected the conflicting server of ns/baz to be reported, got %v", rejected)
+	} else if status.Proxy != proxy.ID || !strings.Contains(status.Message, "port 80") {
+		t.Errorf("expected the rejection to name the proxy and port, got %+v", status)
+	}
+}
+
 func makeConfig(name, namespace, host, portName, portProtocol string, portNumber uint32, gw string) config.Config {
 	c := config.Config{
 		Meta: config.Meta{
@@ -16,6 +16,7 @@ package model
 
 import (
 	"encoding/json"
+	"fmt"
 	"net"
 	"sort"
 	"strings"
@@ -144,6 +145,37 @@ func newGatewayIndex() gatewayIndex {
 	}
 }
 
+// ConfigVersion is the version of a config resource that contributed to a push context.
+type ConfigVersion struct {
+	Kind            string `json:"kind"`
+	Name            string `json:"name"`
+	Namespace       string `json:"namespace"`
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+}
+
+// sortConfigVersions sorts config versions by kind, namespace and name.
+func sortConfigVersions(versions []ConfigVersion) {
+	sort.Slice(versions, func(i, j int) bool {
+		a, b := versions[i], versions[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+}
+
+func newConfigVersion(c config.Config) ConfigVersion {
+	return ConfigVersion{
+		Kind:            c.GroupVersionKind.Kind,
+		Name:            c.Name,
+		Namespace:       c.Namespace,
+		ResourceVersion: c.ResourceVersion,
+	}
+}
+
 // PushContext tracks the status of a push - metrics and errors.
 // Metrics are reset after a push - at the beginning all
 // values are zero, and when push completes the status is reset.
@@ -179,6 +211,15 @@ type PushContext struct {
 	// sidecars for each namespace
 	sidecarsByNamespace map[string][]*SidecarScope
 
+	// configVersions are the versions of the config resources this push context was built from, by kind.
+	configVersions map[config.GroupVersionKind][]ConfigVersion
+	// invalidConfigs are the config resources skipped because they failed validation, by kind, with the reason.
+	invalidConfigs map[config.GroupVersionKind]map[ConfigVersion]string
+	// configRejections counts the config resources, or parts of them, rejected while building the push context.
+	configRejections configRejectionCounts
+	// sortedConfigVersions are all configVersions, sorted by kind, namespace and name.
+	sortedConfigVersions []ConfigVersion
+
 	// envoy filters for each namespace including global config namespace
 	envoyFiltersByNamespace map[string][]*EnvoyFilterWrapper
 
@@ -481,12 +522,53 @@ var (
 		"Duplicate subsets across destination rules for same host",
 	)
 
+	// VirtualServiceWeightsNormalized tracks virtual services whose route weights do not sum up to 100
+	// and were normalized.
+	VirtualServiceWeightsNormalized = monitoring.NewGauge(
+		"pilot_vservice_weights_normalized",
+		"Virtual services with route weights not summing up to 100, which were normalized.",
+	)
+
+	// EgressProxyNotFound tracks sidecars whose outbound traffic policy sends unmatched traffic
+	// to an egress proxy that does not match any service visible to the proxy.
+	EgressProxyNotFound = monitoring.NewGauge(
+		"pilot_sidecar_egress_proxy_not_found",
+		"Egress proxies not matching any service visible to the sidecar.",
+	)
+
+	// RejectedGatewayServers tracks gateway servers that could not be merged into the listeners of a
+	// gateway workload, because they conflict with another server bound to the same port.
+	RejectedGatewayServers = monitoring.NewGauge(
+		"pilot_gateway_rejected_servers",
+		"Gateway servers rejected because they conflict with other servers on the same port.",
+	)
+
+	// InvalidConfigs tracks config resources skipped because they failed validation while building the push context.
+	InvalidConfigs = monitoring.NewGauge(
+		"pilot_invalid_configs",
+		"Config resources skipped because they failed validation.",
+	)
+
 	// totalVirtualServices tracks the total number of virtual service
 	totalVirtualServices = monitoring.NewGauge(
 		"pilot_virt_services",
 		"Total virtual services known to pilot.",
 	)
 
+	reasonTag = monitoring.MustCreateLabel("reason")
+
+	// configRejections tracks config resources, or parts of them, ignored while building the last push context.
+	configRejections = monitoring.NewGauge(
+		"pilot_config_rejections",
+		"Config resources rejected while building the push context, by type and reason.",
+		monitoring.WithLabels(typeTag, reasonTag),
+	)
+
+	// recordedConfigRejections are the type and reason labels of configRejections recorded so far, so that they
+	// are reset once the rejections are gone.
+	recordedConfigRejections      = map[rejectionLabels]struct{}{}
+	recordedConfigRejectionsMutex sync.Mutex
+
 	// LastPushStatus preserves the metrics and data collected during lasts global push.
 	// It can be used by debugging tools to inspect the push event. It will be reset after each push with the
 	// new version.
@@ -507,6 +589,10 @@ var (
 		ProxyStatusClusterNoInstances,
 		DuplicatedDomains,
 		DuplicatedSubsets,
+		VirtualServiceWeightsNormalized,
+		EgressProxyNotFound,
+		RejectedGatewayServers,
+		InvalidConfigs,
 	}
 )
 
@@ -515,6 +601,58 @@ func init() {
 		monitoring.MustRegister(m)
 	}
 	monitoring.MustRegister(totalVirtualServices)
+	monitoring.MustRegister(configRejections)
+}
+
+type rejectionLabels struct {
+	kind   string
+	reason string
+}
+
+// configRejectionCounts counts the config resources, or parts of them, rejected while building the push
+// context, by kind and reason.
+type configRejectionCounts map[config.GroupVersionKind]map[string]int
+
+// add counts a config resource of the given kind that was rejected for the given reason. It is a no-op on a
+// nil configRejectionCounts.
+func (c configRejectionCounts) add(kind config.GroupVersionKind, reason string) {
+	if c == nil {
+		return
+	}
+	if c[kind] == nil {
+		c[kind] = map[string]int{}
+	}
+	c[kind][reason]++
+}
+
+// rejections returns the config rejection counts of the push context.
+func (ps *PushContext) rejections() configRejectionCounts {
+	if ps.configRejections == nil {
+		ps.configRejections = configRejectionCounts{}
+	}
+	return ps.configRejections
+}
+
+// recordConfigRejections records the config rejections of the push context, resetting those no longer present.
+func (ps *PushContext) recordConfigRejections() {
+	recordedConfigRejectionsMutex.Lock()
+	defer recordedConfigRejectionsMutex.Unlock()
+	current := map[rejectionLabels]int{}
+	for kind, reasons := range ps.configRejections {
+		for reason, count := range reasons {
+			current[rejectionLabels{kind: kind.Kind, reason: reason}] = count
+		}
+	}
+	for l := range recordedConfigRejections {
+		if _, f := current[l]; !f {
+			configRejections.With(typeTag.Value(l.kind), reasonTag.Value(l.reason)).Record(0)
+			delete(recordedConfigRejections, l)
+		}
+	}
+	for l, count := range current {
+		configRejections.With(typeTag.Value(l.kind), reasonTag.Value(l.reason)).Record(float64(count))
+		recordedConfigRejections[l] = struct{}{}
+	}
 }
 
 // NewPushContext creates a new PushContext structure to track push status.
@@ -560,6 +698,7 @@ func (ps *PushContext) UpdateMetrics() {
 		mmap := ps.ProxyStatus[pm.Name()]
 		pm.Record(float64(len(mmap)))
 	}
+	ps.recordConfigRejections()
 }
 
 func virtualServiceDestinations(v *networking.VirtualService) []*networking.Destination {
@@ -666,6 +805,36 @@ func (ps *PushContext) Services(proxy *Proxy) []*Service {
 	return out
 }
 
+// OutboundClusterNames returns the sorted names of the outbound service clusters sent to the proxy,
+// including subset clusters from destination rules. These are the clusters the proxy may request
+// endpoints for over EDS.
+func (ps *PushContext) OutboundClusterNames(proxy *Proxy) []string {
+	var services []*Service
+	if features.FilterGatewayClusterConfig && proxy.Type == Router {
+		services = ps.GatewayServices(proxy)
+	} else {
+		services = ps.Services(proxy)
+	}
+	out := make([]string, 0, len(services))
+	for _, service := range services {
+		var subsets []*networking.Subset
+		if dr := ps.DestinationRule(proxy, service); dr != nil {
+			subsets = dr.Spec.(*networking.DestinationRule).Subsets
+		}
+		for _, port := range service.Ports {
+			if port.Protocol == protocol.UDP {
+				continue
+			}
+			out = append(out, BuildSubsetKey(TrafficDirectionOutbound, "", service.Hostname, port.Port))
+			for _, subset := range subsets {
+				out = append(out, BuildSubsetKey(TrafficDirectionOutbound, subset.Name, service.Hostname, port.Port))
+			}
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
 // ServiceForHostname returns the service associated with a given hostname following SidecarScope
 func (ps *PushContext) ServiceForHostname(proxy *Proxy, hostname host.Name) *Service {
 	if proxy != nil && proxy.SidecarScope != nil {
@@ -748,6 +917,101 @@ func (ps *PushContext) getSidecarScope(proxy *Proxy, workloadLabels labels.Colle
 	return DefaultSidecarScopeForNamespace(ps, proxy.ConfigNamespace)
 }
 
+// ProxySelector identifies the group of proxies that share a SidecarScope: the proxies in
+// Namespace whose labels match WorkloadSelector. A nil WorkloadSelector selects every proxy
+// in the namespace that is not matched by a more specific Sidecar.
+type ProxySelector struct {
+	Namespace        string
+	WorkloadSelector labels.Instance
+}
+
+// AffectedProxySelectors returns the selectors of the proxies that would be impacted if the
+// given config was applied. Virtual services are evaluated against the exportTo and egress
+// host scoping of each sidecar scope, so the result is accurate for configs that are not yet
+// in the push context; other kinds fall back to the dependencies recorded on the sidecar scopes.
+func (ps *PushContext) AffectedProxySelectors(cfg config.Config) []ProxySelector {
+	namespaces := make([]string, 0, len(ps.sidecarsByNamespace))
+	for ns := range ps.sidecarsByNamespace {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+
+	var vs *config.Config
+	if cfg.GroupVersionKind == gvk.VirtualService {
+		if _, ok := cfg.Spec.(*networking.VirtualService); ok {
+			c := cfg.DeepCopy()
+			resolveVirtualServiceShortnames(c.Spec.(*networking.VirtualService), c.Meta)
+			vs = &c
+		}
+	}
+
+	key := ConfigKey{Kind: cfg.GroupVersionKind, Name: cfg.Name, Namespace: cfg.Namespace}
+	out := make([]ProxySelector, 0)
+	for _, ns := range namespaces {
+		for _, sc := range ps.sidecarsByNamespace[ns] {
+			affected := sc.DependsOnConfig(key)
+			if !affected && vs != nil {
+				affected = ps.virtualServiceAffectsSidecarScope(*vs, sc, ns)
+			}
+			if !affected {
+				continue
+			}
+			selector := ProxySelector{Namespace: ns}
+			if sc.Config != nil && sc.Config.Spec != nil {
+				if ws := sc.Config.Spec.(*networking.Sidecar).GetWorkloadSelector(); ws != nil {
+					selector.WorkloadSelector = labels.Instance(ws.GetLabels())
+				}
+			}
+			out = append(out, selector)
+		}
+	}
+	return out
+}
+
+// virtualServiceAffectsSidecarScope checks whether the virtual service is visible to the
+// namespace and imported by any of the egress listeners of the sidecar scope.
+func (ps *PushContext) virtualServiceAffectsSidecarScope(vs config.Config, sc *SidecarScope, namespace string) bool {
+	rule := vs.Spec.(*networking.VirtualService)
+	meshBound := false
+	for _, gw := range getGatewayNames(rule, vs.Meta) {
+		if gw == constants.IstioMeshGateway {
+			meshBound = true
+			break
+		}
+	}
+	if !meshBound {
+		return false
+	}
+
+	exportTo := make(map[visibility.Instance]bool)
+	for _, e := range rule.ExportTo {
+		exportTo[visibility.Instance(e)] = true
+	}
+	if len(exportTo) == 0 {
+		exportTo = ps.exportToDefaults.virtualService
+	}
+	var visible bool
+	switch {
+	case exportTo[visibility.Public]:
+		visible = true
+	case exportTo[visibility.None]:
+		// not visible to anyone
+	default:
+		visible = exportTo[visibility.Instance(namespace)] ||
+			(exportTo[visibility.Private] && vs.Namespace == namespace)
+	}
+	if !visible {
+		return false
+	}
+
+	for _, el := range sc.EgressListeners {
+		if len(el.selectVirtualServices([]config.Config{vs})) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // DestinationRule returns a destination rule for a service name in a given domain.
 func (ps *PushContext) DestinationRule(proxy *Proxy, service *Service) *config.Config {
 	if service == nil {
@@ -908,6 +1172,10 @@ func (ps *PushContext) InitContext(env *Environment, oldPushContext *PushContext
 		}
 	}
 
+	ps.initConfigVersions(oldPushContext)
+
+	ps.initEgressProxies()
+
 	// TODO: only do this when meshnetworks or gateway service changed
 	ps.initMeshNetworks()
 
@@ -917,6 +1185,54 @@ func (ps *PushContext) InitContext(env *Environment, oldPushContext *PushContext
 	return nil
 }
 
+// recordConfigVersions records the versions of the config resources of a kind used to build the push context.
+func (ps *PushContext) recordConfigVersions(kind config.GroupVersionKind, configs []config.Config) {
+	if ps.configVersions == nil {
+		ps.configVersions = map[config.GroupVersionKind][]ConfigVersion{}
+	}
+	versions := make([]ConfigVersion, 0, len(configs))
+	for _, c := range configs {
+		versions = append(versions, newConfigVersion(c))
+	}
+	ps.configVersions[kind] = versions
+}
+
+// initConfigVersions carries over the config versions, invalid configs and config rejections of the kinds that
+// were not rebuilt from the old push context, and sorts the config versions.
+func (ps *PushContext) initConfigVersions(oldPushContext *PushContext) {
+	if oldPushContext != nil {
+		for kind, versions := range oldPushContext.configVersions {
+			if _, f := ps.configVersions[kind]; f {
+				continue
+			}
+			if ps.configVersions == nil {
+				ps.configVersions = map[config.GroupVersionKind][]ConfigVersion{}
+			}
+			ps.configVersions[kind] = versions
+			for cv, reason := range oldPushContext.invalidConfigs[kind] {
+				ps.addInvalidConfig(kind, cv, reason)
+			}
+			for reason, count := range oldPushContext.configRejections[kind] {
+				if ps.rejections()[kind] == nil {
+					ps.rejections()[kind] = map[string]int{}
+				}
+				ps.rejections()[kind][reason] = count
+			}
+		}
+	}
+	ps.sortedConfigVersions = make([]ConfigVersion, 0)
+	for _, versions := range ps.configVersions {
+		ps.sortedConfigVersions = append(ps.sortedConfigVersions, versions...)
+	}
+	sortConfigVersions(ps.sortedConfigVersions)
+}
+
+// ConfigVersions returns the versions of the config resources the push context was built from. Config
+// resources skipped because they failed validation are not included.
+func (ps *PushContext) ConfigVersions() []ConfigVersion {
+	return ps.sortedConfigVersions
+}
+
 func (ps *PushContext) createNewContext(env *Environment) error {
 	if err := ps.initServiceRegistry(env); err != nil {
 		return err
@@ -1048,8 +1364,9 @@ func (ps *PushContext) updateContext(
 	}
 
 	// Must be initialized in the end
-	// Sidecars need to be updated if services, virtual services, destination rules, or the sidecar configs change
-	if servicesChanged || virtualServicesChanged || destinationRulesChanged || sidecarsChanged {
+	// Sidecars need to be updated if services, virtual services, destination rules, or the sidecar configs change.
+	// Authorization policies select the extension provider services added to sidecars.
+	if servicesChanged || virtualServicesChanged || destinationRulesChanged || sidecarsChanged || authzChanged {
 		if err := ps.initSidecarScopes(env); err != nil {
 			return err
 		}
@@ -1159,6 +1476,8 @@ func (ps *PushContext) initAuthnPolicies(env *Environment) error {
 	if ps.AuthnBetaPolicies, initBetaPolicyErro = initAuthenticationPolicies(env); initBetaPolicyErro != nil {
 		return initBetaPolicyErro
 	}
+	ps.recordConfigVersions(gvk.RequestAuthentication, ps.AuthnBetaPolicies.requestAuthenticationConfigs())
+	ps.recordConfigVersions(gvk.PeerAuthentication, ps.AuthnBetaPolicies.peerAuthenticationConfigs())
 
 	return nil
 }
@@ -1176,11 +1495,15 @@ func (ps *PushContext) initVirtualServices(env *Environment) error {
 
 	// values returned from ConfigStore.List are immutable.
 	// Therefore, we make a copy
-	vservices := make([]config.Config, len(virtualServices))
+	vservices := make([]config.Config, 0, len(virtualServices))
 
-	for i := range vservices {
-		vservices[i] = virtualServices[i].DeepCopy()
+	for _, vs := range virtualServices {
+		if ps.rejectInvalidConfig(gvk.VirtualService, vs, virtualServiceRules) {
+			continue
+		}
+		vservices = append(vservices, vs.DeepCopy())
 	}
+	ps.recordConfigVersions(gvk.VirtualService, vservices)
 
 	totalVirtualServices.Record(float64(len(virtualServices)))
 
@@ -1190,7 +1513,8 @@ func (ps *PushContext) initVirtualServices(env *Environment) error {
 	// the RDS code. See separateVSHostsAndServices in route/route.go
 	sortConfigByCreationTime(vservices)
 
-	vservices, ps.virtualServiceIndex.delegates = mergeVirtualServicesIfNeeded(vservices, ps.exportToDefaults.virtualService)
+	vservices, ps.virtualServiceIndex.delegates = mergeVirtualServicesIfNeeded(vservices, ps.exportToDefaults.virtualService,
+		ps.rejections())
 
 	// convert all shortnames in virtual services into FQDNs
 	for _, r := range vservices {
@@ -1329,6 +1653,7 @@ func (ps *PushContext) initSidecarScopes(env *Environment) error {
 	}
 
 	sortConfigByCreationTime(sidecarConfigs)
+	ps.recordConfigVersions(gvk.Sidecar, sidecarConfigs)
 
 	sidecarConfigWithSelector := make([]config.Config, 0)
 	sidecarConfigWithoutSelector := make([]config.Config, 0)
@@ -1387,6 +1712,26 @@ func (ps *PushContext) initSidecarScopes(env *Environment) error {
 	return nil
 }
 
+// initEgressProxies reports the sidecar scopes whose outbound traffic policy sends unmatched traffic
+// to an egress proxy that does not match any service visible to the scope. It runs on every push, as
+// the sidecar scopes may be carried over from the previous push context.
+func (ps *PushContext) initEgressProxies() {
+	for ns, scopes := range ps.sidecarsByNamespace {
+		for _, sc := range scopes {
+			if sc.OutboundTrafficPolicy == nil || sc.OutboundTrafficPolicy.EgressProxy == nil || sc.Config == nil {
+				continue
+			}
+			egressProxy := sc.OutboundTrafficPolicy.EgressProxy.Host
+			if _, f := sc.servicesByHostname[host.Name(egressProxy)]; f {
+				continue
+			}
+			ps.AddMetric(EgressProxyNotFound, egressProxy, ns+"/"+sc.Config.Name,
+				fmt.Sprintf("egress proxy %s does not match any service visible to sidecar %s/%s",
+					egressProxy, sc.Config.Namespace, sc.Config.Name))
+		}
+	}
+}
+
 // Split out of DestinationRule expensive conversions - once per push.
 func (ps *PushContext) initDestinationRules(env *Environment) error {
 	configs, err := env.List(gvk.DestinationRule, NamespaceAll)
@@ -1396,11 +1741,15 @@ func (ps *PushContext) initDestinationRules(env *Environment) error {
 
 	// values returned from ConfigStore.List are immutable.
 	// Therefore, we make a copy
-	destRules := make([]config.Config, len(configs))
-	for i := range destRules {
-		destRules[i] = configs[i].DeepCopy()
+	destRules := make([]config.Config, 0, len(configs))
+	for _, dr := range configs {
+		if ps.rejectInvalidConfig(gvk.DestinationRule, dr, destinationRuleRules) {
+			continue
+		}
+		destRules = append(destRules, dr.DeepCopy())
 	}
 
+	ps.recordConfigVersions(gvk.DestinationRule, destRules)
 	ps.SetDestinationRules(destRules)
 	return nil
 }
@@ -1484,11 +1833,13 @@ func (ps *PushContext) SetDestinationRules(configs []config.Config) {
 }
 
 func (ps *PushContext) initAuthorizationPolicies(env *Environment) error {
-	var err error
-	if ps.AuthzPolicies, err = GetAuthorizationPolicies(env); err != nil {
+	policies, err := env.List(gvk.AuthorizationPolicy, NamespaceAll)
+	if err != nil {
 		authzLog.Errorf("failed to initialize authorization policies: %v", err)
 		return err
 	}
+	ps.recordConfigVersions(gvk.AuthorizationPolicy, policies)
+	ps.AuthzPolicies = newAuthorizationPolicies(env.Mesh().GetRootNamespace(), policies)
 	return nil
 }
 
@@ -1500,10 +1851,11 @@ func (ps *PushContext) initEnvoyFilters(env *Environment) error {
 	}
 
 	sortConfigByCreationTime(envoyFilterConfigs)
+	ps.recordConfigVersions(gvk.EnvoyFilter, envoyFilterConfigs)
 
 	ps.envoyFiltersByNamespace = make(map[string][]*EnvoyFilterWrapper)
 	for _, envoyFilterConfig := range envoyFilterConfigs {
-		efw := convertToEnvoyFilterWrapper(&envoyFilterConfig)
+		efw := convertToEnvoyFilterWrapper(&envoyFilterConfig, ps.rejections())
 		if _, exists := ps.envoyFiltersByNamespace[envoyFilterConfig.Namespace]; !exists {
 			ps.envoyFiltersByNamespace[envoyFilterConfig.Namespace] = make([]*EnvoyFilterWrapper, 0)
 		}
@@ -1518,6 +1870,35 @@ func (ps *PushContext) EnvoyFilters(proxy *Proxy) *EnvoyFilterWrapper {
 	if proxy == nil {
 		return nil
 	}
+	matchedEnvoyFilters := ps.matchingEnvoyFilters(proxy)
+
+	var out *EnvoyFilterWrapper
+	if len(matchedEnvoyFilters) > 0 {
+		out = &EnvoyFilterWrapper{
+			// no need populate workloadSelector, as it is not used later.
+			Patches: make(map[networking.EnvoyFilter_ApplyTo][]*EnvoyFilterConfigPatchWrapper),
+		}
+		// merge EnvoyFilterWrapper
+		for _, efw := range matchedEnvoyFilters {
+			for applyTo, cps := range efw.Patches {
+				if out.Patches[applyTo] == nil {
+					out.Patches[applyTo] = []*EnvoyFilterConfigPatchWrapper{}
+				}
+				for _, cp := range cps {
+					if proxyMatch(proxy, cp) {
+						out.Patches[applyTo] = append(out.Patches[applyTo], cp.forProxy(proxy))
+					}
+				}
+			}
+		}
+	}
+
+	return out
+}
+
+// matchingEnvoyFilters returns the EnvoyFilters whose workload selector matches the proxy, with the
+// ones from the config root namespace first.
+func (ps *PushContext) matchingEnvoyFilters(proxy *Proxy) []*EnvoyFilterWrapper {
 	matchedEnvoyFilters := make([]*EnvoyFilterWrapper, 0)
 	// EnvoyFilters supports inheritance (global ones plus namespace local ones).
 	// First get all the filter configs from the config root namespace
@@ -1550,31 +1931,60 @@ func (ps *PushContext) EnvoyFilters(proxy *Proxy) *EnvoyFilterWrapper {
 			}
 		}
 	}
+	return matchedEnvoyFilters
+}
 
-	var out *EnvoyFilterWrapper
-	if len(matchedEnvoyFilters) > 0 {
-		out = &EnvoyFilterWrapper{
-			// no need populate workloadSelector, as it is not used later.
-			Patches: make(map[networking.EnvoyFilter_ApplyTo][]*EnvoyFilterConfigPatchWrapper),
+// MatchedEnvoyFilters returns the EnvoyFilters applied to a proxy, in the order they are applied. Only
+// the patches matching the proxy are included.
+func (ps *PushContext) MatchedEnvoyFilters(proxy *Proxy) []*EnvoyFilterWrapper {
+	if proxy == nil {
+		return nil
+	}
+	var out []*EnvoyFilterWrapper
+	for _, efw := range ps.matchingEnvoyFilters(proxy) {
+		matched := &EnvoyFilterWrapper{
+			Name:      efw.Name,
+			Namespace: efw.Namespace,
+			Patches:   make(map[networking.EnvoyFilter_ApplyTo][]*EnvoyFilterConfigPatchWrapper),
 		}
-		// merge EnvoyFilterWrapper
-		for _, efw := range matchedEnvoyFilters {
-			for applyTo, cps := range efw.Patches {
-				if out.Patches[applyTo] == nil {
-					out.Patches[applyTo] = []*EnvoyFilterConfigPatchWrapper{}
-				}
-				for _, cp := range cps {
-					if proxyMatch(proxy, cp) {
-						out.Patches[applyTo] = append(out.Patches[applyTo], cp)
-					}
+		for applyTo, cps := range efw.Patches {
+			for _, cp := range cps {
+				if proxyMatch(proxy, cp) {
+					matched.Patches[applyTo] = append(matched.Patches[applyTo], cp)
 				}
 			}
 		}
+		out = append(out, matched)
 	}
-
 	return out
 }
 
+// PreviewEnvoyFilter returns the patches of an EnvoyFilter, which is not necessarily part of this
+// PushContext, that would apply to the proxy. It returns nil if the EnvoyFilter does not select the proxy.
+func (ps *PushContext) PreviewEnvoyFilter(proxy *Proxy, cfg *config.Config) *EnvoyFilterWrapper {
+	if proxy == nil || (cfg.Namespace != ps.Mesh.RootNamespace && cfg.Namespace != proxy.ConfigNamespace) {
+		return nil
+	}
+	efw := convertToEnvoyFilterWrapper(cfg, nil)
+	var workloadLabels labels.Collection
+	if proxy.Metadata != nil && len(proxy.Metadata.Labels) > 0 {
+		workloadLabels = labels.Collection{proxy.Metadata.Labels}
+	}
+	if efw.workloadSelector != nil && !workloadLabels.IsSupersetOf(efw.workloadSelector) {
+		return nil
+	}
+	for applyTo, cps := range efw.Patches {
+		matched := make([]*EnvoyFilterConfigPatchWrapper, 0, len(cps))
+		for _, cp := range cps {
+			if proxyMatch(proxy, cp) {
+				matched = append(matched, cp.forProxy(proxy))
+			}
+		}
+		efw.Patches[applyTo] = matched
+	}
+	return efw
+}
+
 // pre computes gateways per namespace
 func (ps *PushContext) initGateways(env *Environment) error {
 	gatewayConfigs, err := env.List(gvk.Gateway, NamespaceAll)
@@ -1583,6 +1993,7 @@ func (ps *PushContext) initGateways(env *Environment) error {
 	}
 
 	sortConfigByCreationTime(gatewayConfigs)
+	ps.recordConfigVersions(gvk.Gateway, gatewayConfigs)
 
 	ps.gatewayIndex.all = gatewayConfigs
 	ps.gatewayIndex.namespace = make(map[string][]config.Config)
@@ -1630,7 +2041,18 @@ func (ps *PushContext) mergeGateways(proxy *Proxy) *MergedGateway {
 	if len(out) == 0 {
 		return nil
 	}
-	return MergeGateways(out...)
+	mgw := MergeGateways(out...)
+	if len(mgw.RejectedServers) > 0 {
+		for _, cfg := range out {
+			for _, s := range cfg.Spec.(*networking.Gateway).Servers {
+				if reason, f := mgw.RejectedServers[s]; f {
+					ps.AddMetric(RejectedGatewayServers, fmt.Sprintf("%s/%s/%d", cfg.Namespace, cfg.Name, s.Port.GetNumber()),
+						proxy.ID, reason)
+				}
+			}
+		}
+	}
+	return mgw
 }
 
 // pre computes gateways for each network
@@ -19,6 +19,7 @@ import (
 	"reflect"
 	"regexp"
 	"sort"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -37,6 +38,7 @@ import (
 	"istio.io/istio/pkg/config/host"
 	"istio.io/istio/pkg/config/labels"
 	"istio.io/istio/pkg/config/mesh"
+	"istio.io/istio/pkg/config/protocol"
 	"istio.io/istio/pkg/config/schema/collections"
 	"istio.io/istio/pkg/config/schema/gvk"
 	"istio.io/istio/pkg/config/visibility"
@@ -342,6 +344,57 @@ func serviceNames(svcs []*Service) []string {
 	return s
 }
 
+func TestOutboundClusterNames(t *testing.T) {
+	configStore := NewFakeStore()
+	_, _ = configStore.Create(config.Config{
+		Meta: config.Meta{
+			Name:             "reviews",
+			Namespace:        "test1",
+			GroupVersionKind: gvk.DestinationRule,
+		},
+		Spec: &networking.DestinationRule{
+			Host:    "reviews.test1.svc.cluster.local",
+			Subsets: []*networking.Subset{{Name: "v1", Labels: map[string]string{"version": "v1"}}},
+		},
+	})
+	env := &Environment{IstioConfigStore: &istioConfigStore{ConfigStore: configStore}}
+	env.ServiceDiscovery = &localServiceDiscovery{
+		services: []*Service{
+			{
+				Hostname:   "reviews.test1.svc.cluster.local",
+				Ports:      PortList{{Name: "http", Port: 9080, Protocol: protocol.HTTP}},
+				Attributes: ServiceAttributes{Namespace: "test1"},
+			},
+			{
+				Hostname: "dns.test1.svc.cluster.local",
+				Ports: PortList{
+					{Name: "tcp", Port: 53, Protocol: protocol.TCP},
+					{Name: "udp", Port: 53, Protocol: protocol.UDP},
+				},
+				Attributes: ServiceAttributes{Namespace: "test1"},
+			},
+		},
+	}
+	m := mesh.DefaultMeshConfig()
+	env.Watcher = mesh.NewFixedWatcher(&m)
+
+	pc := NewPushContext()
+	if err := pc.InitContext(env, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	proxy := &Proxy{Type: SidecarProxy, ConfigNamespace: "test1", Metadata: &NodeMetadata{}}
+	proxy.SetSidecarScope(pc)
+
+	want := []string{
+		"outbound|53||dns.test1.svc.cluster.local",
+		"outbound|9080|v1|reviews.test1.svc.cluster.local",
+		"outbound|9080||reviews.test1.svc.cluster.local",
+	}
+	if got := pc.OutboundClusterNames(proxy); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected clusters %v, got %v", want, got)
+	}
+}
+
 func TestInitPushContext(t *testing.T) {
 	env := &Environment{}
 	configStore := NewFakeStore()
@@ -514,6 +567,102 @@ func TestSidecarScope(t *testing.T) {
 	}
 }
 
+func TestAffectedProxySelectors(t *testing.T) {
+	ps := NewPushContext()
+	env := &Environment{Watcher: mesh.NewFixedWatcher(&meshconfig.MeshConfig{RootNamespace: "istio-system"})}
+	ps.Mesh = env.Mesh()
+	ps.ServiceDiscovery = env
+	ps.ServiceIndex.HostnameAndNamespace[host.Name("svc1.ns1.svc.cluster.local")] = map[string]*Service{"ns1": nil}
+	ps.ServiceIndex.HostnameAndNamespace[host.Name("svc2.ns2.svc.cluster.local")] = map[string]*Service{"ns2": nil}
+	ps.initDefaultExportMaps()
+
+	configStore := NewFakeStore()
+	_, _ = configStore.Create(config.Config{
+		Meta: config.Meta{
+			GroupVersionKind: gvk.Sidecar,
+			Name:             "foo",
+			Namespace:        "ns2",
+		},
+		Spec: &networking.Sidecar{
+			WorkloadSelector: &networking.WorkloadSelector{
+				Labels: map[string]string{"app": "foo"},
+			},
+			Egress: []*networking.IstioEgressListener{
+				{
+					Hosts: []string{"ns2/*"},
+				},
+			},
+		},
+	})
+	env.IstioConfigStore = &istioConfigStore{ConfigStore: configStore}
+	if err := ps.initSidecarScopes(env); err != nil {
+		t.Fatalf("init sidecar scope failed: %v", err)
+	}
+
+	virtualService := func(namespace string, exportTo ...string) config.Config {
+		return config.Config{
+			Meta: config.Meta{
+				GroupVersionKind: gvk.VirtualService,
+				Name:             "vs",
+				Namespace:        namespace,
+			},
+			Spec: &networking.VirtualService{
+				Hosts:    []string{"svc1"},
+				ExportTo: exportTo,
+			},
+		}
+	}
+
+	cases := []struct {
+		name   string
+		config config.Config
+		want   []ProxySelector
+	}{
+		{
+			name:   "namespaced virtual service",
+			config: virtualService("ns1", "."),
+			want:   []ProxySelector{{Namespace: "ns1"}},
+		},
+		{
+			name:   "exported to other namespace",
+			config: virtualService("ns1", "ns2"),
+			want:   []ProxySelector{{Namespace: "ns2"}},
+		},
+		{
+			name:   "public virtual service",
+			config: virtualService("ns1"),
+			want:   []ProxySelector{{Namespace: "ns1"}, {Namespace: "ns2"}},
+		},
+		{
+			name:   "not exported",
+			config: virtualService("ns1", "~"),
+			want:   []ProxySelector{},
+		},
+		{
+			name: "sidecar in namespace",
+			config: config.Config{
+				Meta: config.Meta{
+					GroupVersionKind: gvk.Sidecar,
+					Name:             "foo",
+					Namespace:        "ns2",
+				},
+			},
+			want: []ProxySelector{
+				{Namespace: "ns2", WorkloadSelector: labels.Instance{"app": "foo"}},
+				{Namespace: "ns2"},
+			},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ps.AffectedProxySelectors(tt.config)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestBestEffortInferServiceMTLSMode(t *testing.T) {
 	const partialNS string = "partial"
 	const wholeNS string = "whole"
@@ -847,6 +996,134 @@ func TestSetDestinationRuleWithExportTo(t *testing.T) {
 	}
 }
 
+func TestInvalidVirtualServicesSkipped(t *testing.T) {
+	ps := NewPushContext()
+	env := &Environment{Watcher: mesh.NewFixedWatcher(&meshconfig.MeshConfig{RootNamespace: "zzz"})}
+	ps.Mesh = env.Mesh()
+	ps.ServiceDiscovery = env
+	configStore := NewFakeStore()
+
+	valid := config.Config{
+		Meta: config.Meta{
+			Name:             "valid",
+			Namespace:        "test1",
+			GroupVersionKind: gvk.VirtualService,
+		},
+		Spec: &networking.VirtualService{
+			Hosts: []string{"valid.com"},
+			Http: []*networking.HTTPRoute{{
+				Route: []*networking.HTTPRouteDestination{{Destination: &networking.Destination{Host: "valid.com"}}},
+			}},
+		},
+	}
+	wildcard := config.Config{
+		Meta: config.Meta{
+			Name:             "wildcard",
+			Namespace:        "test1",
+			GroupVersionKind: gvk.VirtualService,
+		},
+		Spec: &networking.VirtualService{
+			Hosts: []string{"*"},
+			Http: []*networking.HTTPRoute{{
+				Route: []*networking.HTTPRouteDestination{{Destination: &networking.Destination{Host: "valid.com"}}},
+			}},
+		},
+	}
+	for _, c := range []config.Config{valid, wildcard} {
+		if _, err := configStore.Create(c); err != nil {
+			t.Fatalf("could not create %v", c.Name)
+		}
+	}
+
+	env.IstioConfigStore = &istioConfigStore{ConfigStore: configStore}
+	ps.initDefaultExportMaps()
+	if err := ps.initVirtualServices(env); err != nil {
+		t.Fatalf("init virtual services failed: %v", err)
+	}
+
+	rules := ps.VirtualServicesForGateway(&Proxy{ConfigNamespace: "test1"}, constants.IstioMeshGateway)
+	if len(rules) != 1 || rules[0].Name != "valid" {
+		t.Fatalf("expected only the valid virtual service, got %v", rules)
+	}
+	status, f := ps.ProxyStatus[InvalidConfigs.Name()]["VirtualService/test1/wildcard"]
+	if !f || !strings.Contains(status.Message, "ValidateVirtualService") {
+		t.Fatalf("expected the failed rule to be recorded, got %v", ps.ProxyStatus[InvalidConfigs.Name()])
+	}
+
+	ps.initConfigVersions(nil)
+	if got := ps.ConfigVersions(); len(got) != 1 || got[0].Name != "valid" {
+		t.Fatalf("expected only the valid virtual service to be versioned, got %v", got)
+	}
+	if got := ps.InvalidConfigVersions(); len(got) != 1 || got[0].Name != "wildcard" {
+		t.Fatalf("expected the wildcard virtual service to be invalid, got %v", got)
+	}
+
+	// A push context which does not rebuild the virtual services carries over their versions and rejections.
+	next := NewPushContext()
+	next.initConfigVersions(ps)
+	if got := next.ConfigVersions(); len(got) != 1 || got[0].Name != "valid" {
+		t.Fatalf("expected the valid virtual service to be carried over, got %v", got)
+	}
+	if reason, f := next.InvalidConfigReason(gvk.VirtualService.Kind, "test1", "wildcard"); !f || !strings.Contains(reason, "ValidateVirtualService") {
+		t.Fatalf("expected the rejection to be carried over, got %q", reason)
+	}
+}
+
+func TestInvalidDestinationRulesSkipped(t *testing.T) {
+	ps := NewPushContext()
+	env := &Environment{Watcher: mesh.NewFixedWatcher(&meshconfig.MeshConfig{RootNamespace: "zzz"})}
+	ps.Mesh = env.Mesh()
+	configStore := NewFakeStore()
+
+	valid := config.Config{
+		Meta: config.Meta{
+			Name:             "valid",
+			Namespace:        "test1",
+			GroupVersionKind: gvk.DestinationRule,
+		},
+		Spec: &networking.DestinationRule{
+			Host:    "valid.com",
+			Subsets: []*networking.Subset{{Name: "v1"}},
+		},
+	}
+	invalid := config.Config{
+		Meta: config.Meta{
+			Name:             "invalid",
+			Namespace:        "test1",
+			GroupVersionKind: gvk.DestinationRule,
+		},
+		Spec: &networking.DestinationRule{
+			Host:    "invalid.com",
+			Subsets: []*networking.Subset{{Name: "Not_A_Label"}},
+		},
+	}
+	for _, c := range []config.Config{valid, invalid} {
+		if _, err := configStore.Create(c); err != nil {
+			t.Fatalf("could not create %v", c.Name)
+		}
+	}
+
+	env.IstioConfigStore = &istioConfigStore{ConfigStore: configStore}
+	ps.initDefaultExportMaps()
+	if err := ps.initDestinationRules(env); err != nil {
+		t.Fatalf("init destination rules failed: %v", err)
+	}
+
+	proxy := &Proxy{ConfigNamespace: "test1"}
+	if ps.DestinationRule(proxy, &Service{Hostname: "valid.com"}) == nil {
+		t.Fatalf("expected the valid destination rule")
+	}
+	if dr := ps.DestinationRule(proxy, &Service{Hostname: "invalid.com"}); dr != nil {
+		t.Fatalf("expected the invalid destination rule to be skipped, got %v", dr)
+	}
+	if reason, f := ps.InvalidConfigReason(gvk.DestinationRule.Kind, "test1", "invalid"); !f || !strings.Contains(reason, "ValidateDestinationRule") {
+		t.Fatalf("expected the failed rule to be recorded, got %q", reason)
+	}
+	if got := ps.configRejections[gvk.DestinationRule]["ValidateDestinationRule"]; got != 1 {
+		t.Fatalf("expected the rejection to be counted, got %v", got)
+	}
+}
+
 func TestVirtualServiceWithExportTo(t *testing.T) {
 	ps := NewPushContext()
 	env := &Environment{Watcher: mesh.NewFixedWatcher(&meshconfig.MeshConfig{RootNamespace: "zzz"})}
@@ -415,8 +415,24 @@ type IstioEndpoint struct {
 	// If this endpoint sidecar proxy does not support h2 tunnel, this endpoint will not show up in the EDS clusters
 	// which are generated for h2 tunnel.
 	TunnelAbility networking.TunnelAbility
+
+	// HealthStatus is the result of the most recent active health check performed by the registry.
+	// Registries that do not health check endpoints leave this as Healthy.
+	HealthStatus HealthStatus
 }
 
+// HealthStatus indicates the status of an endpoint's active health check.
+type HealthStatus int32
+
+const (
+	// Healthy indicates the endpoint passed its last health check. This is the default.
+	Healthy HealthStatus = 0
+	// UnHealthy indicates the endpoint failed its last health check.
+	UnHealthy HealthStatus = 1
+	// UnknownHealth indicates the endpoint has not been health checked yet.
+	UnknownHealth HealthStatus = 2
+)
+
 // ServiceAttributes represents a group of custom attributes of the service.
 type ServiceAttributes struct {
 	// ServiceRegistry indicates the backing service registry system where this service
@@ -96,7 +96,8 @@ type SidecarScope struct {
 
 	// OutboundTrafficPolicy defines the outbound traffic policy for this sidecar.
 	// If OutboundTrafficPolicy is ALLOW_ANY traffic to unknown destinations will
-	// be forwarded.
+	// be forwarded. The Sidecar API only supports a policy for the whole sidecar,
+	// so it applies to all egress listeners alike.
 	OutboundTrafficPolicy *networking.OutboundTrafficPolicy
 
 	// Set of known configs this sidecar depends on.
@@ -335,6 +336,13 @@ func ConvertToSidecarScope(ps *PushContext, sidecarConfig *config.Config, config
 		}
 	}
 
+	// Add the services backing the mesh extension providers used by authorization policies in this
+	// namespace, so that the clusters referenced by ext_authz filters are generated even if they are
+	// not imported by any egress listener.
+	for _, s := range ps.extensionProviderServices(configNamespace) {
+		addService(s)
+	}
+
 	// Now that we have all the services that sidecars using this scope (in
 	// this config namespace) will see, identify all the destinationRules
 	// that these services need
@@ -20,6 +20,7 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 
+	"istio.io/istio/pkg/config"
 	"istio.io/istio/pkg/config/labels"
 	"istio.io/istio/pkg/config/validation"
 )
@@ -105,3 +106,75 @@ func (instance *ServiceInstance) Validate() error {
 
 	return errs
 }
+
+// configRule is a named check applied to config while building the push context. The rules run the same
+// validation as the validation webhook, so that invalid config which reached the store anyway, for example
+// with the webhook disabled, is skipped rather than pushed. Validation warnings are ignored.
+type configRule struct {
+	name  string
+	check validation.ValidateFunc
+}
+
+// virtualServiceRules are the rules virtual services must pass to be included in the push context.
+var virtualServiceRules = []configRule{
+	{name: "ValidateVirtualService", check: validation.ValidateVirtualService},
+}
+
+// destinationRuleRules are the rules destination rules must pass to be included in the push context.
+var destinationRuleRules = []configRule{
+	{name: "ValidateDestinationRule", check: validation.ValidateDestinationRule},
+}
+
+// rejectInvalidConfig reports whether the config violates one of the rules. The first rule that fails is
+// logged, counted and recorded in the push status.
+func (ps *PushContext) rejectInvalidConfig(kind config.GroupVersionKind, cfg config.Config, rules []configRule) bool {
+	for _, rule := range rules {
+		if _, err := rule.check(cfg); err != nil {
+			log.Warnf("skipping invalid %s %s/%s, rule %s failed: %v", kind.Kind, cfg.Namespace, cfg.Name, rule.name, err)
+			ps.rejections().add(kind, rule.name)
+			ps.addInvalidConfig(kind, newConfigVersion(cfg), fmt.Sprintf("rule %s failed: %v", rule.name, err))
+			return true
+		}
+	}
+	return false
+}
+
+// addInvalidConfig records that the config resource was skipped while building the push context.
+func (ps *PushContext) addInvalidConfig(kind config.GroupVersionKind, cv ConfigVersion, reason string) {
+	if ps.invalidConfigs == nil {
+		ps.invalidConfigs = map[config.GroupVersionKind]map[ConfigVersion]string{}
+	}
+	if ps.invalidConfigs[kind] == nil {
+		ps.invalidConfigs[kind] = map[ConfigVersion]string{}
+	}
+	ps.invalidConfigs[kind][cv] = reason
+	ps.AddMetric(InvalidConfigs, cv.Kind+"/"+cv.Namespace+"/"+cv.Name, "", reason)
+}
+
+// InvalidConfigVersions returns the versions of the config resources skipped while building the push context
+// because they failed validation.
+func (ps *PushContext) InvalidConfigVersions() []ConfigVersion {
+	out := make([]ConfigVersion, 0)
+	for _, invalid := range ps.invalidConfigs {
+		for cv := range invalid {
+			out = append(out, cv)
+		}
+	}
+	sortConfigVersions(out)
+	return out
+}
+
+// InvalidConfigReason returns why the config resource was skipped while building the push context, if it was.
+func (ps *PushContext) InvalidConfigReason(kind, namespace, name string) (string, bool) {
+	for k, invalid := range ps.invalidConfigs {
+		if k.Kind != kind {
+			continue
+		}
+		for cv, reason := range invalid {
+			if cv.Namespace == namespace && cv.Name == name {
+				return reason, true
+			}
+		}
+	}
+	return "", false
+}
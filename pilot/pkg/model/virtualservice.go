@@ -89,10 +89,12 @@ func resolveVirtualServiceShortnames(rule *networking.VirtualService, meta confi
 	}
 }
 
-// Return merged virtual services and the root->delegate vs map
+// Return merged virtual services and the root->delegate vs map.
+// Delegates that are missing or not exported to the root are counted in rejections, if set.
 func mergeVirtualServicesIfNeeded(
 	vServices []config.Config,
-	defaultExportTo map[visibility.Instance]bool) ([]config.Config, map[ConfigKey][]ConfigKey) {
+	defaultExportTo map[visibility.Instance]bool,
+	rejections configRejectionCounts) ([]config.Config, map[ConfigKey][]ConfigKey) {
 	out := make([]config.Config, 0, len(vServices))
 	delegatesMap := map[string]config.Config{}
 	delegatesExportToMap := map[string]map[visibility.Instance]bool{}
@@ -155,6 +157,7 @@ func mergeVirtualServicesIfNeeded(
 					log.Debugf("delegate virtual service %s/%s of %s/%s not found",
 						delegate.Namespace, delegate.Name, root.Namespace, root.Name)
 					// delegate not found, ignore only the current HTTP route
+					rejections.add(gvk.VirtualService, "delegate_not_found")
 					continue
 				}
 				// make sure that the delegate is visible to root virtual service's namespace
@@ -162,6 +165,7 @@ func mergeVirtualServicesIfNeeded(
 				if !exportTo[visibility.Public] && !exportTo[visibility.Instance(root.Namespace)] {
 					log.Debugf("delegate virtual service %s/%s of %s/%s is not exported to %s",
 						delegate.Namespace, delegate.Name, root.Namespace, root.Name, root.Namespace)
+					rejections.add(gvk.VirtualService, "delegate_not_exported")
 					continue
 				}
 				// DeepCopy to prevent mutate the original delegate, it can conflict
@@ -17,6 +17,7 @@ package apigen
 import (
 	"strings"
 
+	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	gogotypes "github.com/gogo/protobuf/types"
 	golangany "github.com/golang/protobuf/ptypes/any"
 
@@ -57,7 +58,7 @@ type APIGenerator struct {
 //
 // Names are based on the current resource naming in istiod stores.
 func (g *APIGenerator) Generate(proxy *model.Proxy, push *model.PushContext, w *model.WatchedResource, req *model.PushRequest) model.Resources {
-	resp := []*golangany.Any{}
+	resp := model.Resources{}
 
 	// Note: this is the style used by MCP and its config. Pilot is using 'Group/Version/Kind' as the
 	// key, which is similar.
@@ -82,9 +83,12 @@ func (g *APIGenerator) Generate(proxy *model.Proxy, push *model.PushContext, w *
 	if w.TypeUrl == collections.IstioMeshV1Alpha1MeshConfig.Resource().GroupVersionKind().String() {
 		meshAny, err := gogotypes.MarshalAny(push.Mesh)
 		if err == nil {
-			resp = append(resp, &golangany.Any{
-				TypeUrl: meshAny.TypeUrl,
-				Value:   meshAny.Value,
+			resp = append(resp, &discovery.Resource{
+				Name: w.TypeUrl,
+				Resource: &golangany.Any{
+					TypeUrl: meshAny.TypeUrl,
+					Value:   meshAny.Value,
+				},
 			})
 		}
 		return resp
@@ -111,9 +115,12 @@ func (g *APIGenerator) Generate(proxy *model.Proxy, push *model.PushContext, w *
 		}
 		bany, err := gogotypes.MarshalAny(b)
 		if err == nil {
-			resp = append(resp, &golangany.Any{
-				TypeUrl: bany.TypeUrl,
-				Value:   bany.Value,
+			resp = append(resp, &discovery.Resource{
+				Name: b.Metadata.Name,
+				Resource: &golangany.Any{
+					TypeUrl: bany.TypeUrl,
+					Value:   bany.Value,
+				},
 			})
 		} else {
 			log.Warn("Any ", err)
@@ -139,9 +146,12 @@ func (g *APIGenerator) Generate(proxy *model.Proxy, push *model.PushContext, w *
 			}
 			bany, err := gogotypes.MarshalAny(b)
 			if err == nil {
-				resp = append(resp, &golangany.Any{
-					TypeUrl: bany.TypeUrl,
-					Value:   bany.Value,
+				resp = append(resp, &discovery.Resource{
+					Name: b.Metadata.Name,
+					Resource: &golangany.Any{
+						TypeUrl: bany.TypeUrl,
+						Value:   bany.Value,
+					},
 				})
 			} else {
 				log.Warn("Any ", err)
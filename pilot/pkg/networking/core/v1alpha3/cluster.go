@@ -26,6 +26,7 @@ import (
 	auth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	xdstype "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/ptypes"
 	structpb "github.com/golang/protobuf/ptypes/struct"
 	"github.com/golang/protobuf/ptypes/wrappers"
 
@@ -237,6 +238,11 @@ func (configgen *ConfigGeneratorImpl) buildOutboundSniDnatClusters(proxy *model.
 			if defaultCluster == nil {
 				continue
 			}
+			// If stat name is configured, build the alternate stats name, so that SNI DNAT clusters
+			// report under the same stable prefix as the regular outbound clusters.
+			if len(cb.push.Mesh.OutboundClusterStatName) != 0 {
+				defaultCluster.AltStatName = util.BuildStatPrefix(cb.push.Mesh.OutboundClusterStatName, string(service.Hostname), "", port, service.Attributes)
+			}
 			subsetClusters := cb.applyDestinationRule(defaultCluster, SniDnatClusterMode, service, port, networkView)
 			clusters = cp.conditionallyAppend(clusters, defaultCluster)
 			clusters = cp.conditionallyAppend(clusters, subsetClusters...)
@@ -602,6 +608,21 @@ func setH2Options(cluster *cluster.Cluster) {
 			Value: 1073741824,
 		},
 	}
+	applyHTTP2Settings(cluster.Http2ProtocolOptions)
+}
+
+// applyHTTP2Settings overrides the HTTP/2 stream limit and flow control windows with the values
+// configured for pilot, if any. It is used for both upstream clusters and downstream listeners.
+func applyHTTP2Settings(opts *core.Http2ProtocolOptions) {
+	if features.HTTP2MaxConcurrentStreams > 0 {
+		opts.MaxConcurrentStreams = &wrappers.UInt32Value{Value: uint32(features.HTTP2MaxConcurrentStreams)}
+	}
+	if features.HTTP2InitialStreamWindowSize > 0 {
+		opts.InitialStreamWindowSize = &wrappers.UInt32Value{Value: uint32(features.HTTP2InitialStreamWindowSize)}
+	}
+	if features.HTTP2InitialConnectionWindowSize > 0 {
+		opts.InitialConnectionWindowSize = &wrappers.UInt32Value{Value: uint32(features.HTTP2InitialConnectionWindowSize)}
+	}
 }
 
 func applyTrafficPolicy(opts buildClusterOpts) {
@@ -615,6 +636,7 @@ func applyTrafficPolicy(opts buildClusterOpts) {
 	}
 	// Connection pool settings are applicable for both inbound and outbound clusters.
 	applyConnectionPool(opts.mesh, opts.cluster, connectionPool)
+	applyDefaultIdleTimeout(opts.cluster)
 	if opts.direction != model.TrafficDirectionInbound {
 		applyH2Upgrade(opts, connectionPool)
 		applyOutlierDetection(opts.cluster, outlierDetection)
@@ -683,6 +705,18 @@ func applyConnectionPool(mesh *meshconfig.MeshConfig, c *cluster.Cluster, settin
 	}
 }
 
+// applyDefaultIdleTimeout sets the default connection idle timeout on clusters which do not have one
+// configured by a DestinationRule.
+func applyDefaultIdleTimeout(c *cluster.Cluster) {
+	if features.HTTPConnectionIdleTimeout <= 0 || c.CommonHttpProtocolOptions.GetIdleTimeout() != nil {
+		return
+	}
+	if c.CommonHttpProtocolOptions == nil {
+		c.CommonHttpProtocolOptions = &core.HttpProtocolOptions{}
+	}
+	c.CommonHttpProtocolOptions.IdleTimeout = ptypes.DurationProto(features.HTTPConnectionIdleTimeout)
+}
+
 func applyTCPKeepalive(mesh *meshconfig.MeshConfig, c *cluster.Cluster, settings *networking.ConnectionPoolSettings) {
 	// Apply Keepalive config only if it is configured in mesh config or in destination rule.
 	if mesh.TcpKeepalive != nil || settings.Tcp.TcpKeepalive != nil {
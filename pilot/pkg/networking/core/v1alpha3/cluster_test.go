@@ -48,8 +48,10 @@ import (
 	"istio.io/istio/pkg/config"
 	"istio.io/istio/pkg/config/constants"
 	"istio.io/istio/pkg/config/host"
+	"istio.io/istio/pkg/config/mesh"
 	"istio.io/istio/pkg/config/protocol"
 	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/config/visibility"
 )
 
 type ConfigType int
@@ -1075,6 +1077,36 @@ func TestStatNamePattern(t *testing.T) {
 	g.Expect(xdstest.ExtractCluster("inbound|10001||", clusters).AltStatName).To(Equal("LocalService_*.example.org"))
 }
 
+func TestSniDnatStatNamePattern(t *testing.T) {
+	g := NewWithT(t)
+
+	statConfigMesh := meshconfig.MeshConfig{
+		ConnectTimeout: &types.Duration{
+			Seconds: 10,
+			Nanos:   1,
+		},
+		EnableAutoMtls: &types.BoolValue{
+			Value: false,
+		},
+		OutboundClusterStatName: "%SERVICE%_%SERVICE_PORT_NAME%_%SERVICE_PORT%_%SUBSET_NAME%",
+	}
+
+	clusters := buildTestClusters(clusterTest{t: t, serviceHostname: "*.example.org", serviceResolution: model.DNSLB, nodeType: model.Router,
+		locality: &core.Locality{}, mesh: statConfigMesh,
+		destRule: &networking.DestinationRule{
+			Host: "*.example.org",
+			Subsets: []*networking.Subset{
+				{
+					Name:   "foobar",
+					Labels: map[string]string{"foo": "bar"},
+				},
+			},
+		},
+		meta: &model.NodeMetadata{RouterMode: string(model.SniDnatRouter)}})
+	g.Expect(xdstest.ExtractCluster("outbound_.8080_._.*.example.org", clusters).AltStatName).To(Equal("*.example.org_default_8080_"))
+	g.Expect(xdstest.ExtractCluster("outbound_.8080_.foobar_.*.example.org", clusters).AltStatName).To(Equal("*.example.org_default_8080_foobar"))
+}
+
 func TestDuplicateClusters(t *testing.T) {
 	buildTestClusters(clusterTest{t: t, serviceHostname: "*.example.org", serviceResolution: model.DNSLB, nodeType: model.SidecarProxy,
 		locality: &core.Locality{}, mesh: testMesh,
@@ -1172,6 +1204,39 @@ func TestSidecarLocalityLB(t *testing.T) {
 	}
 }
 
+func TestGatewayLocalityLB(t *testing.T) {
+	g := NewWithT(t)
+	// Failover locality loadbalancing setting
+	testMesh.LocalityLbSetting = &networking.LocalityLoadBalancerSetting{}
+
+	// Gateway clusters prefer backends in the gateway's own locality, like sidecar clusters do.
+	c := xdstest.ExtractCluster("outbound|8080||*.example.org",
+		buildTestClusters(clusterTest{t: t, serviceHostname: "*.example.org", serviceResolution: model.DNSLB, nodeType: model.Router,
+			locality: &core.Locality{
+				Region:  "region2",
+				Zone:    "zone1",
+				SubZone: "subzone1",
+			}, mesh: testMesh,
+			destRule: &networking.DestinationRule{
+				Host: "*.example.org",
+				TrafficPolicy: &networking.TrafficPolicy{
+					OutlierDetection: &networking.OutlierDetection{
+						MinHealthPercent: 10,
+					},
+				},
+			}}))
+
+	g.Expect(len(c.LoadAssignment.Endpoints)).To(Equal(3))
+	for _, localityLbEndpoint := range c.LoadAssignment.Endpoints {
+		locality := localityLbEndpoint.Locality
+		if locality.Region == "region2" {
+			g.Expect(localityLbEndpoint.Priority).To(Equal(uint32(0)))
+		} else {
+			g.Expect(localityLbEndpoint.Priority).To(Equal(uint32(1)))
+		}
+	}
+}
+
 func TestLocalityLBDestinationRuleOverride(t *testing.T) {
 	g := NewWithT(t)
 	// Distribute locality loadbalancing setting
@@ -3665,3 +3730,198 @@ func TestTelemetryMetadata(t *testing.T) {
 		})
 	}
 }
+
+func TestExtensionProviderClusters(t *testing.T) {
+	sidecar := func(namespace string) config.Config {
+		return config.Config{
+			Meta: config.Meta{
+				Name:             "sidecar",
+				Namespace:        namespace,
+				GroupVersionKind: gvk.Sidecar,
+			},
+			Spec: &networking.Sidecar{
+				Egress: []*networking.IstioEgressListener{{Hosts: []string{"./*"}}},
+			},
+		}
+	}
+	policy := config.Config{
+		Meta: config.Meta{
+			Name:             "ext-authz",
+			Namespace:        "default",
+			GroupVersionKind: gvk.AuthorizationPolicy,
+		},
+		Spec: &authn_beta.AuthorizationPolicy{
+			Action:       authn_beta.AuthorizationPolicy_CUSTOM,
+			ActionDetail: &authn_beta.AuthorizationPolicy_Provider{Provider: &authn_beta.AuthorizationPolicy_ExtensionProvider{Name: "ext-authz"}},
+			Rules:        []*authn_beta.Rule{{}},
+		},
+	}
+	extAuthzService := func(exportTo ...visibility.Instance) *model.Service {
+		svc := &model.Service{
+			Hostname:   "ext-authz.foo.svc.cluster.local",
+			Address:    "10.0.0.1",
+			Ports:      model.PortList{{Name: "grpc", Port: 9000, Protocol: protocol.GRPC}},
+			Attributes: model.ServiceAttributes{Namespace: "foo"},
+		}
+		if len(exportTo) > 0 {
+			svc.Attributes.ExportTo = map[visibility.Instance]bool{}
+			for _, e := range exportTo {
+				svc.Attributes.ExportTo[e] = true
+			}
+		}
+		return svc
+	}
+	m := mesh.DefaultMeshConfig()
+	m.ExtensionProviders = []*meshconfig.MeshConfig_ExtensionProvider{{
+		Name: "ext-authz",
+		Provider: &meshconfig.MeshConfig_ExtensionProvider_EnvoyExtAuthzGrpc{
+			EnvoyExtAuthzGrpc: &meshconfig.MeshConfig_ExtensionProvider_EnvoyExternalAuthorizationGrpcProvider{
+				Service: "foo/ext-authz.foo.svc.cluster.local",
+				Port:    9000,
+			},
+		},
+	}}
+
+	cases := []struct {
+		name      string
+		namespace string
+		service   *model.Service
+		expected  bool
+	}{
+		{
+			// The provider service is not imported by the Sidecar, but its cluster is still needed by ext_authz.
+			name:      "referenced by policy",
+			namespace: "default",
+			service:   extAuthzService(),
+			expected:  true,
+		},
+		{
+			name:      "not referenced by policy",
+			namespace: "other",
+			service:   extAuthzService(),
+			expected:  false,
+		},
+		{
+			name:      "not exported",
+			namespace: "default",
+			service:   extAuthzService(visibility.Private),
+			expected:  false,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			cg := NewConfigGenTest(t, TestOptions{
+				Configs:    []config.Config{sidecar("default"), sidecar("other"), policy},
+				Services:   []*model.Service{tt.service},
+				MeshConfig: &m,
+			})
+			clusters := cg.Clusters(cg.SetupProxy(&model.Proxy{ConfigNamespace: tt.namespace}))
+			xdstest.ValidateClusters(t, clusters)
+
+			cluster := xdstest.ExtractCluster("outbound|9000||ext-authz.foo.svc.cluster.local", clusters)
+			if tt.expected {
+				g.Expect(cluster).NotTo(BeNil())
+			} else {
+				g.Expect(cluster).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestHTTP2Settings(t *testing.T) {
+	g := NewWithT(t)
+
+	defer func(streams, streamWindow, connWindow int) {
+		features.HTTP2MaxConcurrentStreams = streams
+		features.HTTP2InitialStreamWindowSize = streamWindow
+		features.HTTP2InitialConnectionWindowSize = connWindow
+	}(features.HTTP2MaxConcurrentStreams, features.HTTP2InitialStreamWindowSize, features.HTTP2InitialConnectionWindowSize)
+	features.HTTP2MaxConcurrentStreams = 500
+	features.HTTP2InitialStreamWindowSize = 1048576
+	features.HTTP2InitialConnectionWindowSize = 2097152
+
+	expectSettings := func(opts *core.Http2ProtocolOptions) {
+		g.Expect(opts).NotTo(BeNil())
+		g.Expect(opts.MaxConcurrentStreams.GetValue()).To(Equal(uint32(500)))
+		g.Expect(opts.InitialStreamWindowSize.GetValue()).To(Equal(uint32(1048576)))
+		g.Expect(opts.InitialConnectionWindowSize.GetValue()).To(Equal(uint32(2097152)))
+	}
+
+	service := &model.Service{
+		Hostname:   "grpc.default.svc.cluster.local",
+		Address:    "10.0.0.1",
+		Ports:      model.PortList{{Name: "grpc", Port: 9000, Protocol: protocol.GRPC}},
+		Attributes: model.ServiceAttributes{Namespace: "default"},
+	}
+	cg := NewConfigGenTest(t, TestOptions{Services: []*model.Service{service}})
+	c := xdstest.ExtractCluster("outbound|9000||grpc.default.svc.cluster.local", cg.Clusters(cg.SetupProxy(nil)))
+	g.Expect(c).NotTo(BeNil())
+	expectSettings(c.Http2ProtocolOptions)
+
+	listeners := buildInboundListeners(t, &fakePlugin{}, getProxy(), nil, buildService("test.com", wildcardIP, protocol.GRPC, tnow))
+	g.Expect(listeners).To(HaveLen(1))
+	found := false
+	for _, fc := range listeners[0].FilterChains {
+		if hcm := xdstest.ExtractHTTPConnectionManager(t, fc); hcm != nil {
+			expectSettings(hcm.Http2ProtocolOptions)
+			found = true
+		}
+	}
+	g.Expect(found).To(BeTrue())
+}
+
+func TestIdleTimeouts(t *testing.T) {
+	g := NewWithT(t)
+
+	defer func(conn, stream time.Duration) {
+		features.HTTPConnectionIdleTimeout = conn
+		features.HTTPStreamIdleTimeout = stream
+	}(features.HTTPConnectionIdleTimeout, features.HTTPStreamIdleTimeout)
+	features.HTTPConnectionIdleTimeout = time.Hour
+	features.HTTPStreamIdleTimeout = 5 * time.Minute
+
+	services := []*model.Service{
+		{
+			Hostname:   "default.default.svc.cluster.local",
+			Address:    "10.0.0.1",
+			Ports:      model.PortList{{Name: "http", Port: 80, Protocol: protocol.HTTP}},
+			Attributes: model.ServiceAttributes{Namespace: "default"},
+		},
+		{
+			Hostname:   "override.default.svc.cluster.local",
+			Address:    "10.0.0.2",
+			Ports:      model.PortList{{Name: "http", Port: 80, Protocol: protocol.HTTP}},
+			Attributes: model.ServiceAttributes{Namespace: "default"},
+		},
+	}
+	dr := config.Config{
+		Meta: config.Meta{Name: "override", Namespace: "default", GroupVersionKind: gvk.DestinationRule},
+		Spec: &networking.DestinationRule{
+			Host: "override.default.svc.cluster.local",
+			TrafficPolicy: &networking.TrafficPolicy{
+				ConnectionPool: &networking.ConnectionPoolSettings{
+					Http: &networking.ConnectionPoolSettings_HTTPSettings{IdleTimeout: &types.Duration{Seconds: 30}},
+				},
+			},
+		},
+	}
+	cg := NewConfigGenTest(t, TestOptions{Services: services, Configs: []config.Config{dr}})
+	clusters := cg.Clusters(cg.SetupProxy(nil))
+	c := xdstest.ExtractCluster("outbound|80||default.default.svc.cluster.local", clusters)
+	g.Expect(c.GetCommonHttpProtocolOptions().GetIdleTimeout()).To(Equal(ptypes.DurationProto(time.Hour)))
+	c = xdstest.ExtractCluster("outbound|80||override.default.svc.cluster.local", clusters)
+	g.Expect(c.GetCommonHttpProtocolOptions().GetIdleTimeout()).To(Equal(ptypes.DurationProto(30 * time.Second)))
+
+	listeners := buildInboundListeners(t, &fakePlugin{}, getProxy(), nil, buildService("test.com", wildcardIP, protocol.HTTP, tnow))
+	g.Expect(listeners).To(HaveLen(1))
+	found := false
+	for _, fc := range listeners[0].FilterChains {
+		if hcm := xdstest.ExtractHTTPConnectionManager(t, fc); hcm != nil {
+			g.Expect(hcm.GetCommonHttpProtocolOptions().GetIdleTimeout()).To(Equal(ptypes.DurationProto(time.Hour)))
+			g.Expect(hcm.StreamIdleTimeout).To(Equal(ptypes.DurationProto(5 * time.Minute)))
+			found = true
+		}
+	}
+	g.Expect(found).To(BeTrue())
+}
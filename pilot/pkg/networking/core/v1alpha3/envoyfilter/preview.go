@@ -0,0 +1,146 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envoyfilter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	xdslistener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	"github.com/golang/protobuf/proto"
+
+	networking "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pilot/pkg/networking/util"
+)
+
+// ResourceDiff is a resource changed by an EnvoyFilter. Before is nil if the resource is added by the
+// EnvoyFilter, and After is nil if it is removed.
+type ResourceDiff struct {
+	Name   string
+	Before proto.Message
+	After  proto.Message
+}
+
+// PreviewResult holds the resources of a proxy an EnvoyFilter would change.
+type PreviewResult struct {
+	Listeners []ResourceDiff
+	Clusters  []ResourceDiff
+}
+
+// Preview computes the effect the patches of an EnvoyFilter would have on the given listeners and
+// clusters of a proxy, without modifying them. Only listener and cluster patches can be previewed,
+// as route patches are applied while routes are generated.
+func Preview(proxy *model.Proxy, push *model.PushContext, efw *model.EnvoyFilterWrapper,
+	listeners []*xdslistener.Listener, clusters []*cluster.Cluster) (*PreviewResult, error) {
+	out := &PreviewResult{}
+	if efw == nil {
+		return out, nil
+	}
+	for _, applyTo := range []networking.EnvoyFilter_ApplyTo{
+		networking.EnvoyFilter_ROUTE_CONFIGURATION,
+		networking.EnvoyFilter_VIRTUAL_HOST,
+		networking.EnvoyFilter_HTTP_ROUTE,
+	} {
+		if len(efw.Patches[applyTo]) > 0 {
+			return nil, fmt.Errorf("preview is not supported for %v patches", applyTo)
+		}
+	}
+
+	var inbound, outbound []*xdslistener.Listener
+	for _, l := range listeners {
+		l = proto.Clone(l).(*xdslistener.Listener)
+		if l.TrafficDirection == core.TrafficDirection_INBOUND || l.Name == VirtualInboundListenerName {
+			inbound = append(inbound, l)
+		} else {
+			outbound = append(outbound, l)
+		}
+	}
+	var patched []*xdslistener.Listener
+	if proxy.Type == model.SidecarProxy {
+		patched = append(patched, ApplyListenerPatches(networking.EnvoyFilter_SIDECAR_INBOUND, proxy, push, efw, inbound, false)...)
+		patched = append(patched, ApplyListenerPatches(networking.EnvoyFilter_SIDECAR_OUTBOUND, proxy, push, efw, outbound, false)...)
+	} else {
+		patched = ApplyListenerPatches(networking.EnvoyFilter_GATEWAY, proxy, push, efw, append(inbound, outbound...), false)
+	}
+	before := make(map[string]proto.Message, len(listeners))
+	after := make(map[string]proto.Message, len(patched))
+	for _, l := range listeners {
+		before[l.Name] = l
+	}
+	for _, l := range patched {
+		after[l.Name] = l
+	}
+	out.Listeners = diffResources(before, after)
+
+	before = make(map[string]proto.Message, len(clusters))
+	after = make(map[string]proto.Message, len(clusters))
+	for _, c := range clusters {
+		before[c.Name] = c
+		pctx := clusterPatchContext(proxy, c.Name)
+		c = proto.Clone(c).(*cluster.Cluster)
+		if ShouldKeepCluster(pctx, efw, c) {
+			after[c.Name] = ApplyClusterMerge(pctx, efw, c)
+		}
+	}
+	pctxs := []networking.EnvoyFilter_PatchContext{networking.EnvoyFilter_GATEWAY}
+	if proxy.Type == model.SidecarProxy {
+		pctxs = []networking.EnvoyFilter_PatchContext{networking.EnvoyFilter_SIDECAR_OUTBOUND, networking.EnvoyFilter_SIDECAR_INBOUND}
+	}
+	for _, pctx := range pctxs {
+		for _, c := range InsertedClusters(pctx, efw) {
+			after[c.Name] = c
+		}
+	}
+	out.Clusters = diffResources(before, after)
+
+	return out, nil
+}
+
+// clusterPatchContext returns the patch context a cluster is patched with when generated.
+func clusterPatchContext(proxy *model.Proxy, name string) networking.EnvoyFilter_PatchContext {
+	if proxy.Type != model.SidecarProxy {
+		return networking.EnvoyFilter_GATEWAY
+	}
+	if strings.HasPrefix(name, string(model.TrafficDirectionInbound)+"|") ||
+		name == util.InboundPassthroughClusterIpv4 || name == util.InboundPassthroughClusterIpv6 {
+		return networking.EnvoyFilter_SIDECAR_INBOUND
+	}
+	return networking.EnvoyFilter_SIDECAR_OUTBOUND
+}
+
+func diffResources(before, after map[string]proto.Message) []ResourceDiff {
+	var out []ResourceDiff
+	for name, b := range before {
+		a, f := after[name]
+		if !f {
+			out = append(out, ResourceDiff{Name: name, Before: b})
+		} else if !proto.Equal(a, b) {
+			out = append(out, ResourceDiff{Name: name, Before: b, After: a})
+		}
+	}
+	for name, a := range after {
+		if _, f := before[name]; !f {
+			out = append(out, ResourceDiff{Name: name, After: a})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
@@ -422,7 +422,7 @@ func buildGatewayListenerTLSContext(
 
 	ctx := &tls.DownstreamTlsContext{
 		CommonTlsContext: &tls.CommonTlsContext{
-			AlpnProtocols: util.ALPNHttp,
+			AlpnProtocols: gatewayServerALPN(server),
 		},
 	}
 
@@ -487,6 +487,19 @@ func buildGatewayListenerTLSContext(
 	return ctx
 }
 
+// gatewayServerALPN returns the ALPN protocols advertised by a TLS terminating gateway server.
+// HTTP servers negotiate h2 or http/1.1. Servers terminating TLS for opaque TCP traffic must not
+// advertise HTTP protocols; ISTIO_MUTUAL servers advertise the istio ALPN used by in-mesh clusters.
+func gatewayServerALPN(server *networking.Server) []string {
+	if server.Port == nil || protocol.Parse(server.Port.Protocol) != protocol.TLS {
+		return util.ALPNHttp
+	}
+	if server.Tls.Mode == networking.ServerTLSSettings_ISTIO_MUTUAL {
+		return util.ALPNInMesh
+	}
+	return nil
+}
+
 func convertTLSProtocol(in networking.ServerTLSSettings_TLSProtocol) tls.TlsParameters_TlsProtocol {
 	out := tls.TlsParameters_TlsProtocol(in) // There should be a one-to-one enum mapping
 	if out < tls.TlsParameters_TLS_AUTO || out > tls.TlsParameters_TLSv1_3 {
@@ -608,6 +621,7 @@ func buildGatewayNetworkFiltersFromTLSRoutes(node *model.Proxy, push *model.Push
 		})
 	} else {
 		virtualServices := push.VirtualServicesForGateway(node, gatewayName)
+		seenSNIHosts := map[string]bool{}
 		for _, v := range virtualServices {
 			vsvc := v.Spec.(*networking.VirtualService)
 			// We have two cases here:
@@ -621,16 +635,29 @@ func buildGatewayNetworkFiltersFromTLSRoutes(node *model.Proxy, push *model.Push
 				continue
 			}
 
-			// For every matching TLS block, generate a filter chain with sni match
-			// TODO: Bug..if there is a single virtual service with *.foo.com, and multiple TLS block
-			// matches, one for 1.foo.com, another for 2.foo.com, this code will produce duplicate filter
-			// chain matches
+			// For every matching TLS block, generate a filter chain with sni match. Envoy rejects
+			// listeners with duplicate filter chain matches, so each SNI host is only matched by the
+			// first TLS block it appears in.
 			for _, tls := range vsvc.Tls {
 				for _, match := range tls.Match {
 					if l4SingleMatch(convertTLSMatchToL4Match(match), server, gatewayName) {
+						// Only route the SNI hosts this server is actually exposing.
+						sniHosts := make([]string, 0, len(match.SniHosts))
+						for _, sni := range sniHostsForServer(match.SniHosts, matchingHosts) {
+							if seenSNIHosts[sni] {
+								log.Debugf("skipping duplicate SNI host %s in virtual service %s/%s for gateway %s",
+									sni, v.Namespace, v.Name, gatewayName)
+								continue
+							}
+							seenSNIHosts[sni] = true
+							sniHosts = append(sniHosts, sni)
+						}
+						if len(sniHosts) == 0 {
+							continue
+						}
 						// the sni hosts in the match will become part of a filter chain match
 						filterChains = append(filterChains, &filterChainOpts{
-							sniHosts:       match.SniHosts,
+							sniHosts:       sniHosts,
 							tlsContext:     nil, // NO TLS context because this is passthrough
 							networkFilters: buildOutboundNetworkFilters(node, tls.Route, push, port, v.Meta),
 						})
@@ -643,6 +670,24 @@ func buildGatewayNetworkFiltersFromTLSRoutes(node *model.Proxy, push *model.Push
 	return filterChains
 }
 
+// sniHostsForServer returns the SNI hosts that overlap with the gateway server hosts matched by the
+// virtual service.
+func sniHostsForServer(sniHosts []string, matchingHosts map[string]host.Name) []string {
+	out := make([]string, 0, len(sniHosts))
+	for _, sni := range sniHosts {
+		for _, gatewayHost := range matchingHosts {
+			if parts := strings.Split(string(gatewayHost), "/"); len(parts) == 2 {
+				gatewayHost = host.Name(parts[1])
+			}
+			if gatewayHost.Matches(host.Name(sni)) {
+				out = append(out, sni)
+				break
+			}
+		}
+	}
+	return out
+}
+
 // Select the virtualService's hosts that match the ones specified in the gateway server's hosts
 // based on the wildcard hostname match and the namespace match
 func pickMatchingGatewayHosts(gatewayServerHosts map[host.Name]bool, virtualService config.Config) map[string]host.Name {
@@ -21,20 +21,27 @@ import (
 	"time"
 
 	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	extauthzhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
+	envoy_jwt "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
 	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	auth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/protobuf/testing/protocmp"
 
 	meshconfig "istio.io/api/mesh/v1alpha1"
 	networking "istio.io/api/networking/v1alpha3"
+	security "istio.io/api/security/v1beta1"
+	selectorpb "istio.io/api/type/v1beta1"
 	pilot_model "istio.io/istio/pilot/pkg/model"
 	"istio.io/istio/pilot/pkg/networking/plugin"
 	"istio.io/istio/pilot/pkg/networking/util"
 	"istio.io/istio/pilot/pkg/security/model"
 	"istio.io/istio/pilot/test/xdstest"
 	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/mesh"
+	"istio.io/istio/pkg/config/protocol"
 	"istio.io/istio/pkg/config/schema/gvk"
 	"istio.io/istio/pkg/proto"
 )
@@ -385,6 +392,38 @@ func TestBuildGatewayListenerTlsContext(t *testing.T) {
 	}
 }
 
+func TestBuildGatewayListenerTLSContextALPN(t *testing.T) {
+	testCases := []struct {
+		name     string
+		protocol string
+		mode     networking.ServerTLSSettings_TLSmode
+		alpn     []string
+	}{
+		{"https simple", "HTTPS", networking.ServerTLSSettings_SIMPLE, util.ALPNHttp},
+		{"https istio mutual", "HTTPS", networking.ServerTLSSettings_ISTIO_MUTUAL, util.ALPNHttp},
+		{"tls simple", "TLS", networking.ServerTLSSettings_SIMPLE, nil},
+		{"tls mutual", "TLS", networking.ServerTLSSettings_MUTUAL, nil},
+		{"tls istio mutual", "TLS", networking.ServerTLSSettings_ISTIO_MUTUAL, util.ALPNInMesh},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &networking.Server{
+				Hosts: []string{"httpbin.example.com"},
+				Port:  &networking.Port{Name: "tls", Number: 443, Protocol: tc.protocol},
+				Tls: &networking.ServerTLSSettings{
+					Mode:              tc.mode,
+					ServerCertificate: "server-cert.crt",
+					PrivateKey:        "private-key.key",
+				},
+			}
+			ret := buildGatewayListenerTLSContext(server, "", &pilot_model.NodeMetadata{})
+			if !reflect.DeepEqual(ret.CommonTlsContext.AlpnProtocols, tc.alpn) {
+				t.Errorf("expected ALPN %v, got %v", tc.alpn, ret.CommonTlsContext.AlpnProtocols)
+			}
+		})
+	}
+}
+
 func TestCreateGatewayHTTPFilterChainOpts(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -1269,3 +1308,409 @@ func TestBuildGatewayListeners(t *testing.T) {
 		xdstest.ValidateListeners(t, builder.gatewayListeners)
 	}
 }
+
+func TestBuildGatewayListenerTLSFilterChains(t *testing.T) {
+	gateway := config.Config{
+		Meta: config.Meta{
+			Name:             "gateway",
+			Namespace:        "default",
+			GroupVersionKind: gvk.Gateway,
+		},
+		Spec: &networking.Gateway{
+			Selector: map[string]string{"istio": "ingressgateway"},
+			Servers: []*networking.Server{
+				{
+					Hosts: []string{"example.org"},
+					Port:  &networking.Port{Name: "https", Number: 443, Protocol: "HTTPS"},
+					Tls:   &networking.ServerTLSSettings{Mode: networking.ServerTLSSettings_SIMPLE, CredentialName: "example-cert"},
+				},
+				{
+					Hosts: []string{"example.org"},
+					Port:  &networking.Port{Name: "https-mtls", Number: 8443, Protocol: "HTTPS"},
+					Tls:   &networking.ServerTLSSettings{Mode: networking.ServerTLSSettings_MUTUAL, CredentialName: "example-cert"},
+				},
+				{
+					Hosts: []string{"*.example.com"},
+					Port:  &networking.Port{Name: "tls", Number: 9443, Protocol: "TLS"},
+					Tls:   &networking.ServerTLSSettings{Mode: networking.ServerTLSSettings_PASSTHROUGH},
+				},
+			},
+		},
+	}
+	tlsRoute := func(dest string, sniHosts ...string) *networking.TLSRoute {
+		return &networking.TLSRoute{
+			Match: []*networking.TLSMatchAttributes{{SniHosts: sniHosts}},
+			Route: []*networking.RouteDestination{{
+				Destination: &networking.Destination{Host: dest, Port: &networking.PortSelector{Number: 443}},
+			}},
+		}
+	}
+	virtualService := config.Config{
+		Meta: config.Meta{
+			Name:             "passthrough",
+			Namespace:        "default",
+			GroupVersionKind: gvk.VirtualService,
+		},
+		Spec: &networking.VirtualService{
+			Hosts:    []string{"a.example.com", "b.example.com", "c.example.com"},
+			Gateways: []string{"gateway"},
+			Tls: []*networking.TLSRoute{
+				tlsRoute("a.default.svc.cluster.local", "a.example.com"),
+				// Duplicates the SNI match above, and must not produce a second filter chain.
+				tlsRoute("other.default.svc.cluster.local", "a.example.com"),
+				tlsRoute("b.default.svc.cluster.local", "b.example.com"),
+				// Partially overlaps the SNI match above, only the new host is matched.
+				tlsRoute("c.default.svc.cluster.local", "b.example.com", "c.example.com"),
+			},
+		},
+	}
+
+	cg := NewConfigGenTest(t, TestOptions{
+		Configs: []config.Config{gateway, virtualService},
+	})
+	proxy := cg.SetupProxy(&proxyGateway)
+	builder := cg.ConfigGen.buildGatewayListeners(&ListenerBuilder{node: proxy, push: cg.PushContext()})
+	xdstest.ValidateListeners(t, builder.gatewayListeners)
+
+	for name, requireClientCert := range map[string]bool{"0.0.0.0_443": false, "0.0.0.0_8443": true} {
+		l := xdstest.ExtractListener(name, builder.gatewayListeners)
+		if l == nil || len(l.FilterChains) != 1 {
+			t.Fatalf("expected a single filter chain for %s, got %v", name, l)
+		}
+		ts := l.FilterChains[0].TransportSocket
+		if ts == nil {
+			t.Fatalf("expected %s to terminate TLS", name)
+		}
+		tlsContext := &auth.DownstreamTlsContext{}
+		if err := ptypes.UnmarshalAny(ts.GetTypedConfig(), tlsContext); err != nil {
+			t.Fatal(err)
+		}
+		if got := tlsContext.GetRequireClientCertificate().GetValue(); got != requireClientCert {
+			t.Errorf("%s: expected require client certificate %v, got %v", name, requireClientCert, got)
+		}
+	}
+
+	l := xdstest.ExtractListener("0.0.0.0_9443", builder.gatewayListeners)
+	if l == nil {
+		t.Fatal("expected a passthrough listener on port 9443")
+	}
+	if _, f := xdstest.ExtractListenerFilters(l)[wellknown.TlsInspector]; !f {
+		t.Errorf("expected a tls inspector for SNI matching on the passthrough listener")
+	}
+	var sniHosts [][]string
+	for _, fc := range l.FilterChains {
+		if fc.TransportSocket != nil {
+			t.Errorf("expected passthrough filter chain %v to not terminate TLS", fc.FilterChainMatch)
+		}
+		sniHosts = append(sniHosts, fc.GetFilterChainMatch().GetServerNames())
+	}
+	expected := [][]string{{"a.example.com"}, {"b.example.com"}, {"c.example.com"}}
+	if !reflect.DeepEqual(sniHosts, expected) {
+		t.Errorf("expected SNI filter chain matches %v, got %v", expected, sniHosts)
+	}
+	if cluster := xdstest.ExtractTCPProxy(t, l.FilterChains[0]).GetCluster(); cluster != "outbound|443||a.default.svc.cluster.local" {
+		t.Errorf("expected first SNI match to route to a.default.svc.cluster.local, got %s", cluster)
+	}
+}
+
+func TestGatewayPassthroughSNIRouting(t *testing.T) {
+	gateway := config.Config{
+		Meta: config.Meta{
+			Name:             "gateway",
+			Namespace:        "default",
+			GroupVersionKind: gvk.Gateway,
+		},
+		Spec: &networking.Gateway{
+			Selector: map[string]string{"istio": "ingressgateway"},
+			Servers: []*networking.Server{
+				{
+					Hosts: []string{"*.example.com"},
+					Port:  &networking.Port{Name: "tls", Number: 443, Protocol: "TLS"},
+					Tls:   &networking.ServerTLSSettings{Mode: networking.ServerTLSSettings_PASSTHROUGH},
+				},
+			},
+		},
+	}
+	tlsRoute := func(sni string, dest string) *networking.TLSRoute {
+		return &networking.TLSRoute{
+			Match: []*networking.TLSMatchAttributes{{SniHosts: []string{sni}}},
+			Route: []*networking.RouteDestination{{
+				Destination: &networking.Destination{Host: dest, Port: &networking.PortSelector{Number: 443}},
+			}},
+		}
+	}
+	virtualService := config.Config{
+		Meta: config.Meta{
+			Name:             "passthrough",
+			Namespace:        "default",
+			GroupVersionKind: gvk.VirtualService,
+		},
+		Spec: &networking.VirtualService{
+			Hosts:    []string{"a.example.com", "b.example.com", "c.example.org"},
+			Gateways: []string{"gateway"},
+			Tls: []*networking.TLSRoute{
+				tlsRoute("a.example.com", "a.default.svc.cluster.local"),
+				tlsRoute("b.example.com", "b.default.svc.cluster.local"),
+				// Not exposed by the gateway server, so it must not get a filter chain.
+				tlsRoute("c.example.org", "c.default.svc.cluster.local"),
+			},
+		},
+	}
+
+	cg := NewConfigGenTest(t, TestOptions{
+		Configs: []config.Config{gateway, virtualService},
+	})
+	proxy := cg.SetupProxy(&proxyGateway)
+	builder := cg.ConfigGen.buildGatewayListeners(&ListenerBuilder{node: proxy, push: cg.PushContext()})
+	xdstest.ValidateListeners(t, builder.gatewayListeners)
+
+	l := xdstest.ExtractListener("0.0.0.0_443", builder.gatewayListeners)
+	if l == nil {
+		t.Fatal("expected a passthrough listener on port 443")
+	}
+	if _, f := xdstest.ExtractListenerFilters(l)[wellknown.TlsInspector]; !f {
+		t.Errorf("expected a tls inspector for SNI matching")
+	}
+	got := map[string]string{}
+	for _, fc := range l.FilterChains {
+		for _, sni := range fc.GetFilterChainMatch().GetServerNames() {
+			got[sni] = xdstest.ExtractTCPProxy(t, fc).GetCluster()
+		}
+	}
+	expected := map[string]string{
+		"a.example.com": "outbound|443||a.default.svc.cluster.local",
+		"b.example.com": "outbound|443||b.default.svc.cluster.local",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected SNI routes %v, got %v", expected, got)
+	}
+}
+
+func TestGatewayRequestAuthenticationJwtFilter(t *testing.T) {
+	gateway := config.Config{
+		Meta: config.Meta{
+			Name:             "gateway",
+			Namespace:        "not-default",
+			GroupVersionKind: gvk.Gateway,
+		},
+		Spec: &networking.Gateway{
+			Selector: map[string]string{"istio": "ingressgateway"},
+			Servers: []*networking.Server{{
+				Hosts: []string{"*"},
+				Port:  &networking.Port{Name: "http", Number: 80, Protocol: "HTTP"},
+			}},
+		},
+	}
+	requestAuthn := func(rules ...*security.JWTRule) config.Config {
+		return config.Config{
+			Meta: config.Meta{
+				Name:             "jwt",
+				Namespace:        "not-default",
+				GroupVersionKind: gvk.RequestAuthentication,
+			},
+			Spec: &security.RequestAuthentication{
+				Selector: &selectorpb.WorkloadSelector{MatchLabels: map[string]string{"istio": "ingressgateway"}},
+				JwtRules: rules,
+			},
+		}
+	}
+	const jwks = `{"keys":[]}`
+
+	cases := []struct {
+		name     string
+		rules    []*security.JWTRule
+		expected map[string][]string
+	}{
+		{
+			name:     "single issuer",
+			rules:    []*security.JWTRule{{Issuer: "https://a.example.com", Jwks: jwks, Audiences: []string{"a"}}},
+			expected: map[string][]string{"https://a.example.com": {"a"}},
+		},
+		{
+			name: "multiple issuers",
+			rules: []*security.JWTRule{
+				{Issuer: "https://a.example.com", Jwks: jwks, Audiences: []string{"a"}},
+				{Issuer: "https://b.example.com", Jwks: jwks, Audiences: []string{"b1", "b2"}},
+			},
+			expected: map[string][]string{
+				"https://a.example.com": {"a"},
+				"https://b.example.com": {"b1", "b2"},
+			},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cg := NewConfigGenTest(t, TestOptions{
+				Configs: []config.Config{gateway, requestAuthn(tt.rules...)},
+			})
+			proxy := cg.SetupProxy(&proxyGateway)
+			builder := cg.ConfigGen.buildGatewayListeners(&ListenerBuilder{node: proxy, push: cg.PushContext()})
+			l := xdstest.ExtractListener("0.0.0.0_80", builder.gatewayListeners)
+			if l == nil {
+				t.Fatal("expected a listener on port 80")
+			}
+			var jwtConfig *envoy_jwt.JwtAuthentication
+			for _, f := range xdstest.ExtractHTTPConnectionManager(t, l.FilterChains[0]).HttpFilters {
+				if f.Name == model.EnvoyJwtFilterName {
+					jwtConfig = &envoy_jwt.JwtAuthentication{}
+					if err := ptypes.UnmarshalAny(f.GetTypedConfig(), jwtConfig); err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+			if jwtConfig == nil {
+				t.Fatal("expected a jwt authn filter")
+			}
+			got := map[string][]string{}
+			for _, p := range jwtConfig.Providers {
+				if p.GetLocalJwks().GetInlineString() != jwks {
+					t.Errorf("expected inline jwks for %s, got %v", p.Issuer, p.JwksSourceSpecifier)
+				}
+				got[p.Issuer] = p.Audiences
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected providers %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestGatewayCustomAuthorization(t *testing.T) {
+	gateway := config.Config{
+		Meta: config.Meta{
+			Name:             "gateway",
+			Namespace:        "not-default",
+			GroupVersionKind: gvk.Gateway,
+		},
+		Spec: &networking.Gateway{
+			Selector: map[string]string{"istio": "ingressgateway"},
+			Servers: []*networking.Server{{
+				Hosts: []string{"*"},
+				Port:  &networking.Port{Name: "http", Number: 80, Protocol: "HTTP"},
+			}},
+		},
+	}
+	policy := config.Config{
+		Meta: config.Meta{
+			Name:             "ext-authz",
+			Namespace:        "not-default",
+			GroupVersionKind: gvk.AuthorizationPolicy,
+		},
+		Spec: &security.AuthorizationPolicy{
+			Selector:     &selectorpb.WorkloadSelector{MatchLabels: map[string]string{"istio": "ingressgateway"}},
+			Action:       security.AuthorizationPolicy_CUSTOM,
+			ActionDetail: &security.AuthorizationPolicy_Provider{Provider: &security.AuthorizationPolicy_ExtensionProvider{Name: "ext-authz"}},
+			Rules:        []*security.Rule{{To: []*security.Rule_To{{Operation: &security.Operation{Paths: []string{"/admin"}}}}}},
+		},
+	}
+	extAuthzService := &pilot_model.Service{
+		Hostname:   "ext-authz.foo.svc.cluster.local",
+		Address:    "10.0.0.1",
+		Ports:      pilot_model.PortList{{Name: "grpc", Port: 9000, Protocol: protocol.GRPC}},
+		Attributes: pilot_model.ServiceAttributes{Namespace: "foo"},
+	}
+	m := mesh.DefaultMeshConfig()
+	m.ExtensionProviders = []*meshconfig.MeshConfig_ExtensionProvider{{
+		Name: "ext-authz",
+		Provider: &meshconfig.MeshConfig_ExtensionProvider_EnvoyExtAuthzGrpc{
+			EnvoyExtAuthzGrpc: &meshconfig.MeshConfig_ExtensionProvider_EnvoyExternalAuthorizationGrpcProvider{
+				Service: "foo/ext-authz.foo.svc.cluster.local",
+				Port:    9000,
+			},
+		},
+	}}
+
+	cg := NewConfigGenTest(t, TestOptions{
+		Configs:    []config.Config{gateway, policy},
+		Services:   []*pilot_model.Service{extAuthzService},
+		MeshConfig: &m,
+	})
+	proxy := cg.SetupProxy(&proxyGateway)
+	builder := cg.ConfigGen.buildGatewayListeners(&ListenerBuilder{node: proxy, push: cg.PushContext()})
+	l := xdstest.ExtractListener("0.0.0.0_80", builder.gatewayListeners)
+	if l == nil {
+		t.Fatal("expected a listener on port 80")
+	}
+	var extAuthz *extauthzhttp.ExtAuthz
+	for _, f := range xdstest.ExtractHTTPConnectionManager(t, l.FilterChains[0]).HttpFilters {
+		if f.Name == wellknown.HTTPExternalAuthorization {
+			extAuthz = &extauthzhttp.ExtAuthz{}
+			if err := ptypes.UnmarshalAny(f.GetTypedConfig(), extAuthz); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if extAuthz == nil {
+		t.Fatal("expected an ext_authz filter")
+	}
+	const expectedCluster = "outbound|9000||ext-authz.foo.svc.cluster.local"
+	if got := extAuthz.GetGrpcService().GetEnvoyGrpc().GetClusterName(); got != expectedCluster {
+		t.Errorf("expected ext_authz to use cluster %s, got %s", expectedCluster, got)
+	}
+	if xdstest.ExtractCluster(expectedCluster, cg.Clusters(proxy)) == nil {
+		t.Errorf("expected provider cluster %s to be generated", expectedCluster)
+	}
+}
+
+func TestGatewayProxyProtocol(t *testing.T) {
+	gateway := config.Config{
+		Meta: config.Meta{
+			Name:             "gateway",
+			Namespace:        "not-default",
+			GroupVersionKind: gvk.Gateway,
+		},
+		Spec: &networking.Gateway{
+			Selector: map[string]string{"istio": "ingressgateway"},
+			Servers: []*networking.Server{
+				{
+					Hosts: []string{"*"},
+					Port:  &networking.Port{Name: "http", Number: 80, Protocol: "HTTP"},
+				},
+				{
+					Hosts: []string{"*.example.com"},
+					Port:  &networking.Port{Name: "tls", Number: 443, Protocol: "TLS"},
+					Tls:   &networking.ServerTLSSettings{Mode: networking.ServerTLSSettings_PASSTHROUGH},
+				},
+			},
+		},
+	}
+	cases := []struct {
+		name          string
+		proxyProtocol bool
+	}{
+		{"enabled", true},
+		{"disabled", false},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cg := NewConfigGenTest(t, TestOptions{Configs: []config.Config{gateway}})
+			proxy := cg.SetupProxy(&pilot_model.Proxy{
+				Type:            pilot_model.Router,
+				IPAddresses:     []string{"1.1.1.1"},
+				ID:              "v0.default",
+				DNSDomain:       "default.example.org",
+				ConfigNamespace: "not-default",
+				Metadata: &pilot_model.NodeMetadata{
+					Namespace:     "not-default",
+					Labels:        map[string]string{"istio": "ingressgateway"},
+					ProxyProtocol: pilot_model.StringBool(tt.proxyProtocol),
+				},
+			})
+			builder := cg.ConfigGen.buildGatewayListeners(&ListenerBuilder{node: proxy, push: cg.PushContext()})
+			for _, name := range []string{"0.0.0.0_80", "0.0.0.0_443"} {
+				l := xdstest.ExtractListener(name, builder.gatewayListeners)
+				if l == nil {
+					t.Fatalf("expected listener %s", name)
+				}
+				names := []string{}
+				for _, lf := range l.ListenerFilters {
+					names = append(names, lf.Name)
+				}
+				hasProxyProtocol := len(names) > 0 && names[0] == wellknown.ProxyProtocol
+				if hasProxyProtocol != tt.proxyProtocol {
+					t.Errorf("%s: expected PROXY protocol filter first: %v, got listener filters %v", name, tt.proxyProtocol, names)
+				}
+			}
+		})
+	}
+}
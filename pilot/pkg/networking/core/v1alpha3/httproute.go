@@ -144,6 +144,15 @@ func (configgen *ConfigGeneratorImpl) buildSidecarOutboundHTTPRouteConfig(node *
 		}
 	}
 
+	// Proxies sharing the same route inputs generate the same route configuration; reuse it if present.
+	routeCache := sidecarRouteCache(node, push, routeName, listenerPort)
+	if cached, f := configgen.Cache.Get(routeCache); f {
+		out := &route.RouteConfiguration{}
+		if err := ptypes.UnmarshalAny(cached, out); err == nil {
+			return out
+		}
+	}
+
 	cacheHit := false
 	if useSniffing && listenerPort != 0 {
 		// Check if we have already computed the list of all virtual hosts for this port
@@ -172,7 +181,7 @@ func (configgen *ConfigGeneratorImpl) buildSidecarOutboundHTTPRouteConfig(node *
 	util.SortVirtualHosts(virtualHosts)
 
 	if !useSniffing {
-		virtualHosts = append(virtualHosts, buildCatchAllVirtualHost(node))
+		virtualHosts = append(virtualHosts, buildCatchAllVirtualHost(push, node))
 	}
 
 	out := &route.RouteConfiguration{
@@ -180,10 +189,24 @@ func (configgen *ConfigGeneratorImpl) buildSidecarOutboundHTTPRouteConfig(node *
 		VirtualHosts:     virtualHosts,
 		ValidateClusters: proto.BoolFalse,
 	}
+	configgen.Cache.Add(routeCache, util.MessageToAny(out))
 
 	return out
 }
 
+// sidecarRouteCache returns the cache entry for the outbound route configuration, keyed by its inputs.
+// It returns nil, which is never cached, if RDS caching is disabled.
+func sidecarRouteCache(node *model.Proxy, push *model.PushContext, routeName string, listenerPort int) *istio_route.Cache {
+	if !features.EnableRDSCaching {
+		return nil
+	}
+	egressListener := node.SidecarScope.GetEgressListenerForRDS(listenerPort, routeName)
+	if egressListener == nil {
+		return nil
+	}
+	return istio_route.NewCache(node, push, routeName, listenerPort, egressListener.Services(), egressListener.VirtualServices())
+}
+
 func (configgen *ConfigGeneratorImpl) buildSidecarOutboundVirtualHosts(node *model.Proxy, push *model.PushContext,
 	routeName string, listenerPort int) []*route.VirtualHost {
 
@@ -515,7 +538,18 @@ func getUniqueAndSharedDNSDomain(fqdnHostname, proxyDomain string) (string, stri
 	return uniqHostame, sharedSuffixes
 }
 
-func buildCatchAllVirtualHost(node *model.Proxy) *route.VirtualHost {
+// egressProxyCluster returns the cluster that unmatched outbound traffic is sent to when the outbound
+// traffic policy sets an egress proxy. The egress proxy host is resolved against the services visible
+// to the proxy, e.g. one declared by a ServiceEntry, so that the cluster name matches the cluster
+// generated for that service. If no such service exists, the destination is still used as is, as
+// it may refer to a cluster statically configured in the bootstrap. Such egress proxies are reported
+// in the push status when the push context is initialized.
+func egressProxyCluster(push *model.PushContext, node *model.Proxy, egressProxy *networking.Destination) string {
+	service := push.ServiceForHostname(node, host.Name(egressProxy.Host))
+	return istio_route.GetDestinationCluster(egressProxy, service, 0)
+}
+
+func buildCatchAllVirtualHost(push *model.PushContext, node *model.Proxy) *route.VirtualHost {
 	if util.IsAllowAnyOutbound(node) {
 		egressCluster := util.PassthroughCluster
 		notimeout := ptypes.DurationProto(0)
@@ -524,8 +558,7 @@ func buildCatchAllVirtualHost(node *model.Proxy) *route.VirtualHost {
 		if node.SidecarScope.OutboundTrafficPolicy.EgressProxy != nil {
 			// user has provided an explicit destination for all the unknown traffic.
 			// build a cluster out of this destination
-			egressCluster = istio_route.GetDestinationCluster(node.SidecarScope.OutboundTrafficPolicy.EgressProxy,
-				nil, 0)
+			egressCluster = egressProxyCluster(push, node, node.SidecarScope.OutboundTrafficPolicy.EgressProxy)
 		}
 
 		routeAction := &route.RouteAction{
@@ -22,9 +22,12 @@ import (
 	"time"
 
 	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
 
 	meshapi "istio.io/api/mesh/v1alpha1"
 	networking "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/features"
 	"istio.io/istio/pilot/pkg/model"
 	"istio.io/istio/pilot/pkg/networking/plugin"
 	"istio.io/istio/pilot/pkg/serviceregistry"
@@ -310,6 +313,141 @@ func TestSidecarOutboundHTTPRouteConfigWithDuplicateHosts(t *testing.T) {
 
 }
 
+// countingCache wraps an XdsCache and counts the lookups that were served from the cache or missed it.
+type countingCache struct {
+	model.XdsCache
+	hits   int
+	misses int
+}
+
+func (c *countingCache) Get(entry model.XdsCacheEntry) (*any.Any, bool) {
+	v, f := c.XdsCache.Get(entry)
+	if f {
+		c.hits++
+	} else {
+		c.misses++
+	}
+	return v, f
+}
+
+func TestSidecarOutboundHTTPRouteConfigCache(t *testing.T) {
+	cg := NewConfigGenTest(t, TestOptions{
+		Services: []*model.Service{buildHTTPService("test.local", visibility.Public, "", "default", 80)},
+	})
+	cache := &countingCache{XdsCache: model.NewXdsCache()}
+	cg.ConfigGen.Cache = cache
+
+	first := cg.SetupProxy(&model.Proxy{ID: "a.default", IPAddresses: []string{"1.1.1.1"}})
+	second := cg.SetupProxy(&model.Proxy{ID: "b.default", IPAddresses: []string{"1.1.1.2"}})
+
+	want := cg.ConfigGen.BuildHTTPRoutes(first, cg.PushContext(), []string{"80"})
+	if cache.hits != 0 {
+		t.Fatalf("expected the first proxy to miss the cache, got %d hits", cache.hits)
+	}
+	got := cg.ConfigGen.BuildHTTPRoutes(second, cg.PushContext(), []string{"80"})
+	if cache.hits != 1 {
+		t.Fatalf("expected an identical proxy to hit the cache, got %d hits", cache.hits)
+	}
+	if !proto.Equal(want[0], got[0]) {
+		t.Fatalf("cached route differs:\n%v\nwant\n%v", got[0], want[0])
+	}
+
+	// A config update for one of the inputs invalidates the entry.
+	cache.Clear(map[model.ConfigKey]struct{}{
+		{Kind: gvk.ServiceEntry, Name: "test.local", Namespace: "default"}: {},
+	})
+	cg.ConfigGen.BuildHTTPRoutes(second, cg.PushContext(), []string{"80"})
+	if cache.hits != 1 {
+		t.Fatalf("expected the cache to be invalidated by the update, got %d hits", cache.hits)
+	}
+}
+
+func TestSidecarOutboundHTTPRouteConfigCacheDNSCapture(t *testing.T) {
+	cg := NewConfigGenTest(t, TestOptions{
+		Services: []*model.Service{buildHTTPService("test.local", visibility.Public, "", "default", 80)},
+	})
+	cache := &countingCache{XdsCache: model.NewXdsCache()}
+	cg.ConfigGen.Cache = cache
+
+	// DNS capture makes the proxy use auto allocated addresses in its domains, so the route
+	// configurations must not be shared.
+	withCapture := cg.SetupProxy(&model.Proxy{ID: "a.default", IPAddresses: []string{"1.1.1.1"},
+		Metadata: &model.NodeMetadata{DNSCapture: "true"}})
+	withoutCapture := cg.SetupProxy(&model.Proxy{ID: "b.default", IPAddresses: []string{"1.1.1.2"}})
+
+	cg.ConfigGen.BuildHTTPRoutes(withCapture, cg.PushContext(), []string{"80"})
+	cg.ConfigGen.BuildHTTPRoutes(withoutCapture, cg.PushContext(), []string{"80"})
+	if cache.hits != 0 || cache.misses != 2 {
+		t.Fatalf("expected two cache misses, got %d hits and %d misses", cache.hits, cache.misses)
+	}
+}
+
+func TestSidecarOutboundHTTPRouteConfigCacheDisabled(t *testing.T) {
+	defaultValue := features.EnableRDSCaching
+	features.EnableRDSCaching = false
+	defer func() { features.EnableRDSCaching = defaultValue }()
+
+	cg := NewConfigGenTest(t, TestOptions{
+		Services: []*model.Service{buildHTTPService("test.local", visibility.Public, "", "default", 80)},
+	})
+	cache := &countingCache{XdsCache: model.NewXdsCache()}
+	cg.ConfigGen.Cache = cache
+
+	first := cg.SetupProxy(&model.Proxy{ID: "a.default", IPAddresses: []string{"1.1.1.1"}})
+	second := cg.SetupProxy(&model.Proxy{ID: "b.default", IPAddresses: []string{"1.1.1.2"}})
+	cg.ConfigGen.BuildHTTPRoutes(first, cg.PushContext(), []string{"80"})
+	cg.ConfigGen.BuildHTTPRoutes(second, cg.PushContext(), []string{"80"})
+	if cache.hits != 0 {
+		t.Fatalf("expected no cache hits with RDS caching disabled, got %d", cache.hits)
+	}
+}
+
+func TestSidecarOutboundHTTPRouteConfigCacheDelegate(t *testing.T) {
+	cg := NewConfigGenTest(t, TestOptions{
+		Services: []*model.Service{buildHTTPService("test.local", visibility.Public, "", "default", 80)},
+		ConfigString: `
+apiVersion: networking.istio.io/v1alpha3
+kind: VirtualService
+metadata:
+  name: root
+  namespace: default
+spec:
+  hosts:
+  - test.local
+  http:
+  - delegate:
+      name: delegate
+      namespace: default
+---
+apiVersion: networking.istio.io/v1alpha3
+kind: VirtualService
+metadata:
+  name: delegate
+  namespace: default
+spec:
+  http:
+  - route:
+    - destination:
+        host: test.local
+`,
+	})
+	cache := &countingCache{XdsCache: model.NewXdsCache()}
+	cg.ConfigGen.Cache = cache
+
+	first := cg.SetupProxy(&model.Proxy{ID: "a.default", IPAddresses: []string{"1.1.1.1"}})
+	second := cg.SetupProxy(&model.Proxy{ID: "b.default", IPAddresses: []string{"1.1.1.2"}})
+	cg.ConfigGen.BuildHTTPRoutes(first, cg.PushContext(), []string{"80"})
+
+	// Updating the delegate changes the merged routes, so it must invalidate the entry.
+	cache.Clear(map[model.ConfigKey]struct{}{
+		{Kind: gvk.VirtualService, Name: "delegate", Namespace: "default"}: {},
+	})
+	cg.ConfigGen.BuildHTTPRoutes(second, cg.PushContext(), []string{"80"})
+	if cache.hits != 0 {
+		t.Fatalf("expected the cache to be invalidated by a delegate update, got %d hits", cache.hits)
+	}
+}
+
 func TestSidecarOutboundHTTPRouteConfig(t *testing.T) {
 	services := []*model.Service{
 		buildHTTPService("bookinfo.com", visibility.Public, wildcardIP, "default", 9999, 70),
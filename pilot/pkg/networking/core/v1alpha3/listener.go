@@ -401,6 +401,15 @@ func (configgen *ConfigGeneratorImpl) buildSidecarInboundListeners(
 	} else {
 		rule := sidecarScope.Config.Spec.(*networking.Sidecar)
 		for _, ingressListener := range rule.Ingress {
+			// The virtual inbound listener already owns this port when traffic is captured, so a
+			// user supplied listener on it would collide with the catch-all inbound listener.
+			if !noneMode && ingressListener.Port.Number == ProxyInboundListenPort {
+				log.Warnf("buildSidecarInboundListeners: skipping ingress listener on port %d of Sidecar %s/%s for %s, "+
+					"it conflicts with the virtual inbound listener",
+					ingressListener.Port.Number, sidecarScope.Config.Namespace, sidecarScope.Config.Name, node.ID)
+				continue
+			}
+
 			// determine the bindToPort setting for listeners. Validation guarantees that these are all IP listeners.
 			bindToPort := false
 			if noneMode {
@@ -494,6 +503,7 @@ func (configgen *ConfigGeneratorImpl) buildSidecarInboundHTTPListenerOptsForPort
 	// See https://github.com/grpc/grpc-web/tree/master/net/grpc/gateway/examples/helloworld#configure-the-proxy
 	if pluginParams.ServiceInstance.ServicePort.Protocol.IsHTTP2() {
 		httpOpts.connectionManager.Http2ProtocolOptions = &core.Http2ProtocolOptions{}
+		applyHTTP2Settings(httpOpts.connectionManager.Http2ProtocolOptions)
 		if pluginParams.ServiceInstance.ServicePort.Protocol == protocol.GRPCWeb {
 			httpOpts.addGRPCWebFilter = true
 		}
@@ -1679,15 +1689,19 @@ func buildHTTPConnectionManager(listenerOpts buildListenerOpts, httpOpts *httpLi
 	websocketUpgrade := &hcm.HttpConnectionManager_UpgradeConfig{UpgradeType: "websocket"}
 	connectionManager.UpgradeConfigs = []*hcm.HttpConnectionManager_UpgradeConfig{websocketUpgrade}
 
-	idleTimeout, err := time.ParseDuration(listenerOpts.proxy.Metadata.IdleTimeout)
-	if idleTimeout > 0 && err == nil {
+	// The connection idle timeout closes connections without active requests, while the stream idle
+	// timeout resets a single request seeing no activity. The proxy setting overrides the default.
+	idleTimeout := features.HTTPConnectionIdleTimeout
+	if t, err := time.ParseDuration(listenerOpts.proxy.Metadata.IdleTimeout); err == nil && t > 0 {
+		idleTimeout = t
+	}
+	if idleTimeout > 0 {
 		connectionManager.CommonHttpProtocolOptions = &core.HttpProtocolOptions{
 			IdleTimeout: ptypes.DurationProto(idleTimeout),
 		}
 	}
 
-	notimeout := ptypes.DurationProto(0 * time.Second)
-	connectionManager.StreamIdleTimeout = notimeout
+	connectionManager.StreamIdleTimeout = ptypes.DurationProto(features.HTTPStreamIdleTimeout)
 
 	if httpOpts.rds != "" {
 		rds := &hcm.HttpConnectionManager_Rds{
@@ -1910,6 +1924,13 @@ func buildListener(opts buildListenerOpts) *listener.Listener {
 	listenerFiltersMap := make(map[string]bool)
 	var listenerFilters []*listener.ListenerFilter
 
+	// The PROXY protocol header precedes any other data on the connection, so it must be consumed
+	// before other listener filters inspect it.
+	if opts.class == ListenerClassGateway && opts.proxy.Metadata != nil && bool(opts.proxy.Metadata.ProxyProtocol) {
+		listenerFiltersMap[wellknown.ProxyProtocol] = true
+		listenerFilters = append(listenerFilters, xdsfilters.ProxyProtocol)
+	}
+
 	// add a TLS inspector if we need to detect ServerName or ALPN
 	needTLSInspector := false
 	for _, chain := range opts.filterChainOpts {
@@ -2004,7 +2025,7 @@ func buildListener(opts buildListenerOpts) *listener.Listener {
 		// TODO: need to sanitize the opts.bind if its a UDS socket, as it could have colons, that envoy
 		// doesn't like
 		Name:            opts.bind + "_" + strconv.Itoa(opts.port.Port),
-		Address:         util.BuildAddress(opts.bind, uint32(opts.port.Port)),
+		Address:         buildListenerAddress(opts.proxy, opts.bind, uint32(opts.port.Port)),
 		ListenerFilters: listenerFilters,
 		FilterChains:    filterChains,
 		DeprecatedV1:    deprecatedV1,
@@ -2176,6 +2197,18 @@ func getActualWildcardAndLocalHost(node *model.Proxy) (string, string) {
 	return WildcardIPv6Address, LocalhostIPv6Address
 }
 
+// buildListenerAddress returns the address a listener should bind to. Dual-stack proxies (with
+// both ipv4 and ipv6 addresses) bind the ipv4 wildcard as the ipv6 wildcard with ipv4_compat
+// set instead, so that the listener accepts connections from both address families.
+func buildListenerAddress(node *model.Proxy, bind string, port uint32) *core.Address {
+	if bind == WildcardAddress && node.SupportsIPv4() && node.SupportsIPv6() {
+		addr := util.BuildAddress(WildcardIPv6Address, port)
+		addr.GetSocketAddress().Ipv4Compat = true
+		return addr
+	}
+	return util.BuildAddress(bind, port)
+}
+
 // getSidecarInboundBindIP returns the IP that the proxy can bind to along with the sidecar specified port.
 // It looks for an unicast address, if none found, then the default wildcard address is used.
 // This will make the inbound listener bind to instance_ip:port instead of 0.0.0.0:port where applicable.
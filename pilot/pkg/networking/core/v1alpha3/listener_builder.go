@@ -31,7 +31,6 @@ import (
 	"istio.io/istio/pilot/pkg/model"
 	istionetworking "istio.io/istio/pilot/pkg/networking"
 	"istio.io/istio/pilot/pkg/networking/core/v1alpha3/envoyfilter"
-	istio_route "istio.io/istio/pilot/pkg/networking/core/v1alpha3/route"
 	"istio.io/istio/pilot/pkg/networking/plugin"
 	"istio.io/istio/pilot/pkg/networking/util"
 	xdsfilters "istio.io/istio/pilot/pkg/xds/filters"
@@ -333,7 +332,7 @@ func (lb *ListenerBuilder) buildVirtualOutboundListener(configgen *ConfigGenerat
 	// add an extra listener that binds to the port that is the recipient of the iptables redirect
 	ipTablesListener := &listener.Listener{
 		Name:                                VirtualOutboundListenerName,
-		Address:                             util.BuildAddress(actualWildcard, uint32(lb.push.Mesh.ProxyListenPort)),
+		Address:                             buildListenerAddress(lb.node, actualWildcard, uint32(lb.push.Mesh.ProxyListenPort)),
 		Transparent:                         isTransparentProxy,
 		HiddenEnvoyDeprecatedUseOriginalDst: proto.BoolTrue,
 		FilterChains:                        filterChains,
@@ -362,7 +361,7 @@ func (lb *ListenerBuilder) buildVirtualInboundListener(configgen *ConfigGenerato
 	}
 	lb.virtualInboundListener = &listener.Listener{
 		Name:                                VirtualInboundListenerName,
-		Address:                             util.BuildAddress(actualWildcard, ProxyInboundListenPort),
+		Address:                             buildListenerAddress(lb.node, actualWildcard, ProxyInboundListenPort),
 		Transparent:                         isTransparentProxy,
 		HiddenEnvoyDeprecatedUseOriginalDst: proto.BoolTrue,
 		TrafficDirection:                    core.TrafficDirection_INBOUND,
@@ -672,8 +671,7 @@ func buildOutboundCatchAllNetworkFiltersOnly(push *model.PushContext, node *mode
 		if node.SidecarScope.OutboundTrafficPolicy.EgressProxy != nil {
 			// user has provided an explicit destination for all the unknown traffic.
 			// build a cluster out of this destination
-			egressCluster = istio_route.GetDestinationCluster(node.SidecarScope.OutboundTrafficPolicy.EgressProxy,
-				nil, 0)
+			egressCluster = egressProxyCluster(push, node, node.SidecarScope.OutboundTrafficPolicy.EgressProxy)
 		}
 	} else {
 		egressCluster = util.BlackHoleCluster
@@ -114,6 +114,62 @@ func TestVirtualListenerBuilder(t *testing.T) {
 
 }
 
+func TestDualStackVirtualListenerBind(t *testing.T) {
+	cases := []struct {
+		name        string
+		ips         []string
+		wantAddress string
+		wantCompat  bool
+	}{
+		{
+			name:        "ipv4 only",
+			ips:         []string{"1.1.1.1"},
+			wantAddress: WildcardAddress,
+		},
+		{
+			name:        "ipv6 only",
+			ips:         []string{"1111:2222::1"},
+			wantAddress: WildcardIPv6Address,
+		},
+		{
+			name:        "dual stack",
+			ips:         []string{"1.1.1.1", "1111:2222::1"},
+			wantAddress: WildcardIPv6Address,
+			wantCompat:  true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ldsEnv := getDefaultLdsEnv()
+			env := buildListenerEnv(testServices)
+			if err := env.PushContext.InitContext(&env, nil, nil); err != nil {
+				t.Fatalf("init push context error: %s", err.Error())
+			}
+			proxy := getDefaultProxy()
+			proxy.IPAddresses = tt.ips
+			proxy.DiscoverIPVersions()
+			setNilSidecarOnProxy(proxy, env.PushContext)
+
+			listeners := NewListenerBuilder(proxy, env.PushContext).
+				buildVirtualOutboundListener(ldsEnv.configgen).
+				buildVirtualInboundListener(ldsEnv.configgen).
+				getListeners()
+			if len(listeners) != 2 {
+				t.Fatalf("expected %d listeners, found %d", 2, len(listeners))
+			}
+			for _, l := range listeners {
+				addr := l.Address.GetSocketAddress()
+				if addr.Address != tt.wantAddress {
+					t.Errorf("%s: expected bind address %s, found %s", l.Name, tt.wantAddress, addr.Address)
+				}
+				if addr.Ipv4Compat != tt.wantCompat {
+					t.Errorf("%s: expected ipv4_compat %v, found %v", l.Name, tt.wantCompat, addr.Ipv4Compat)
+				}
+			}
+		})
+	}
+}
+
 func setInboundCaptureAllOnThisNode(proxy *model.Proxy, mode model.TrafficInterceptionMode) {
 	proxy.Metadata.InterceptionMode = mode
 }
@@ -164,6 +164,45 @@ func TestInboundListenerConfig(t *testing.T) {
 		buildService("test1.com", wildcardIP, protocol.GRPC, tnow.Add(1*time.Second)))
 }
 
+func TestInboundListenerConfig_SidecarIngress(t *testing.T) {
+	p := &fakePlugin{}
+	sidecarConfig := &config.Config{
+		Meta: config.Meta{
+			Name:      "foo",
+			Namespace: "not-default",
+		},
+		Spec: &networking.Sidecar{
+			Ingress: []*networking.IstioIngressListener{
+				{
+					Port: &networking.Port{
+						Number:   9080,
+						Protocol: "HTTP",
+						Name:     "http",
+					},
+					DefaultEndpoint: "127.0.0.1:8080",
+				},
+				{
+					// Conflicts with the virtual inbound listener and should be skipped
+					Port: &networking.Port{
+						Number:   ProxyInboundListenPort,
+						Protocol: "TCP",
+						Name:     "tcp",
+					},
+					DefaultEndpoint: "127.0.0.1:8081",
+				},
+			},
+		},
+	}
+	listeners := buildInboundListeners(t, p, getProxy(), sidecarConfig,
+		buildService("test.com", wildcardIP, protocol.HTTP, tnow))
+	if len(listeners) != 1 {
+		t.Fatalf("expected %d listeners, found %d", 1, len(listeners))
+	}
+	if port := listeners[0].Address.GetSocketAddress().GetPortValue(); port != 9080 {
+		t.Fatalf("expected inbound listener on port %d, found %d", 9080, port)
+	}
+}
+
 func TestOutboundListenerConflict_HTTPWithCurrentUnknown(t *testing.T) {
 	defaultValue := features.EnableProtocolSniffingForOutbound
 	features.EnableProtocolSniffingForOutbound = true
@@ -560,6 +599,31 @@ func TestOutboundListenerConfig_WithDisabledSniffing_WithSidecar(t *testing.T) {
 	testOutboundListenerConfigWithSidecarWithUseRemoteAddress(t, services...)
 }
 
+func TestOutboundListenerConfig_WithDisabledSniffing_UnknownPort(t *testing.T) {
+	defaultValue := features.EnableProtocolSniffingForOutbound
+	features.EnableProtocolSniffingForOutbound = false
+	defer func() { features.EnableProtocolSniffingForOutbound = defaultValue }()
+
+	listeners := buildOutboundListeners(t, &fakePlugin{}, getProxy(), nil, nil,
+		buildService("test.com", "1.2.3.4", "unknown", tnow))
+	l := findListenerByAddress(listeners, "1.2.3.4")
+	if l == nil {
+		t.Fatalf("expected listener 1.2.3.4_8080")
+	}
+	for _, lf := range l.ListenerFilters {
+		if lf.Name == wellknown.HttpInspector {
+			t.Fatalf("expected no HTTP inspector without sniffing, got %v", l.ListenerFilters)
+		}
+	}
+	if len(l.FilterChains) != 1 {
+		t.Fatalf("expected a single TCP filter chain, got %d", len(l.FilterChains))
+	}
+	filters := l.FilterChains[0].Filters
+	if name := filters[len(filters)-1].Name; name != wellknown.TCPProxy {
+		t.Fatalf("expected TCP proxy filter, got %s", name)
+	}
+}
+
 func TestOutboundTlsTrafficWithoutTimeout(t *testing.T) {
 	services := []*model.Service{
 		{
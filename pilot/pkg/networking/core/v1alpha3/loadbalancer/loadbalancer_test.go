@@ -171,6 +171,44 @@ func TestApplyLocalitySetting(t *testing.T) {
 	})
 }
 
+func TestApplyLocalitySettingFailoverVersusWeighted(t *testing.T) {
+	locality := &core.Locality{Region: "region1", Zone: "zone1", SubZone: "subzone1"}
+	twoRegions := func() *endpoint.ClusterLoadAssignment {
+		return &endpoint.ClusterLoadAssignment{
+			Endpoints: []*endpoint.LocalityLbEndpoints{
+				{Locality: &core.Locality{Region: "region1", Zone: "zone1", SubZone: "subzone1"}, LbEndpoints: []*endpoint.LbEndpoint{{}}},
+				{Locality: &core.Locality{Region: "region2", Zone: "zone1", SubZone: "subzone1"}, LbEndpoints: []*endpoint.LbEndpoint{{}}},
+			},
+		}
+	}
+
+	t.Run("failover mode puts the second region at priority 1", func(t *testing.T) {
+		g := NewWithT(t)
+		cla := twoRegions()
+		ApplyLocalityLBSetting(locality, cla, &networking.LocalityLoadBalancerSetting{
+			Failover: []*networking.LocalityLoadBalancerSetting_Failover{{From: "region1", To: "region2"}},
+		}, true)
+		g.Expect(cla.Endpoints[0].Priority).To(Equal(uint32(0)))
+		g.Expect(cla.Endpoints[1].Priority).To(Equal(uint32(1)))
+		g.Expect(cla.Endpoints[1].LoadBalancingWeight).To(BeNil())
+	})
+
+	t.Run("weighted mode keeps both regions at priority 0", func(t *testing.T) {
+		g := NewWithT(t)
+		cla := twoRegions()
+		ApplyLocalityLBSetting(locality, cla, &networking.LocalityLoadBalancerSetting{
+			Distribute: []*networking.LocalityLoadBalancerSetting_Distribute{{
+				From: "region1/zone1/subzone1",
+				To:   map[string]uint32{"region1/*": 80, "region2/*": 20},
+			}},
+		}, true)
+		g.Expect(cla.Endpoints[0].Priority).To(Equal(uint32(0)))
+		g.Expect(cla.Endpoints[1].Priority).To(Equal(uint32(0)))
+		g.Expect(cla.Endpoints[0].LoadBalancingWeight.GetValue()).To(Equal(uint32(80)))
+		g.Expect(cla.Endpoints[1].LoadBalancingWeight.GetValue()).To(Equal(uint32(20)))
+	})
+}
+
 func TestGetLocalityLbSetting(t *testing.T) {
 	// dummy config for test
 	failover := []*networking.LocalityLoadBalancerSetting_Failover{nil}
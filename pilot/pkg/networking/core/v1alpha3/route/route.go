@@ -296,7 +296,11 @@ allroutes:
 			// We have a rule with catch all match. Other rules are of no use.
 			break
 		} else {
-			for _, match := range http.Match {
+			matches := http.Match
+			if features.OrderRouteMatchesBySpecificity {
+				matches = orderMatchesBySpecificity(http.Match)
+			}
+			for _, match := range matches {
 				if r := translateRoute(push, node, http, match, listenPort, virtualService, serviceRegistry, gatewayNames); r != nil {
 					out = append(out, r)
 					// This is a catch all path. Routes are matched in order, so we will never go beyond this match
@@ -315,6 +319,89 @@ allroutes:
 	return out, nil
 }
 
+// totalClusterWeight is the weight Envoy expects the weighted clusters of a route to sum up to.
+const totalClusterWeight = 100
+
+// normalizeClusterWeights scales the weights of the clusters so that they sum up to totalClusterWeight,
+// as Envoy rejects routes whose weights do not add up. The remainder left after scaling is handed out
+// to the clusters with the largest fractional parts, in order. The virtual service is reported in the push
+// status, once per push rather than for every proxy, so the misconfiguration remains visible.
+func normalizeClusterWeights(push *model.PushContext, clusters []*route.WeightedCluster_ClusterWeight, name, namespace string) {
+	var total uint32
+	for _, c := range clusters {
+		total += c.Weight.GetValue()
+	}
+	if total == totalClusterWeight || total == 0 {
+		return
+	}
+	push.AddMetric(model.VirtualServiceWeightsNormalized, namespace+"/"+name, "",
+		fmt.Sprintf("weights of virtual service %s/%s sum up to %d instead of %d, normalizing",
+			namespace, name, total, totalClusterWeight))
+
+	remainders := make([]uint32, len(clusters))
+	var assigned uint32
+	for i, c := range clusters {
+		scaled := c.Weight.GetValue() * totalClusterWeight
+		remainders[i] = scaled % total
+		c.Weight = &wrappers.UInt32Value{Value: scaled / total}
+		assigned += c.Weight.Value
+	}
+	order := make([]int, len(clusters))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return remainders[order[i]] > remainders[order[j]]
+	})
+	for i := 0; assigned < totalClusterWeight; i++ {
+		clusters[order[i%len(order)]].Weight.Value++
+		assigned++
+	}
+}
+
+// orderMatchesBySpecificity returns the match conditions of a single HTTPRoute ordered from most to
+// least specific: exact, prefix (longest first), regex and finally catch all matches. Conditions with
+// the same uri specificity are ordered by their number of header and query parameter matches.
+// All conditions of a route share its action, so this does not change which requests the route
+// handles, but ensures the more specific Envoy routes are emitted first. It is only applied if
+// features.OrderRouteMatchesBySpecificity is enabled, to keep the routes of existing config stable.
+func orderMatchesBySpecificity(matches []*networking.HTTPMatchRequest) []*networking.HTTPMatchRequest {
+	out := make([]*networking.HTTPMatchRequest, len(matches))
+	copy(out, matches)
+	sort.SliceStable(out, func(i, j int) bool {
+		ri, li := uriMatchSpecificity(out[i])
+		rj, lj := uriMatchSpecificity(out[j])
+		if ri != rj {
+			return ri < rj
+		}
+		if li != lj {
+			return li > lj
+		}
+		return len(out[i].Headers)+len(out[i].QueryParams) > len(out[j].Headers)+len(out[j].QueryParams)
+	})
+	return out
+}
+
+// uriMatchSpecificity returns the rank of the uri match type (lower is more specific), along with
+// the length of the prefix for prefix matches.
+func uriMatchSpecificity(m *networking.HTTPMatchRequest) (int, int) {
+	if isCatchAllMatch(m) {
+		return 3, 0
+	}
+	if m.Uri == nil {
+		// No uri match is equivalent to prefix "/".
+		return 1, 1
+	}
+	switch u := m.Uri.MatchType.(type) {
+	case *networking.StringMatch_Exact:
+		return 0, 0
+	case *networking.StringMatch_Prefix:
+		return 1, len(u.Prefix)
+	default:
+		return 2, 0
+	}
+}
+
 // sourceMatchHttp checks if the sourceLabels or the gateways in a match condition match with the
 // labels for the proxy or the gateway name for which we are generating a route
 func sourceMatchHTTP(match *networking.HTTPMatchRequest, proxyLabels labels.Collection, gatewayNames map[string]bool, proxyNamespace string) bool {
@@ -431,9 +518,12 @@ func translateRoute(push *model.PushContext, node *model.Proxy, in *networking.H
 		out.Action = &route.Route_Route{Route: action}
 
 		if rewrite := in.Rewrite; rewrite != nil {
+			// With a prefix match, Envoy swaps only the matched prefix for the rewrite.
 			action.PrefixRewrite = rewrite.Uri
-			action.HostRewriteSpecifier = &route.RouteAction_HostRewriteLiteral{
-				HostRewriteLiteral: rewrite.Authority,
+			if rewrite.Authority != "" {
+				action.HostRewriteSpecifier = &route.RouteAction_HostRewriteLiteral{
+					HostRewriteLiteral: rewrite.Authority,
+				}
 			}
 		}
 
@@ -495,6 +585,7 @@ func translateRoute(push *model.PushContext, node *model.Proxy, in *networking.H
 			out.ResponseHeadersToAdd = append(out.ResponseHeadersToAdd, weighted[0].ResponseHeadersToAdd...)
 			out.ResponseHeadersToRemove = append(out.ResponseHeadersToRemove, weighted[0].ResponseHeadersToRemove...)
 		} else {
+			normalizeClusterWeights(push, weighted, virtualService.Name, virtualService.Namespace)
 			action.ClusterSpecifier = &route.RouteAction_WeightedClusters{
 				WeightedClusters: &route.WeightedCluster{
 					Clusters: weighted,
@@ -670,6 +761,11 @@ func translateRouteMatch(in *networking.HTTPMatchRequest, node *model.Proxy) *ro
 		out.QueryParameters = append(out.QueryParameters, matcher)
 	}
 
+	// guarantee ordering of query parameters
+	sort.Slice(out.QueryParameters, func(i, j int) bool {
+		return out.QueryParameters[i].Name < out.QueryParameters[j].Name
+	})
+
 	return out
 }
 
@@ -0,0 +1,144 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package route
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	networking "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/schema/gvk"
+)
+
+// Cache holds the inputs of an outbound sidecar route configuration. Proxies that share the same inputs,
+// for example sidecars in the same namespace and scope, generate identical route configurations, so the
+// result can be generated once and reused across their connections.
+type Cache struct {
+	RouteName    string
+	ListenerPort int
+
+	ProxyVersion    string
+	ClusterID       string
+	DNSDomain       string
+	ConfigNamespace string
+	// DNSCapture is set if the proxy captures DNS, which makes it use the auto allocated addresses of
+	// services in the virtual host domains.
+	DNSCapture bool
+
+	// Sidecar is the Sidecar resource that scopes the proxy, if any.
+	Sidecar          *config.Config
+	Services         []*model.Service
+	VirtualServices  []config.Config
+	DestinationRules []*config.Config
+	// DelegateVirtualServices are the delegates referenced by VirtualServices, which are merged into them
+	// when building routes.
+	DelegateVirtualServices []model.ConfigKey
+}
+
+var _ model.XdsCacheEntry = &Cache{}
+
+// NewCache collects the inputs of the route configuration routeName for the proxy.
+func NewCache(node *model.Proxy, push *model.PushContext, routeName string, listenerPort int,
+	services []*model.Service, virtualServices []config.Config) *Cache {
+	c := &Cache{
+		RouteName:       routeName,
+		ListenerPort:    listenerPort,
+		ClusterID:       node.Metadata.ClusterID,
+		DNSDomain:       node.DNSDomain,
+		ConfigNamespace: node.ConfigNamespace,
+		DNSCapture:      node.Metadata.DNSCapture != "",
+		Services:        services,
+		VirtualServices: virtualServices,
+
+		DelegateVirtualServices: push.DelegateVirtualServicesConfigKey(virtualServices),
+	}
+	if node.IstioVersion != nil {
+		c.ProxyVersion = strconv.Itoa(node.IstioVersion.Major) + "." + strconv.Itoa(node.IstioVersion.Minor) + "." +
+			strconv.Itoa(node.IstioVersion.Patch)
+	}
+	if node.SidecarScope != nil {
+		c.Sidecar = node.SidecarScope.Config
+	}
+	for _, svc := range services {
+		if dr := push.DestinationRule(node, svc); dr != nil {
+			c.DestinationRules = append(c.DestinationRules, dr)
+		}
+	}
+	return c
+}
+
+// Cacheable returns false if the route configuration depends on the identity of the proxy, which is the
+// case for virtual services matching on source labels or namespace.
+func (r *Cache) Cacheable() bool {
+	if r == nil {
+		return false
+	}
+	for _, vs := range r.VirtualServices {
+		for _, httpRoute := range vs.Spec.(*networking.VirtualService).Http {
+			for _, match := range httpRoute.Match {
+				if len(match.SourceLabels) > 0 || match.SourceNamespace != "" {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+func (r *Cache) DependentConfigs() []model.ConfigKey {
+	configs := make([]model.ConfigKey, 0,
+		len(r.Services)+len(r.VirtualServices)+len(r.DelegateVirtualServices)+len(r.DestinationRules)+1)
+	if r.Sidecar != nil {
+		configs = append(configs, model.ConfigKey{Kind: gvk.Sidecar, Name: r.Sidecar.Name, Namespace: r.Sidecar.Namespace})
+	}
+	for _, svc := range r.Services {
+		configs = append(configs, model.ConfigKey{Kind: gvk.ServiceEntry, Name: string(svc.Hostname), Namespace: svc.Attributes.Namespace})
+	}
+	for _, vs := range r.VirtualServices {
+		configs = append(configs, model.ConfigKey{Kind: gvk.VirtualService, Name: vs.Name, Namespace: vs.Namespace})
+	}
+	configs = append(configs, r.DelegateVirtualServices...)
+	for _, dr := range r.DestinationRules {
+		configs = append(configs, model.ConfigKey{Kind: gvk.DestinationRule, Name: dr.Name, Namespace: dr.Namespace})
+	}
+	return configs
+}
+
+// Key returns a hash of all the inputs, so that proxies with the same inputs share the cache entry.
+func (r *Cache) Key() string {
+	params := []string{"rds", r.RouteName, strconv.Itoa(r.ListenerPort), r.ProxyVersion, r.ClusterID, r.DNSDomain, r.ConfigNamespace,
+		strconv.FormatBool(r.DNSCapture)}
+	if r.Sidecar != nil {
+		params = append(params, "sidecar:"+r.Sidecar.Namespace+"/"+r.Sidecar.Name)
+	}
+	for _, svc := range r.Services {
+		params = append(params, "svc:"+string(svc.Hostname)+"/"+svc.Attributes.Namespace)
+	}
+	for _, vs := range r.VirtualServices {
+		params = append(params, "vs:"+vs.Namespace+"/"+vs.Name)
+	}
+	for _, delegate := range r.DelegateVirtualServices {
+		params = append(params, "delegate:"+delegate.Namespace+"/"+delegate.Name)
+	}
+	for _, dr := range r.DestinationRules {
+		params = append(params, "dr:"+dr.Namespace+"/"+dr.Name)
+	}
+	sum := sha256.Sum256([]byte(strings.Join(params, "~")))
+	return hex.EncodeToString(sum[:])
+}
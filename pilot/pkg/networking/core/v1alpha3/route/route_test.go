@@ -125,6 +125,110 @@ func TestBuildHTTPRoutes(t *testing.T) {
 		g.Expect(routes[0].GetRoute().MaxStreamDuration.MaxStreamDuration.Seconds).To(gomega.Equal(int64(0)))
 	})
 
+	t.Run("for virtual service with per-route timeouts", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+
+		routes, err := route.BuildHTTPRoutesForVirtualService(node, nil, virtualServiceWithPerRouteTimeouts, serviceRegistry, 8080, gatewayNames)
+		xdstest.ValidateRoutes(t, routes)
+
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(len(routes)).To(gomega.Equal(2))
+		// Each route carries its own timeout.
+		g.Expect(routes[0].GetRoute().Timeout.Seconds).To(gomega.Equal(int64(2)))
+		g.Expect(routes[0].GetRoute().Timeout.Nanos).To(gomega.Equal(int32(500000000)))
+		g.Expect(routes[0].GetRoute().MaxStreamDuration.MaxStreamDuration.Seconds).To(gomega.Equal(int64(2)))
+		// A disabled timeout is sent as an explicit 0s rather than omitted, which would fall back to Envoy's default.
+		g.Expect(routes[1].GetRoute().Timeout).NotTo(gomega.BeNil())
+		g.Expect(routes[1].GetRoute().Timeout.Seconds).To(gomega.Equal(int64(0)))
+		g.Expect(routes[1].GetRoute().Timeout.Nanos).To(gomega.Equal(int32(0)))
+		g.Expect(routes[1].GetRoute().MaxStreamDuration.MaxStreamDuration).NotTo(gomega.BeNil())
+	})
+
+	t.Run("for virtual service with rewrite", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+
+		routes, err := route.BuildHTTPRoutesForVirtualService(node, nil, virtualServiceWithRewrite, serviceRegistry, 8080, gatewayNames)
+		xdstest.ValidateRoutes(t, routes)
+
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(len(routes)).To(gomega.Equal(2))
+
+		// The matched prefix is swapped for the rewritten uri, along with the authority.
+		g.Expect(routes[0].GetMatch().GetPrefix()).To(gomega.Equal("/old"))
+		g.Expect(routes[0].GetRoute().GetPrefixRewrite()).To(gomega.Equal("/new"))
+		g.Expect(routes[0].GetRoute().GetHostRewriteLiteral()).To(gomega.Equal("new.example.org"))
+
+		// Only the uri is rewritten, so the host is left alone.
+		g.Expect(routes[1].GetMatch().GetPrefix()).To(gomega.Equal("/v1/"))
+		g.Expect(routes[1].GetRoute().GetPrefixRewrite()).To(gomega.Equal("/"))
+		g.Expect(routes[1].GetRoute().GetHostRewriteSpecifier()).To(gomega.BeNil())
+	})
+
+	t.Run("for virtual service with mixed match types in written order", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+
+		routes, err := route.BuildHTTPRoutesForVirtualService(node, nil, virtualServiceWithMixedMatches, serviceRegistry, 8080, gatewayNames)
+		xdstest.ValidateRoutes(t, routes)
+
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		// The first match is a catch all, so the others are dropped.
+		g.Expect(len(routes)).To(gomega.Equal(1))
+		g.Expect(routes[0].GetMatch().GetPrefix()).To(gomega.Equal("/"))
+	})
+
+	t.Run("for virtual service with mixed match types", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		features.OrderRouteMatchesBySpecificity = true
+		defer func() { features.OrderRouteMatchesBySpecificity = false }()
+
+		routes, err := route.BuildHTTPRoutesForVirtualService(node, nil, virtualServiceWithMixedMatches, serviceRegistry, 8080, gatewayNames)
+		xdstest.ValidateRoutes(t, routes)
+
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		// The catch all match is emitted last, and the following http route is dropped.
+		g.Expect(len(routes)).To(gomega.Equal(5))
+		g.Expect(routes[0].GetMatch().GetPath()).To(gomega.Equal("/api/v1"))
+		g.Expect(routes[1].GetMatch().GetPrefix()).To(gomega.Equal("/api"))
+		g.Expect(len(routes[1].GetMatch().GetHeaders())).To(gomega.Equal(1))
+		g.Expect(routes[1].GetMatch().GetHeaders()[0].GetExactMatch()).To(gomega.Equal("bar"))
+		g.Expect(routes[2].GetMatch().GetPrefix()).To(gomega.Equal("/api"))
+		g.Expect(len(routes[2].GetMatch().GetHeaders())).To(gomega.Equal(0))
+		g.Expect(routes[3].GetMatch().GetSafeRegex().GetRegex()).To(gomega.Equal("/a.*"))
+		g.Expect(routes[4].GetMatch().GetPrefix()).To(gomega.Equal("/"))
+	})
+
+	t.Run("for virtual service with weighted subsets", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+
+		routes, err := route.BuildHTTPRoutesForVirtualService(node, nil, virtualServiceWithWeights(80, 20), serviceRegistry, 8080, gatewayNames)
+		xdstest.ValidateRoutes(t, routes)
+
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(len(routes)).To(gomega.Equal(1))
+		weighted := routes[0].GetRoute().GetWeightedClusters().GetClusters()
+		g.Expect(len(weighted)).To(gomega.Equal(2))
+		g.Expect(weighted[0].Name).To(gomega.Equal("outbound|8484|v1|*.example.org"))
+		g.Expect(weighted[0].Weight.GetValue()).To(gomega.Equal(uint32(80)))
+		g.Expect(weighted[1].Name).To(gomega.Equal("outbound|8484|v2|*.example.org"))
+		g.Expect(weighted[1].Weight.GetValue()).To(gomega.Equal(uint32(20)))
+	})
+
+	t.Run("for virtual service with weights not summing to 100", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+
+		push := model.NewPushContext()
+		routes, err := route.BuildHTTPRoutesForVirtualService(node, push, virtualServiceWithWeights(1, 2), serviceRegistry, 8080, gatewayNames)
+		xdstest.ValidateRoutes(t, routes)
+
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(len(routes)).To(gomega.Equal(1))
+		weighted := routes[0].GetRoute().GetWeightedClusters().GetClusters()
+		g.Expect(len(weighted)).To(gomega.Equal(2))
+		g.Expect(weighted[0].Weight.GetValue()).To(gomega.Equal(uint32(33)))
+		g.Expect(weighted[1].Weight.GetValue()).To(gomega.Equal(uint32(67)))
+		g.Expect(push.ProxyStatus[model.VirtualServiceWeightsNormalized.Name()]).To(gomega.HaveKey("/acme"))
+	})
+
 	t.Run("for virtual service with catch all route", func(t *testing.T) {
 		g := gomega.NewWithT(t)
 		routes, err := route.BuildHTTPRoutesForVirtualService(node, nil, virtualServiceWithCatchAllRoute, serviceRegistry, 8080, gatewayNames)
@@ -252,6 +356,45 @@ func TestBuildHTTPRoutes(t *testing.T) {
 		g.Expect(routes[0].GetName()).To(gomega.Equal("bar"))
 	})
 
+	t.Run("for virtual service with source labels matching", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+
+		// Source labels are resolved against the labels of the proxy the routes are generated for, so
+		// only workloads carrying the labels get the matching route.
+		canaryNode := &model.Proxy{
+			Type:        model.SidecarProxy,
+			IPAddresses: []string{"1.1.1.1"},
+			ID:          "someID",
+			DNSDomain:   "foo.com",
+			Metadata: &model.NodeMetadata{
+				Labels: map[string]string{"app": "client", "track": "canary"},
+			},
+		}
+		routes, err := route.BuildHTTPRoutesForVirtualService(canaryNode, nil, virtualServiceMatchingOnSourceLabels, serviceRegistry, 8080, gatewayNames)
+		xdstest.ValidateRoutes(t, routes)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(len(routes)).To(gomega.Equal(2))
+		g.Expect(routes[0].GetName()).To(gomega.Equal("canary"))
+		g.Expect(routes[0].GetMatch().GetPrefix()).To(gomega.Equal("/"))
+		g.Expect(routes[0].GetRoute().GetCluster()).To(gomega.Equal("outbound|8484|v2|*.example.org"))
+		g.Expect(routes[1].GetRoute().GetCluster()).To(gomega.Equal("outbound|8484|v1|*.example.org"))
+
+		stableNode := &model.Proxy{
+			Type:        model.SidecarProxy,
+			IPAddresses: []string{"1.1.1.2"},
+			ID:          "someID",
+			DNSDomain:   "foo.com",
+			Metadata: &model.NodeMetadata{
+				Labels: map[string]string{"app": "client"},
+			},
+		}
+		routes, err = route.BuildHTTPRoutesForVirtualService(stableNode, nil, virtualServiceMatchingOnSourceLabels, serviceRegistry, 8080, gatewayNames)
+		xdstest.ValidateRoutes(t, routes)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(len(routes)).To(gomega.Equal(1))
+		g.Expect(routes[0].GetRoute().GetCluster()).To(gomega.Equal("outbound|8484|v1|*.example.org"))
+	})
+
 	t.Run("for virtual service with ring hash", func(t *testing.T) {
 		g := gomega.NewWithT(t)
 
@@ -807,6 +950,227 @@ var virtualServiceWithTimeoutDisabled = config.Config{
 	},
 }
 
+var virtualServiceWithPerRouteTimeouts = config.Config{
+	Meta: config.Meta{
+		GroupVersionKind: collections.IstioNetworkingV1Alpha3Virtualservices.Resource().GroupVersionKind(),
+		Name:             "acme",
+	},
+	Spec: &networking.VirtualService{
+		Hosts:    []string{},
+		Gateways: []string{"some-gateway"},
+		Http: []*networking.HTTPRoute{
+			{
+				Match: []*networking.HTTPMatchRequest{
+					{
+						Uri: &networking.StringMatch{
+							MatchType: &networking.StringMatch_Prefix{Prefix: "/slow"},
+						},
+					},
+				},
+				Route: []*networking.HTTPRouteDestination{
+					{
+						Destination: &networking.Destination{
+							Host: "*.example.org",
+							Port: &networking.PortSelector{
+								Number: 8484,
+							},
+						},
+						Weight: 100,
+					},
+				},
+				Timeout: &types.Duration{
+					Seconds: 2,
+					Nanos:   500000000,
+				},
+			},
+			{
+				Route: []*networking.HTTPRouteDestination{
+					{
+						Destination: &networking.Destination{
+							Host: "*.example.org",
+							Port: &networking.PortSelector{
+								Number: 8484,
+							},
+						},
+						Weight: 100,
+					},
+				},
+				Timeout: &types.Duration{},
+			},
+		},
+	},
+}
+
+var virtualServiceWithRewrite = config.Config{
+	Meta: config.Meta{
+		GroupVersionKind: collections.IstioNetworkingV1Alpha3Virtualservices.Resource().GroupVersionKind(),
+		Name:             "acme",
+	},
+	Spec: &networking.VirtualService{
+		Hosts:    []string{},
+		Gateways: []string{"some-gateway"},
+		Http: []*networking.HTTPRoute{
+			{
+				Match: []*networking.HTTPMatchRequest{
+					{
+						Uri: &networking.StringMatch{
+							MatchType: &networking.StringMatch_Prefix{Prefix: "/old"},
+						},
+					},
+				},
+				Rewrite: &networking.HTTPRewrite{
+					Uri:       "/new",
+					Authority: "new.example.org",
+				},
+				Route: []*networking.HTTPRouteDestination{
+					{
+						Destination: &networking.Destination{
+							Host: "*.example.org",
+							Port: &networking.PortSelector{
+								Number: 8484,
+							},
+						},
+						Weight: 100,
+					},
+				},
+			},
+			{
+				Match: []*networking.HTTPMatchRequest{
+					{
+						Uri: &networking.StringMatch{
+							MatchType: &networking.StringMatch_Prefix{Prefix: "/v1/"},
+						},
+					},
+				},
+				Rewrite: &networking.HTTPRewrite{
+					Uri: "/",
+				},
+				Route: []*networking.HTTPRouteDestination{
+					{
+						Destination: &networking.Destination{
+							Host: "*.example.org",
+							Port: &networking.PortSelector{
+								Number: 8484,
+							},
+						},
+						Weight: 100,
+					},
+				},
+			},
+		},
+	},
+}
+
+var virtualServiceWithMixedMatches = config.Config{
+	Meta: config.Meta{
+		GroupVersionKind: collections.IstioNetworkingV1Alpha3Virtualservices.Resource().GroupVersionKind(),
+		Name:             "acme",
+	},
+	Spec: &networking.VirtualService{
+		Hosts:    []string{},
+		Gateways: []string{"some-gateway"},
+		Http: []*networking.HTTPRoute{
+			{
+				Match: []*networking.HTTPMatchRequest{
+					{
+						Uri: &networking.StringMatch{
+							MatchType: &networking.StringMatch_Prefix{Prefix: "/"},
+						},
+					},
+					{
+						Uri: &networking.StringMatch{
+							MatchType: &networking.StringMatch_Regex{Regex: "/a.*"},
+						},
+					},
+					{
+						Uri: &networking.StringMatch{
+							MatchType: &networking.StringMatch_Prefix{Prefix: "/api"},
+						},
+					},
+					{
+						Uri: &networking.StringMatch{
+							MatchType: &networking.StringMatch_Exact{Exact: "/api/v1"},
+						},
+					},
+					{
+						Uri: &networking.StringMatch{
+							MatchType: &networking.StringMatch_Prefix{Prefix: "/api"},
+						},
+						Headers: map[string]*networking.StringMatch{
+							"x-foo": {
+								MatchType: &networking.StringMatch_Exact{Exact: "bar"},
+							},
+						},
+					},
+				},
+				Route: []*networking.HTTPRouteDestination{
+					{
+						Destination: &networking.Destination{
+							Host: "*.example.org",
+							Port: &networking.PortSelector{
+								Number: 8484,
+							},
+						},
+						Weight: 100,
+					},
+				},
+			},
+			{
+				Route: []*networking.HTTPRouteDestination{
+					{
+						Destination: &networking.Destination{
+							Host: "*.example.org",
+							Port: &networking.PortSelector{
+								Number: 8485,
+							},
+						},
+						Weight: 100,
+					},
+				},
+			},
+		},
+	},
+}
+
+func virtualServiceWithWeights(v1, v2 int32) config.Config {
+	return config.Config{
+		Meta: config.Meta{
+			GroupVersionKind: collections.IstioNetworkingV1Alpha3Virtualservices.Resource().GroupVersionKind(),
+			Name:             "acme",
+		},
+		Spec: &networking.VirtualService{
+			Hosts:    []string{},
+			Gateways: []string{"some-gateway"},
+			Http: []*networking.HTTPRoute{
+				{
+					Route: []*networking.HTTPRouteDestination{
+						{
+							Destination: &networking.Destination{
+								Host:   "*.example.org",
+								Subset: "v1",
+								Port: &networking.PortSelector{
+									Number: 8484,
+								},
+							},
+							Weight: v1,
+						},
+						{
+							Destination: &networking.Destination{
+								Host:   "*.example.org",
+								Subset: "v2",
+								Port: &networking.PortSelector{
+									Number: 8484,
+								},
+							},
+							Weight: v2,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 var virtualServiceWithCatchAllRoute = config.Config{
 	Meta: config.Meta{
 		GroupVersionKind: collections.IstioNetworkingV1Alpha3Virtualservices.Resource().GroupVersionKind(),
@@ -1232,6 +1596,52 @@ var virtualServiceWithPresentMatchingOnWithoutHeader = config.Config{
 	},
 }
 
+var virtualServiceMatchingOnSourceLabels = config.Config{
+	Meta: config.Meta{
+		GroupVersionKind: collections.IstioNetworkingV1Alpha3Virtualservices.Resource().GroupVersionKind(),
+		Name:             "acme",
+	},
+	Spec: &networking.VirtualService{
+		Hosts: []string{},
+		Http: []*networking.HTTPRoute{
+			{
+				Name: "canary",
+				Match: []*networking.HTTPMatchRequest{
+					{
+						SourceLabels: map[string]string{
+							"track": "canary",
+						},
+					},
+				},
+				Route: []*networking.HTTPRouteDestination{
+					{
+						Destination: &networking.Destination{
+							Host:   "*.example.org",
+							Subset: "v2",
+							Port: &networking.PortSelector{
+								Number: 8484,
+							},
+						},
+					},
+				},
+			},
+			{
+				Route: []*networking.HTTPRouteDestination{
+					{
+						Destination: &networking.Destination{
+							Host:   "*.example.org",
+							Subset: "v1",
+							Port: &networking.PortSelector{
+								Number: 8484,
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
 var virtualServiceMatchingOnSourceNamespace = config.Config{
 	Meta: config.Meta{
 		GroupVersionKind: collections.IstioNetworkingV1Alpha3Virtualservices.Resource().GroupVersionKind(),
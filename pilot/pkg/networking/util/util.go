@@ -184,13 +184,28 @@ func ConvertAddressToCidr(addr string) *core.CidrRange {
 	return cidr
 }
 
+// normalizeIPv6 strips the brackets from an IPv6 literal and returns it in canonical form, as
+// Envoy expects a bare address in SocketAddress. IPv4 addresses and hostnames are returned unchanged.
+func normalizeIPv6(addr string) string {
+	if !strings.Contains(addr, ":") {
+		return addr
+	}
+	trimmed := strings.TrimSuffix(strings.TrimPrefix(addr, "["), "]")
+	ip := net.ParseIP(trimmed)
+	if ip == nil || ip.To4() != nil {
+		return trimmed
+	}
+	return ip.String()
+}
+
 // BuildAddress returns a SocketAddress with the given ip and port or uds.
+// Addresses with the unix:// prefix always produce a Pipe, regardless of port.
 func BuildAddress(bind string, port uint32) *core.Address {
-	if port != 0 {
+	if port != 0 && !strings.HasPrefix(bind, model.UnixAddressPrefix) {
 		return &core.Address{
 			Address: &core.Address_SocketAddress{
 				SocketAddress: &core.SocketAddress{
-					Address: bind,
+					Address: normalizeIPv6(bind),
 					PortSpecifier: &core.SocketAddress_PortValue{
 						PortValue: port,
 					},
@@ -272,20 +287,17 @@ func SortVirtualHosts(hosts []*route.VirtualHost) {
 
 // IsIstioVersionGE19 checks whether the given Istio version is greater than or equals 1.9.
 func IsIstioVersionGE19(node *model.Proxy) bool {
-	return node == nil || node.IstioVersion == nil ||
-		node.IstioVersion.Compare(&model.IstioVersion{Major: 1, Minor: 9, Patch: -1}) >= 0
+	return node == nil || node.IstioVersion.AtLeast(&model.IstioVersion{Major: 1, Minor: 9, Patch: -1})
 }
 
 // IsIstioVersionGE18 checks whether the given Istio version is greater than or equals 1.8.
 func IsIstioVersionGE18(node *model.Proxy) bool {
-	return node == nil || node.IstioVersion == nil ||
-		node.IstioVersion.Compare(&model.IstioVersion{Major: 1, Minor: 8, Patch: -1}) >= 0
+	return node == nil || node.IstioVersion.AtLeast(&model.IstioVersion{Major: 1, Minor: 8, Patch: -1})
 }
 
 // IsIstioVersionGE181 checks whether the given Istio version is greater than or equals 1.8.1
 func IsIstioVersionGE181(node *model.Proxy) bool {
-	return node == nil || node.IstioVersion == nil ||
-		node.IstioVersion.Compare(&model.IstioVersion{Major: 1, Minor: 8, Patch: 1}) >= 0
+	return node == nil || node.IstioVersion.AtLeast(&model.IstioVersion{Major: 1, Minor: 8, Patch: 1})
 }
 
 func BuildInboundSubsetKey(node *model.Proxy, subsetName string, hostname host.Name, servicePort int, endpointPort int) string {
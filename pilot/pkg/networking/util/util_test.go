@@ -961,6 +961,36 @@ func TestBuildAddress(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "ipv6 with brackets",
+			addr: "[fe80::10e7:52ff:fecd:198b]",
+			port: 8080,
+			expected: &core.Address{
+				Address: &core.Address_SocketAddress{
+					SocketAddress: &core.SocketAddress{
+						Address: "fe80::10e7:52ff:fecd:198b",
+						PortSpecifier: &core.SocketAddress_PortValue{
+							PortValue: 8080,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ipv6 non canonical",
+			addr: "fe80:0:0:0:10e7:52ff:fecd:198b",
+			port: 8080,
+			expected: &core.Address{
+				Address: &core.Address_SocketAddress{
+					SocketAddress: &core.SocketAddress{
+						Address: "fe80::10e7:52ff:fecd:198b",
+						PortSpecifier: &core.SocketAddress_PortValue{
+							PortValue: 8080,
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "uds",
 			addr: "/var/run/test/socket",
@@ -985,6 +1015,18 @@ func TestBuildAddress(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "uds with unix prefix and port",
+			addr: "unix:///var/run/test/socket",
+			port: 8080,
+			expected: &core.Address{
+				Address: &core.Address_Pipe{
+					Pipe: &core.Pipe{
+						Path: "/var/run/test/socket",
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range testCases {
@@ -180,6 +180,11 @@ func TestGenerator_GenerateHTTP(t *testing.T) {
 			input: "deny-all-in.yaml",
 			want:  []string{"deny-all-out.yaml"},
 		},
+		{
+			name:  "deny-none",
+			input: "deny-none-in.yaml",
+			want:  []string{"deny-none-out.yaml"},
+		},
 		{
 			name:  "multiple-policies",
 			input: "multiple-policies-in.yaml",
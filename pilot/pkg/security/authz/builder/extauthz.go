@@ -35,7 +35,6 @@ import (
 	"istio.io/istio/pilot/pkg/model"
 	"istio.io/istio/pilot/pkg/networking/plugin"
 	authzmodel "istio.io/istio/pilot/pkg/security/authz/model"
-	"istio.io/istio/pkg/config/host"
 )
 
 const (
@@ -135,7 +134,9 @@ func getExtAuthz(resolved map[string]*builtExtAuthz, providers []string) (*built
 		for p := range resolved {
 			li = append(li, p)
 		}
-		errs = multierror.Append(fmt.Errorf("available providers are %v but found %q", li, provider))
+		sort.Strings(li)
+		errs = multierror.Append(fmt.Errorf("extension provider %q is not defined in mesh config, available providers are %v",
+			provider, li))
 	}
 	if errs != nil {
 		return nil, errs
@@ -201,33 +202,12 @@ func parseService(in *plugin.InputParams, service string, port int) (hostname st
 	}
 
 	// TODO(yangminzhu): Verify the service and its cluster is supported, e.g. resolution type is not OriginalDst.
-	if parts := strings.Split(service, "/"); len(parts) == 2 {
-		namespace, name := parts[0], parts[1]
-		if svc := in.Push.ServiceIndex.HostnameAndNamespace[host.Name(name)][namespace]; svc != nil {
-			hostname = string(svc.Hostname)
-			cluster = model.BuildSubsetKey(model.TrafficDirectionOutbound, "", svc.Hostname, port)
-			return
-		}
-	} else {
-		namespaceToServices := in.Push.ServiceIndex.HostnameAndNamespace[host.Name(service)]
-		var namespaces []string
-		for k := range namespaceToServices {
-			namespaces = append(namespaces, k)
-		}
-		// If namespace is omitted, return successfully if there is only one such host name in the service index.
-		if len(namespaces) == 1 {
-			svc := namespaceToServices[namespaces[0]]
-			hostname = string(svc.Hostname)
-			cluster = model.BuildSubsetKey(model.TrafficDirectionOutbound, "", svc.Hostname, port)
-			return
-		} else if len(namespaces) > 1 {
-			err = fmt.Errorf("found %s in multiple namespaces %v, specify the namespace explicitly in "+
-				"the format of <Namespace>/<Hostname>", service, namespaces)
-			return
-		}
+	svc, err := in.Push.ExtensionProviderService(service)
+	if err != nil {
+		return
 	}
-
-	err = fmt.Errorf("could not find service %s in Istio service registry", service)
+	hostname = string(svc.Hostname)
+	cluster = model.BuildSubsetKey(model.TrafficDirectionOutbound, "", svc.Hostname, port)
 	return
 }
 
@@ -686,8 +686,10 @@ func (c *Controller) getPodLocality(pod *v1.Pod) string {
 		return ""
 	}
 
-	region := getLabelValue(nodeMeta, NodeRegionLabel, NodeRegionLabelGA)
-	zone := getLabelValue(nodeMeta, NodeZoneLabel, NodeZoneLabelGA)
+	// Prefer the GA topology.kubernetes.io labels, falling back to the deprecated beta labels
+	// for nodes that have not been relabeled yet.
+	region := getLabelValue(nodeMeta, NodeRegionLabelGA, NodeRegionLabel)
+	zone := getLabelValue(nodeMeta, NodeZoneLabelGA, NodeZoneLabel)
 	subzone := getLabelValue(nodeMeta, label.IstioSubZone, "")
 
 	if region == "" && zone == "" && subzone == "" {
@@ -218,6 +218,41 @@ func TestController_GetPodLocality(t *testing.T) {
 				pod2: "region2/zone2/",
 			},
 		},
+		{
+			name: "should return correct az for nodes with topology labels",
+			pods: []*coreV1.Pod{pod1, pod2},
+			nodes: []*coreV1.Node{
+				generateNode("node1", map[string]string{NodeZoneLabelGA: "zone1", NodeRegionLabelGA: "region1", label.IstioSubZone: "subzone1"}),
+				generateNode("node2", map[string]string{NodeZoneLabelGA: "zone2", NodeRegionLabelGA: "region2"}),
+			},
+			wantAZ: map[*coreV1.Pod]string{
+				pod1: "region1/zone1/subzone1",
+				pod2: "region2/zone2/",
+			},
+		},
+		{
+			name: "should prefer topology labels over beta labels",
+			pods: []*coreV1.Pod{pod1},
+			nodes: []*coreV1.Node{
+				generateNode("node1", map[string]string{
+					NodeZoneLabelGA: "zone1", NodeRegionLabelGA: "region1",
+					NodeZoneLabel: "betazone1", NodeRegionLabel: "betaregion1",
+				}),
+			},
+			wantAZ: map[*coreV1.Pod]string{
+				pod1: "region1/zone1/",
+			},
+		},
+		{
+			name: "should return empty az if node has no locality labels",
+			pods: []*coreV1.Pod{pod1},
+			nodes: []*coreV1.Node{
+				generateNode("node1", map[string]string{"app": "node"}),
+			},
+			wantAZ: map[*coreV1.Pod]string{
+				pod1: "",
+			},
+		},
 		{
 			name: "should return false if pod isn't in the cache",
 			wantAZ: map[*coreV1.Pod]string{
@@ -96,6 +96,9 @@ func (esc *endpointSliceController) GetProxyServiceInstances(c *Controller, prox
 
 func sliceServiceInstances(c *Controller, ep *discovery.EndpointSlice, proxy *model.Proxy) []*model.ServiceInstance {
 	out := make([]*model.ServiceInstance, 0)
+	if !supportedAddressType(ep) {
+		return out
+	}
 
 	hostname := kube.ServiceHostname(ep.Labels[discovery.LabelServiceName], ep.Namespace, c.domainSuffix)
 	c.RLock()
@@ -159,6 +162,10 @@ func (esc *endpointSliceController) forgetEndpoint(endpoint interface{}) {
 func (esc *endpointSliceController) buildIstioEndpoints(es interface{}, host host.Name) []*model.IstioEndpoint {
 	slice := es.(*discovery.EndpointSlice)
 	endpoints := make([]*model.IstioEndpoint, 0)
+	if !supportedAddressType(slice) {
+		esc.endpointCache.Update(host, slice.Name, endpoints)
+		return esc.endpointCache.Get(host)
+	}
 	for _, e := range slice.Endpoints {
 		if e.Conditions.Ready != nil && !*e.Conditions.Ready {
 			// Ignore not ready endpoints
@@ -231,6 +238,9 @@ func (esc *endpointSliceController) InstancesByPort(c *Controller, svc *model.Se
 
 	var out []*model.ServiceInstance
 	for _, slice := range slices {
+		if !supportedAddressType(slice) {
+			continue
+		}
 		for _, e := range slice.Endpoints {
 			for _, a := range e.Addresses {
 				var podLabels labels.Instance
@@ -273,12 +283,33 @@ func (esc *endpointSliceController) newEndpointBuilder(pod *v1.Pod, endpoint dis
 		// Respect pod "istio-locality" label
 		if pod.Labels[model.LocalityLabel] == "" {
 			pod = pod.DeepCopy()
+			if pod.Labels == nil {
+				pod.Labels = map[string]string{}
+			}
 			// mutate the labels, only need `istio-locality`
 			pod.Labels[model.LocalityLabel] = getLocalityFromTopology(endpoint.Topology)
 		}
+		return NewEndpointBuilder(esc.c, pod)
 	}
 
-	return NewEndpointBuilder(esc.c, pod)
+	// Endpoints without a pod, for example on a VM, still have the topology reported by the slice.
+	builder := NewEndpointBuilder(esc.c, nil)
+	if locality := getLocalityFromTopology(endpoint.Topology); locality != "" {
+		builder.locality.Label = locality
+		builder.labels = augmentLabels(builder.labels, esc.c.Cluster(), locality)
+	}
+	return builder
+}
+
+// supportedAddressType reports whether the addresses of a slice can be used as endpoints. IPv4 and IPv6
+// slices, for example of a dual stack service, are both used. FQDN slices are ignored since endpoints
+// must have an IP address.
+func supportedAddressType(slice *discovery.EndpointSlice) bool {
+	if slice.AddressType == discovery.AddressTypeFQDN {
+		log.Debugf("ignoring endpoint slice %s/%s with address type %s", slice.Namespace, slice.Name, slice.AddressType)
+		return false
+	}
+	return true
 }
 
 func getLocalityFromTopology(topology map[string]string) string {
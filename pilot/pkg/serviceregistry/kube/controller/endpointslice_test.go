@@ -15,9 +15,13 @@
 package controller
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
+	discovery "k8s.io/api/discovery/v1beta1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	"istio.io/api/label"
 )
 
@@ -61,3 +65,78 @@ func TestGetLocalityFromTopology(t *testing.T) {
 		})
 	}
 }
+
+func TestEndpointSliceEndpoints(t *testing.T) {
+	controller, fx := NewFakeControllerWithOptions(FakeControllerOptions{Mode: EndpointSliceOnly})
+	defer controller.Stop()
+	createService(controller, "svc1", "nsa", nil, []int32{8080}, map[string]string{"app": "a"}, t)
+	if ev := fx.Wait("service"); ev == nil {
+		t.Fatal("Timeout creating service")
+	}
+
+	portName := "tcp-port"
+	var portNum int32 = 8080
+	notReady := false
+	slices := []*discovery.EndpointSlice{
+		{
+			ObjectMeta:  metaV1.ObjectMeta{Name: "svc1-ipv4", Namespace: "nsa", Labels: map[string]string{discovery.LabelServiceName: "svc1"}},
+			AddressType: discovery.AddressTypeIPv4,
+			Endpoints: []discovery.Endpoint{
+				{
+					Addresses: []string{"10.0.0.1"},
+					Topology:  map[string]string{NodeRegionLabelGA: "region", NodeZoneLabelGA: "zone-a"},
+				},
+				{
+					Addresses: []string{"10.0.0.2"},
+					Topology:  map[string]string{NodeRegionLabelGA: "region", NodeZoneLabelGA: "zone-b"},
+				},
+				{
+					Addresses:  []string{"10.0.0.3"},
+					Conditions: discovery.EndpointConditions{Ready: &notReady},
+				},
+			},
+			Ports: []discovery.EndpointPort{{Name: &portName, Port: &portNum}},
+		},
+		{
+			ObjectMeta:  metaV1.ObjectMeta{Name: "svc1-ipv6", Namespace: "nsa", Labels: map[string]string{discovery.LabelServiceName: "svc1"}},
+			AddressType: discovery.AddressTypeIPv6,
+			Endpoints: []discovery.Endpoint{{
+				Addresses: []string{"2001:db8::1"},
+				Topology:  map[string]string{NodeRegionLabelGA: "region", NodeZoneLabelGA: "zone-a"},
+			}},
+			Ports: []discovery.EndpointPort{{Name: &portName, Port: &portNum}},
+		},
+		{
+			ObjectMeta:  metaV1.ObjectMeta{Name: "svc1-fqdn", Namespace: "nsa", Labels: map[string]string{discovery.LabelServiceName: "svc1"}},
+			AddressType: discovery.AddressTypeFQDN,
+			Endpoints:   []discovery.Endpoint{{Addresses: []string{"svc1.example.com"}}},
+			Ports:       []discovery.EndpointPort{{Name: &portName, Port: &portNum}},
+		},
+	}
+	var ev *FakeXdsEvent
+	for _, slice := range slices {
+		if _, err := controller.client.DiscoveryV1beta1().EndpointSlices("nsa").Create(context.TODO(), slice, metaV1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		if ev = fx.Wait("eds"); ev == nil {
+			t.Fatalf("Timeout waiting for the endpoints of slice %s", slice.Name)
+		}
+	}
+
+	// The not ready endpoint and the FQDN slice are ignored.
+	zones := map[string]string{}
+	for _, ep := range ev.Endpoints {
+		zones[ep.Address] = ep.Locality.Label
+		if ep.ServicePortName != portName || ep.EndpointPort != uint32(portNum) {
+			t.Fatalf("unexpected port for endpoint %s: %s/%d", ep.Address, ep.ServicePortName, ep.EndpointPort)
+		}
+	}
+	expected := map[string]string{
+		"10.0.0.1":    "region/zone-a",
+		"10.0.0.2":    "region/zone-b",
+		"2001:db8::1": "region/zone-a",
+	}
+	if !reflect.DeepEqual(zones, expected) {
+		t.Fatalf("expected endpoints and localities %v, got %v", expected, zones)
+	}
+}
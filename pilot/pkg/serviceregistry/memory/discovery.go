@@ -234,6 +234,33 @@ func (sd *ServiceDiscovery) SetEndpoints(service string, namespace string, endpo
 	sd.EDSUpdater.EDSUpdate(sd.ClusterID, service, namespace, endpoints)
 }
 
+// SetEndpointHealth updates the health status of all endpoints of a service with the given address,
+// similar to the result of an active health check, and pushes the updated endpoints.
+func (sd *ServiceDiscovery) SetEndpointHealth(service host.Name, address string, status model.HealthStatus) {
+	sd.mutex.Lock()
+	svc := sd.services[service]
+	if svc == nil {
+		sd.mutex.Unlock()
+		return
+	}
+	endpoints := make([]*model.IstioEndpoint, 0)
+	for _, port := range svc.Ports {
+		key := fmt.Sprintf("%s:%d", service, port.Port)
+		for _, instance := range sd.instancesByPortNum[key] {
+			if instance.Endpoint.Address == address {
+				// Copy the endpoint, the previous one may still be referenced by the EDS shards.
+				ep := *instance.Endpoint
+				ep.HealthStatus = status
+				instance.Endpoint = &ep
+			}
+			endpoints = append(endpoints, instance.Endpoint)
+		}
+	}
+	sd.mutex.Unlock()
+
+	sd.EDSUpdater.EDSUpdate(sd.ClusterID, string(service), svc.Attributes.Namespace, endpoints)
+}
+
 // Services implements discovery interface
 // Each call to Services() should return a list of new *model.Service
 func (sd *ServiceDiscovery) Services() ([]*model.Service, error) {
@@ -334,3 +361,110 @@ func (sd *ServiceDiscovery) SetGatewaysForNetwork(nw string, gws ...*model.Gatew
 func (sd *ServiceDiscovery) NetworkGateways() map[string][]*model.Gateway {
 	return sd.networkGateways
 }
+
+// Snapshot is a copy of the state of a ServiceDiscovery, see ServiceDiscovery.Snapshot.
+type Snapshot struct {
+	services            map[host.Name]*model.Service
+	networkGateways     map[string][]*model.Gateway
+	instancesByPortNum  map[string][]*model.ServiceInstance
+	instancesByPortName map[string][]*model.ServiceInstance
+	ip2instance         map[string][]*model.ServiceInstance
+	ip2workloadLabels   map[string]*labels.Instance
+}
+
+// copy returns a copy of the snapshot that shares no mutable state with it. Services and endpoints are
+// replaced rather than modified by the registry, so only the instances referencing them are copied.
+func (s *Snapshot) copy() *Snapshot {
+	out := &Snapshot{
+		services:            make(map[host.Name]*model.Service, len(s.services)),
+		networkGateways:     make(map[string][]*model.Gateway, len(s.networkGateways)),
+		instancesByPortNum:  make(map[string][]*model.ServiceInstance, len(s.instancesByPortNum)),
+		instancesByPortName: make(map[string][]*model.ServiceInstance, len(s.instancesByPortName)),
+		ip2instance:         make(map[string][]*model.ServiceInstance, len(s.ip2instance)),
+		ip2workloadLabels:   make(map[string]*labels.Instance, len(s.ip2workloadLabels)),
+	}
+	for k, v := range s.services {
+		out.services[k] = v
+	}
+	for k, v := range s.networkGateways {
+		out.networkGateways[k] = append([]*model.Gateway{}, v...)
+	}
+	for k, v := range s.ip2workloadLabels {
+		out.ip2workloadLabels[k] = v
+	}
+	// The same instance is referenced by several indexes, copy it once so they stay consistent.
+	copies := map[*model.ServiceInstance]*model.ServiceInstance{}
+	copyInstances := func(from, to map[string][]*model.ServiceInstance) {
+		for k, instances := range from {
+			copied := make([]*model.ServiceInstance, 0, len(instances))
+			for _, instance := range instances {
+				c, f := copies[instance]
+				if !f {
+					cp := *instance
+					c = &cp
+					copies[instance] = c
+				}
+				copied = append(copied, c)
+			}
+			to[k] = copied
+		}
+	}
+	copyInstances(s.instancesByPortNum, out.instancesByPortNum)
+	copyInstances(s.instancesByPortName, out.instancesByPortName)
+	copyInstances(s.ip2instance, out.ip2instance)
+	return out
+}
+
+// Snapshot returns a copy of the services, instances, workloads and gateways of the registry, which
+// can be restored later with Restore.
+func (sd *ServiceDiscovery) Snapshot() *Snapshot {
+	sd.mutex.Lock()
+	defer sd.mutex.Unlock()
+	return (&Snapshot{
+		services:            sd.services,
+		networkGateways:     sd.networkGateways,
+		instancesByPortNum:  sd.instancesByPortNum,
+		instancesByPortName: sd.instancesByPortName,
+		ip2instance:         sd.ip2instance,
+		ip2workloadLabels:   sd.ip2workloadLabels,
+	}).copy()
+}
+
+// Restore resets the registry to the state it had when the snapshot was taken. A snapshot can be restored
+// any number of times. The endpoints of all services that existed before or after restoring are pushed
+// to the EDSUpdater, if set.
+func (sd *ServiceDiscovery) Restore(snapshot *Snapshot) {
+	restored := snapshot.copy()
+
+	sd.mutex.Lock()
+	updated := map[host.Name]*model.Service{}
+	for name, svc := range sd.services {
+		updated[name] = svc
+	}
+	for name, svc := range restored.services {
+		updated[name] = svc
+	}
+	sd.services = restored.services
+	sd.networkGateways = restored.networkGateways
+	sd.instancesByPortNum = restored.instancesByPortNum
+	sd.instancesByPortName = restored.instancesByPortName
+	sd.ip2instance = restored.ip2instance
+	sd.ip2workloadLabels = restored.ip2workloadLabels
+	endpoints := map[host.Name][]*model.IstioEndpoint{}
+	for name, svc := range updated {
+		endpoints[name] = make([]*model.IstioEndpoint, 0)
+		for _, port := range svc.Ports {
+			for _, instance := range sd.instancesByPortNum[fmt.Sprintf("%s:%d", name, port.Port)] {
+				endpoints[name] = append(endpoints[name], instance.Endpoint)
+			}
+		}
+	}
+	sd.mutex.Unlock()
+
+	if sd.EDSUpdater == nil {
+		return
+	}
+	for name, svc := range updated {
+		sd.EDSUpdater.EDSUpdate(sd.ClusterID, string(name), svc.Attributes.Namespace, endpoints[name])
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"testing"
+
+	"istio.io/istio/pilot/pkg/model"
+)
+
+// edsRecorder records the endpoints pushed for each service.
+type edsRecorder struct {
+	endpoints map[string][]*model.IstioEndpoint
+}
+
+var _ model.XDSUpdater = &edsRecorder{}
+
+func (r *edsRecorder) EDSUpdate(_, hostname string, _ string, entry []*model.IstioEndpoint) {
+	r.endpoints[hostname] = entry
+}
+
+func (r *edsRecorder) EDSCacheUpdate(_, _ string, _ string, _ []*model.IstioEndpoint) {}
+
+func (r *edsRecorder) SvcUpdate(_, _ string, _ string, _ model.Event) {}
+
+func (r *edsRecorder) ConfigUpdate(_ *model.PushRequest) {}
+
+func (r *edsRecorder) ProxyUpdate(_, _ string) {}
+
+func TestSnapshotRestore(t *testing.T) {
+	updater := &edsRecorder{endpoints: map[string][]*model.IstioEndpoint{}}
+	sd := NewServiceDiscovery(nil)
+	sd.EDSUpdater = updater
+	sd.AddHTTPService("a.default.svc.cluster.local", "10.0.0.1", 80)
+	sd.AddEndpoint("a.default.svc.cluster.local", "http-main", 80, "1.1.1.1", 8080)
+
+	snapshot := sd.Snapshot()
+
+	// Mutate the registry: add a service, add an endpoint and change the health of an existing one.
+	sd.AddHTTPService("b.default.svc.cluster.local", "10.0.0.2", 80)
+	sd.AddEndpoint("b.default.svc.cluster.local", "http-main", 80, "2.2.2.2", 8080)
+	sd.AddEndpoint("a.default.svc.cluster.local", "http-main", 80, "1.1.1.2", 8080)
+	sd.SetEndpointHealth("a.default.svc.cluster.local", "1.1.1.1", model.UnHealthy)
+	if services, _ := sd.Services(); len(services) != 2 {
+		t.Fatalf("expected 2 services after mutating, got %d", len(services))
+	}
+
+	// Restoring twice checks that the snapshot is not modified by the mutations done in between.
+	for i := 0; i < 2; i++ {
+		sd.Restore(snapshot)
+
+		services, _ := sd.Services()
+		if len(services) != 1 || services[0].Hostname != "a.default.svc.cluster.local" {
+			t.Fatalf("expected only service a after restoring, got %v", services)
+		}
+		svc, _ := sd.GetService("a.default.svc.cluster.local")
+		instances := sd.InstancesByPort(svc, 80, nil)
+		if len(instances) != 1 || instances[0].Endpoint.Address != "1.1.1.1" {
+			t.Fatalf("expected the original endpoint after restoring, got %v", instances)
+		}
+		if instances[0].Endpoint.HealthStatus != model.Healthy {
+			t.Fatalf("expected the original endpoint health after restoring, got %v", instances[0].Endpoint.HealthStatus)
+		}
+		if got := updater.endpoints["a.default.svc.cluster.local"]; len(got) != 1 || got[0].Address != "1.1.1.1" {
+			t.Fatalf("expected the restored endpoints of a to be pushed, got %v", got)
+		}
+		if got := updater.endpoints["b.default.svc.cluster.local"]; len(got) != 0 {
+			t.Fatalf("expected the endpoints of the removed service b to be cleared, got %v", got)
+		}
+		if instances := sd.GetProxyServiceInstances(&model.Proxy{IPAddresses: []string{"2.2.2.2"}}); len(instances) != 0 {
+			t.Fatalf("expected no instances for the removed endpoint, got %v", instances)
+		}
+
+		sd.SetEndpointHealth("a.default.svc.cluster.local", "1.1.1.1", model.UnHealthy)
+	}
+}
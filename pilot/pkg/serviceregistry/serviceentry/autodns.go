@@ -0,0 +1,235 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serviceentry
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	networking "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/constants"
+	"istio.io/istio/pkg/config/host"
+	"istio.io/istio/pkg/config/protocol"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/pkg/log"
+)
+
+// autoDNSServiceEntryPrefix is the name prefix of synthetic service entries created for external hosts.
+const autoDNSServiceEntryPrefix = "auto-dns-"
+
+// serviceEntries returns the service entries in the store, along with synthetic DNS service entries
+// for external hosts routed to by VirtualServices if that is enabled.
+func (s *ServiceEntryStore) serviceEntries() []config.Config {
+	entries := s.store.ServiceEntries()
+	if !s.autoDNSServiceEntries {
+		return entries
+	}
+	s.maybeRefreshIndexes()
+	s.storeMutex.RLock()
+	defer s.storeMutex.RUnlock()
+	return append(entries, s.autoDNSEntries...)
+}
+
+// SetMeshHostFunc sets the function used to check whether a host is a service of another registry,
+// so that no synthetic DNS service entry shadows it.
+func (s *ServiceEntryStore) SetMeshHostFunc(f func(host.Name) bool) {
+	s.meshHost = f
+	s.refreshIndexes.Store(true)
+}
+
+// RefreshAutoDNSServiceEntries recomputes the synthetic DNS service entries on next use. It is called
+// when the services of other registries change.
+func (s *ServiceEntryStore) RefreshAutoDNSServiceEntries() {
+	if s.autoDNSServiceEntries {
+		s.refreshIndexes.Store(true)
+	}
+}
+
+// virtualServiceHandler recomputes the synthetic DNS service entries when VirtualServices change. The
+// push for the VirtualService itself does not rebuild services, so a full push is triggered for the
+// synthetic service entries that were added, changed or removed.
+func (s *ServiceEntryStore) virtualServiceHandler(_, _ config.Config, _ model.Event) {
+	s.storeMutex.RLock()
+	previous := s.autoDNSEntries
+	s.storeMutex.RUnlock()
+
+	s.refreshIndexes.Store(true)
+	s.maybeRefreshIndexes()
+
+	s.storeMutex.RLock()
+	current := s.autoDNSEntries
+	s.storeMutex.RUnlock()
+
+	configsUpdated := map[model.ConfigKey]struct{}{}
+	for _, entries := range [][]config.Config{previous, current} {
+		for _, cfg := range entries {
+			for _, svc := range convertServices(cfg) {
+				configsUpdated[makeConfigKey(svc)] = struct{}{}
+			}
+		}
+	}
+	if len(configsUpdated) == 0 {
+		return
+	}
+	s.XdsUpdater.ConfigUpdate(&model.PushRequest{
+		Full:           true,
+		ConfigsUpdated: configsUpdated,
+		Reason:         []model.TriggerReason{model.ServiceUpdate},
+	})
+}
+
+// buildAutoDNSServiceEntries builds the synthetic DNS service entries for the VirtualServices in the store.
+func (s *ServiceEntryStore) buildAutoDNSServiceEntries(serviceEntries []config.Config) []config.Config {
+	vss, err := s.store.List(gvk.VirtualService, model.NamespaceAll)
+	if err != nil {
+		log.Errorf("Error listing virtual services: %v", err)
+		return nil
+	}
+	return buildDNSServiceEntries(serviceEntries, vss, s.meshHost)
+}
+
+// buildDNSServiceEntries creates a DNS resolved service entry for every external host that the given
+// VirtualServices route to but that none of the given service entries declare, nor meshHost if set.
+// Service entries defined by users always take precedence, so a host they cover, including through a
+// wildcard, is left alone.
+func buildDNSServiceEntries(serviceEntries []config.Config, virtualServices []config.Config,
+	meshHost func(host.Name) bool) []config.Config {
+	declared := make([]host.Name, 0)
+	for _, cfg := range serviceEntries {
+		for _, h := range cfg.Spec.(*networking.ServiceEntry).Hosts {
+			declared = append(declared, host.Name(h))
+		}
+	}
+	isDeclared := func(h host.Name) bool {
+		for _, d := range declared {
+			if h.SubsetOf(d) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Process VirtualServices in a stable order so that the namespace of each synthetic entry is deterministic.
+	sort.Slice(virtualServices, func(i, j int) bool {
+		if virtualServices[i].Namespace != virtualServices[j].Namespace {
+			return virtualServices[i].Namespace < virtualServices[j].Namespace
+		}
+		return virtualServices[i].Name < virtualServices[j].Name
+	})
+
+	entries := map[string]*config.Config{}
+	hosts := make([]string, 0)
+	addPort := func(vs config.Config, destination *networking.Destination, defaultPort uint32, proto protocol.Instance) {
+		if destination == nil || !isExternalHost(destination.Host, vs.Meta) || isDeclared(host.Name(destination.Host)) {
+			return
+		}
+		if meshHost != nil && (meshHost(host.Name(destination.Host)) || meshHost(clusterHost(destination.Host, vs.Meta))) {
+			return
+		}
+		number := destination.GetPort().GetNumber()
+		if number == 0 {
+			number = defaultPort
+		}
+		if number == 0 {
+			// TCP routes have no default port, there is nothing to declare.
+			return
+		}
+		cfg, f := entries[destination.Host]
+		if !f {
+			cfg = &config.Config{
+				Meta: config.Meta{
+					GroupVersionKind: gvk.ServiceEntry,
+					Name:             autoDNSServiceEntryPrefix + destination.Host,
+					Namespace:        vs.Namespace,
+					Domain:           vs.Domain,
+				},
+				Spec: &networking.ServiceEntry{
+					Hosts:      []string{destination.Host},
+					Location:   networking.ServiceEntry_MESH_EXTERNAL,
+					Resolution: networking.ServiceEntry_DNS,
+				},
+			}
+			entries[destination.Host] = cfg
+			hosts = append(hosts, destination.Host)
+		}
+		se := cfg.Spec.(*networking.ServiceEntry)
+		for _, p := range se.Ports {
+			if p.Number == number {
+				return
+			}
+		}
+		se.Ports = append(se.Ports, &networking.Port{
+			Number:   number,
+			Protocol: string(proto),
+			Name:     fmt.Sprintf("%s-%d", strings.ToLower(string(proto)), number),
+		})
+	}
+
+	for _, vs := range virtualServices {
+		spec := vs.Spec.(*networking.VirtualService)
+		for _, r := range spec.Http {
+			for _, d := range r.Route {
+				addPort(vs, d.Destination, 80, protocol.HTTP)
+			}
+			if r.Mirror != nil {
+				addPort(vs, r.Mirror, 80, protocol.HTTP)
+			}
+		}
+		for _, r := range spec.Tls {
+			for _, d := range r.Route {
+				addPort(vs, d.Destination, 443, protocol.TLS)
+			}
+		}
+		for _, r := range spec.Tcp {
+			for _, d := range r.Route {
+				addPort(vs, d.Destination, 0, protocol.TCP)
+			}
+		}
+	}
+
+	out := make([]config.Config, 0, len(hosts))
+	for _, h := range hosts {
+		out = append(out, *entries[h])
+	}
+	return out
+}
+
+// isExternalHost reports whether a destination host of a VirtualService names a single external host
+// that can be resolved through DNS. Short names and hosts in the cluster domain refer to services in
+// the mesh.
+func isExternalHost(h string, meta config.Meta) bool {
+	if h == "" || host.Name(h).IsWildCarded() || net.ParseIP(h) != nil {
+		return false
+	}
+	fqdn := string(model.ResolveShortnameToFQDN(h, meta))
+	return fqdn == h && !strings.HasSuffix(h, ".svc."+clusterDomain(meta))
+}
+
+// clusterHost returns the host in the cluster domain that h resolves to when it is of the form
+// name.namespace, as Kubernetes DNS does.
+func clusterHost(h string, meta config.Meta) host.Name {
+	return host.Name(h + ".svc." + clusterDomain(meta))
+}
+
+func clusterDomain(meta config.Meta) string {
+	if meta.Domain != "" {
+		return meta.Domain
+	}
+	return constants.DefaultKubernetesDomain
+}
@@ -0,0 +1,151 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serviceentry
+
+import (
+	"testing"
+
+	networking "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/features"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/host"
+	"istio.io/istio/pkg/config/protocol"
+	"istio.io/istio/pkg/config/schema/gvk"
+)
+
+var externalRoutes = &config.Config{
+	Meta: config.Meta{
+		GroupVersionKind: gvk.VirtualService,
+		Name:             "external",
+		Namespace:        "default",
+	},
+	Spec: &networking.VirtualService{
+		Hosts: []string{"frontend.default.svc.cluster.local"},
+		Http: []*networking.HTTPRoute{{
+			Route: []*networking.HTTPRouteDestination{
+				{Destination: &networking.Destination{Host: "api.example.com", Port: &networking.PortSelector{Number: 8080}}, Weight: 50},
+				{Destination: &networking.Destination{Host: "www.google.com"}, Weight: 25},
+				{Destination: &networking.Destination{Host: "reviews"}, Weight: 25},
+			},
+		}},
+		Tcp: []*networking.TCPRoute{{
+			Route: []*networking.RouteDestination{
+				{Destination: &networking.Destination{Host: "db.example.com", Port: &networking.PortSelector{Number: 5432}}},
+			},
+		}},
+	},
+}
+
+func TestAutoDNSServiceEntries(t *testing.T) {
+	defaultValue := features.EnableAutoDNSServiceEntries
+	features.EnableAutoDNSServiceEntries = true
+	defer func() { features.EnableAutoDNSServiceEntries = defaultValue }()
+
+	store, sd, events, stopFn := initServiceDiscovery()
+	defer stopFn()
+
+	createConfigs([]*config.Config{httpDNS, externalRoutes}, store, t)
+
+	// Creating the VirtualService must trigger a full push for the synthetic service entries.
+	apiKey := model.ConfigKey{Kind: gvk.ServiceEntry, Name: "api.example.com", Namespace: "default"}
+	for pushed := false; !pushed; {
+		e := waitForEvent(t, events)
+		if e.kind == "xds" && e.pushReq != nil && e.pushReq.Full {
+			_, pushed = e.pushReq.ConfigsUpdated[apiKey]
+		}
+	}
+
+	services, err := sd.Services()
+	if err != nil {
+		t.Fatalf("Services() encountered unexpected error: %v", err)
+	}
+	byHost := map[host.Name]*model.Service{}
+	for _, svc := range services {
+		byHost[svc.Hostname] = svc
+	}
+
+	api := byHost["api.example.com"]
+	if api == nil {
+		t.Fatalf("expected a synthetic service entry for api.example.com, got %v", services)
+	}
+	if api.Resolution != model.DNSLB || !api.MeshExternal || api.Attributes.Namespace != "default" {
+		t.Fatalf("expected a mesh external DNS service in the VirtualService namespace, got %+v", api)
+	}
+	if p, f := api.Ports.GetByPort(8080); !f || p.Protocol != protocol.HTTP {
+		t.Fatalf("expected HTTP port 8080, got %v", api.Ports)
+	}
+	db := byHost["db.example.com"]
+	if db == nil {
+		t.Fatalf("expected a synthetic service entry for db.example.com, got %v", services)
+	}
+	if p, f := db.Ports.GetByPort(5432); !f || p.Protocol != protocol.TCP {
+		t.Fatalf("expected TCP port 5432, got %v", db.Ports)
+	}
+
+	// www.google.com is covered by the user defined *.google.com entry, which must be left untouched.
+	if _, f := byHost["www.google.com"]; f {
+		t.Fatalf("unexpected synthetic service entry for a host declared by a user service entry")
+	}
+	if google := byHost["*.google.com"]; google == nil || len(google.Ports) != 2 {
+		t.Fatalf("expected the user defined service entry to be unchanged, got %v", google)
+	}
+	if _, f := byHost["reviews"]; f {
+		t.Fatalf("unexpected synthetic service entry for a short name")
+	}
+
+	sd.autoDNSServiceEntries = false
+	services, _ = sd.Services()
+	for _, svc := range services {
+		if svc.Hostname == "api.example.com" {
+			t.Fatalf("unexpected synthetic service entry when disabled")
+		}
+	}
+}
+
+func TestAutoDNSServiceEntriesMeshHosts(t *testing.T) {
+	vs := config.Config{
+		Meta: config.Meta{
+			GroupVersionKind: gvk.VirtualService,
+			Name:             "routes",
+			Namespace:        "default",
+			Domain:           "corp.local",
+		},
+		Spec: &networking.VirtualService{
+			Hosts: []string{"frontend"},
+			Http: []*networking.HTTPRoute{{
+				Route: []*networking.HTTPRouteDestination{
+					{Destination: &networking.Destination{Host: "reviews"}},
+					{Destination: &networking.Destination{Host: "reviews.default"}},
+					{Destination: &networking.Destination{Host: "ratings.default.svc.corp.local"}},
+					{Destination: &networking.Destination{Host: "payments.internal"}},
+					{Destination: &networking.Destination{Host: "api.example.com"}},
+				},
+			}},
+		},
+	}
+	meshHosts := map[host.Name]bool{
+		"reviews.default.svc.corp.local": true,
+		"payments.internal":              true,
+	}
+	meshHost := func(h host.Name) bool {
+		return meshHosts[h]
+	}
+
+	entries := buildDNSServiceEntries(nil, []config.Config{vs}, meshHost)
+	if len(entries) != 1 || entries[0].Spec.(*networking.ServiceEntry).Hosts[0] != "api.example.com" {
+		t.Fatalf("expected a synthetic service entry for api.example.com only, got %v", entries)
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"go.uber.org/atomic"
 
 	networking "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/features"
 	"istio.io/istio/pilot/pkg/model"
 	"istio.io/istio/pilot/pkg/serviceregistry"
 	"istio.io/istio/pkg/config"
@@ -78,6 +79,12 @@ type ServiceEntryStore struct { // nolint:golint
 	seWithSelectorByNamespace map[string][]servicesWithEntry
 	refreshIndexes            *atomic.Bool
 	workloadHandlers          []func(*model.WorkloadInstance, model.Event)
+	// autoDNSServiceEntries enables synthetic DNS service entries for external hosts routed to by VirtualServices
+	autoDNSServiceEntries bool
+	// autoDNSEntries are the synthetic DNS service entries, computed along with the indexes
+	autoDNSEntries []config.Config
+	// meshHost reports whether a host is a service of another registry, if set
+	meshHost func(host.Name) bool
 }
 
 // NewServiceDiscovery creates a new ServiceEntry discovery service
@@ -90,10 +97,14 @@ func NewServiceDiscovery(configController model.ConfigStoreCache, store model.Is
 		workloadInstancesByIP:      map[string]*model.WorkloadInstance{},
 		workloadInstancesIPsByName: map[string]string{},
 		refreshIndexes:             atomic.NewBool(true),
+		autoDNSServiceEntries:      features.EnableAutoDNSServiceEntries,
 	}
 	if configController != nil {
 		configController.RegisterEventHandler(gvk.ServiceEntry, s.serviceEntryHandler)
 		configController.RegisterEventHandler(gvk.WorkloadEntry, s.workloadEntryHandler)
+		if s.autoDNSServiceEntries {
+			configController.RegisterEventHandler(gvk.VirtualService, s.virtualServiceHandler)
+		}
 	}
 	return s
 }
@@ -448,7 +459,7 @@ func (s *ServiceEntryStore) HasSynced() bool {
 // Services list declarations of all services in the system
 func (s *ServiceEntryStore) Services() ([]*model.Service, error) {
 	services := make([]*model.Service, 0)
-	for _, cfg := range s.store.ServiceEntries() {
+	for _, cfg := range s.serviceEntries() {
 		services = append(services, convertServices(cfg)...)
 	}
 
@@ -471,7 +482,7 @@ func (s *ServiceEntryStore) GetService(hostname host.Name) (*model.Service, erro
 
 func (s *ServiceEntryStore) getServices() []*model.Service {
 	services := make([]*model.Service, 0)
-	for _, cfg := range s.store.ServiceEntries() {
+	for _, cfg := range s.serviceEntries() {
 		services = append(services, convertServices(cfg)...)
 	}
 	return services
@@ -611,7 +622,12 @@ func (s *ServiceEntryStore) maybeRefreshIndexes() {
 
 	// First refresh service entry
 	seWithSelectorByNamespace := map[string][]servicesWithEntry{}
-	for _, cfg := range s.store.ServiceEntries() {
+	seConfigs := s.store.ServiceEntries()
+	var autoDNSEntries []config.Config
+	if s.autoDNSServiceEntries {
+		autoDNSEntries = s.buildAutoDNSServiceEntries(seConfigs)
+	}
+	for _, cfg := range append(seConfigs, autoDNSEntries...) {
 		key := configKey{
 			kind:      serviceEntryConfigType,
 			name:      cfg.Name,
@@ -683,6 +699,7 @@ func (s *ServiceEntryStore) maybeRefreshIndexes() {
 	s.seWithSelectorByNamespace = seWithSelectorByNamespace
 	s.instances = instanceMap
 	s.ip2instance = ip2instances
+	s.autoDNSEntries = autoDNSEntries
 	s.storeMutex.Unlock()
 }
 
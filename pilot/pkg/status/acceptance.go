@@ -0,0 +1,217 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/clock"
+
+	"istio.io/api/meta/v1alpha1"
+	"istio.io/istio/pilot/pkg/features"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config/schema/collections"
+)
+
+// AcceptedCondition is the condition reporting whether a config resource was accepted into the latest push.
+const AcceptedCondition = "Accepted"
+
+// AcceptanceController writes the Accepted condition onto the config resources the current push context
+// was built from, so users can see whether their config was applied or why it was rejected.
+type AcceptanceController struct {
+	UpdateInterval time.Duration
+	dynamicClient  dynamic.Interface
+	clock          clock.Clock
+	pushContext    func() *model.PushContext
+	lastPush       *model.PushContext
+	knownResources map[schema.GroupVersionResource]dynamic.NamespaceableResourceInterface
+	// informers cache the config resources, so unchanged resources are not read from the API server.
+	informers dynamicinformer.DynamicSharedInformerFactory
+	queue     *writeQueue
+
+	mu sync.Mutex
+	// queued holds the last condition queued for each resource, keyed by statusWrite.key.
+	queued map[string]acceptedState
+}
+
+// acceptedState is the Accepted condition of a version of a config resource.
+type acceptedState struct {
+	resourceVersion string
+	status          string
+	message         string
+}
+
+func NewAcceptanceController(restConfig rest.Config, pushContext func() *model.PushContext) *AcceptanceController {
+	c := &AcceptanceController{
+		UpdateInterval: 200 * time.Millisecond,
+		clock:          clock.RealClock{},
+		pushContext:    pushContext,
+		knownResources: make(map[schema.GroupVersionResource]dynamic.NamespaceableResourceInterface),
+		queued:         make(map[string]acceptedState),
+	}
+	c.queue = newWriteQueue(features.StatusBatchInterval, features.StatusMaxBatchSize, c.writeStatus)
+	restConfig.QPS = float32(features.StatusQPS)
+	restConfig.Burst = features.StatusBurst
+	var err error
+	if c.dynamicClient, err = dynamic.NewForConfig(&restConfig); err != nil {
+		scope.Fatalf("Could not connect to kubernetes: %s", err)
+	}
+	c.informers = dynamicinformer.NewDynamicSharedInformerFactory(c.dynamicClient, 0)
+	return c
+}
+
+// Start writes the status of every new push context until stop is closed.
+func (c *AcceptanceController) Start(stop <-chan struct{}) {
+	scope.Info("Starting config acceptance status controller")
+	ctx := NewIstioContext(stop)
+	t := c.clock.Tick(c.UpdateInterval)
+	go c.queue.run(ctx)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t:
+				if push := c.pushContext(); push != nil && push != c.lastPush {
+					c.writeAllStatus(push)
+					c.lastPush = push
+				}
+			}
+		}
+	}()
+}
+
+// writeAllStatus queues the Accepted condition of the config resources the push context was built from, and
+// of those it skipped because they failed validation. Only resources which changed, or whose condition
+// changed, since they were last queued are written.
+func (c *AcceptanceController) writeAllStatus(push *model.PushContext) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	seen := make(map[string]struct{}, len(c.queued))
+	for _, cv := range push.ConfigVersions() {
+		c.queueStatus(cv, true, "Config accepted and applied.", seen)
+	}
+	for _, cv := range push.InvalidConfigVersions() {
+		reason, _ := push.InvalidConfigReason(cv.Kind, cv.Namespace, cv.Name)
+		c.queueStatus(cv, false, "Config rejected: "+reason, seen)
+	}
+	for key := range c.queued {
+		if _, f := seen[key]; !f {
+			delete(c.queued, key)
+		}
+	}
+}
+
+// queueStatus queues the Accepted condition of the config resource, unless it is unchanged since it was last
+// queued. The caller must hold c.mu.
+func (c *AcceptanceController) queueStatus(cv model.ConfigVersion, accepted bool, message string, seen map[string]struct{}) {
+	gvr := kindToGVR(cv.Kind)
+	if gvr == nil {
+		return
+	}
+	desired := v1alpha1.IstioCondition{
+		Type:               AcceptedCondition,
+		Status:             boolToConditionStatus(accepted),
+		LastProbeTime:      types.TimestampNow(),
+		LastTransitionTime: types.TimestampNow(),
+		Message:            message,
+	}
+	w := statusWrite{gvr: *gvr, config: cv, condition: desired}
+	key := w.key()
+	seen[key] = struct{}{}
+	state := acceptedState{resourceVersion: cv.ResourceVersion, status: desired.Status, message: desired.Message}
+	if c.queued[key] == state {
+		return
+	}
+	c.queued[key] = state
+	c.queue.enqueue(w)
+}
+
+// forget drops the last condition queued for the resource, so that it is queued again by the next push.
+func (c *AcceptanceController) forget(w statusWrite) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.queued, w.key())
+}
+
+func (c *AcceptanceController) writeStatus(ctx context.Context, w statusWrite) {
+	gvr, cv, desired := w.gvr, w.config, w.condition
+	resourceInterface, ok := c.knownResources[gvr]
+	if !ok {
+		resourceInterface = c.dynamicClient.Resource(gvr)
+		c.knownResources[gvr] = resourceInterface
+	}
+	informer := c.informers.ForResource(gvr)
+	// starts the informer of the resource on first use, informers which are already running are skipped.
+	c.informers.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), informer.Informer().HasSynced) {
+		return
+	}
+	obj, err := informer.Lister().ByNamespace(cv.Namespace).Get(cv.Name)
+	if err != nil {
+		if !errors.IsGone(err) && !errors.IsNotFound(err) {
+			scope.Errorf("Encountered unexpected error when retrieving status for %s %s/%s: %s", cv.Kind, cv.Namespace, cv.Name, err)
+		}
+		return
+	}
+	cached, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		scope.Errorf("Unexpected object type %T for %s %s/%s", obj, cv.Kind, cv.Namespace, cv.Name)
+		return
+	}
+	if cv.ResourceVersion != "" && cv.ResourceVersion != cached.GetResourceVersion() {
+		// the push was built from another version of the object, its status will be written by a later push.
+		c.forget(w)
+		return
+	}
+	if needsReconcile, desiredStatus := reconcileCondition(cached.Object, desired, cached.GetGeneration()); needsReconcile {
+		// unstructured objects only hold JSON values
+		status := map[string]interface{}{}
+		if b, err := json.Marshal(desiredStatus); err != nil || json.Unmarshal(b, &status) != nil {
+			scope.Errorf("Failed to convert status for %s %s/%s", cv.Kind, cv.Namespace, cv.Name)
+			return
+		}
+		// objects from the cache are shared, and must not be modified.
+		current := cached.DeepCopy()
+		current.Object["status"] = status
+		if _, err := resourceInterface.Namespace(cv.Namespace).UpdateStatus(ctx, current, metav1.UpdateOptions{}); err != nil {
+			scope.Errorf("Encountered unexpected error updating status for %s %s/%s, will try again later: %s",
+				cv.Kind, cv.Namespace, cv.Name, err)
+			c.forget(w)
+		}
+	}
+}
+
+// kindToGVR returns the resource of the Istio config kind, or nil if it is not known.
+func kindToGVR(kind string) *schema.GroupVersionResource {
+	for _, s := range collections.Pilot.All() {
+		if s.Resource().Kind() == kind {
+			return GVKtoGVR(s.Resource().GroupVersionKind())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"istio.io/istio/pilot/pkg/xds"
+)
+
+const acceptanceConfig = `
+apiVersion: networking.istio.io/v1alpha3
+kind: VirtualService
+metadata:
+  name: valid
+  namespace: default
+spec:
+  hosts:
+  - valid.example.com
+  http:
+  - route:
+    - destination:
+        host: valid.example.com
+---
+apiVersion: networking.istio.io/v1alpha3
+kind: VirtualService
+metadata:
+  name: wildcard
+  namespace: default
+spec:
+  hosts:
+  - "*"
+  http:
+  - route:
+    - destination:
+        host: valid.example.com
+`
+
+func newTestAcceptanceController(client dynamic.Interface) *AcceptanceController {
+	c := &AcceptanceController{
+		dynamicClient:  client,
+		knownResources: map[schema.GroupVersionResource]dynamic.NamespaceableResourceInterface{},
+		informers:      dynamicinformer.NewDynamicSharedInformerFactory(client, 0),
+		queued:         map[string]acceptedState{},
+	}
+	c.queue = newWriteQueue(0, 0, c.writeStatus)
+	return c
+}
+
+func TestAcceptanceStatus(t *testing.T) {
+	s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{ConfigString: acceptanceConfig})
+	push := s.PushContext()
+
+	var objects []runtime.Object
+	for _, cv := range append(push.ConfigVersions(), push.InvalidConfigVersions()...) {
+		u := &unstructured.Unstructured{}
+		u.SetAPIVersion("networking.istio.io/v1alpha3")
+		u.SetKind(cv.Kind)
+		u.SetNamespace(cv.Namespace)
+		u.SetName(cv.Name)
+		u.SetResourceVersion(cv.ResourceVersion)
+		objects = append(objects, u)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme(), objects...)
+	c := newTestAcceptanceController(client)
+	c.writeAllStatus(push)
+	c.queue.flush(ctx)
+
+	conditions := map[string]map[string]interface{}{}
+	for _, action := range client.Actions() {
+		if action.GetVerb() == "get" {
+			t.Fatalf("expected resources to be read from the informer cache, got %v", action)
+		}
+		update, ok := action.(k8stesting.UpdateActionImpl)
+		if !ok || update.GetSubresource() != "status" {
+			continue
+		}
+		u := update.GetObject().(*unstructured.Unstructured)
+		status, err := GetTypedStatus(u.Object["status"])
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, cond := range status.Conditions {
+			if cond.Type == AcceptedCondition {
+				conditions[u.GetName()] = map[string]interface{}{"status": cond.Status, "message": cond.Message}
+			}
+		}
+	}
+
+	if got := conditions["valid"]; got == nil || got["status"] != "True" {
+		t.Fatalf("expected valid virtual service to be accepted, got %v", conditions)
+	}
+	got := conditions["wildcard"]
+	if got == nil || got["status"] != "False" || !strings.Contains(got["message"].(string), "ValidateVirtualService") {
+		t.Fatalf("expected wildcard virtual service to be rejected with the failed rule, got %v", conditions)
+	}
+
+	// Writing the same push again does not queue the unchanged resources.
+	client.ClearActions()
+	c.writeAllStatus(push)
+	if pending := len(c.queue.pending); pending != 0 {
+		t.Fatalf("expected no status writes for an unchanged push, got %d", pending)
+	}
+	c.queue.flush(ctx)
+	for _, action := range client.Actions() {
+		if action.GetVerb() == "update" {
+			t.Fatalf("unexpected status update for an unchanged push: %v", action)
+		}
+	}
+}
@@ -237,8 +237,6 @@ func boolToConditionStatus(b bool) string {
 }
 
 func ReconcileStatuses(current map[string]interface{}, desired Progress, generation int64) (bool, *v1alpha1.IstioStatus) {
-	needsReconcile := false
-	currentStatus, err := GetTypedStatus(current["status"])
 	desiredCondition := v1alpha1.IstioCondition{
 		Type:               "Reconciled",
 		Status:             boolToConditionStatus(desired.AckedInstances == desired.TotalInstances),
@@ -246,6 +244,15 @@ func ReconcileStatuses(current map[string]interface{}, desired Progress, generat
 		LastTransitionTime: types.TimestampNow(),
 		Message:            fmt.Sprintf("%d/%d proxies up to date.", desired.AckedInstances, desired.TotalInstances),
 	}
+	return reconcileCondition(current, desiredCondition, generation)
+}
+
+// reconcileCondition sets the desired condition in the status of the current object, replacing the condition
+// of the same type. It reports whether the status differs from the current one, ignoring timestamps.
+func reconcileCondition(current map[string]interface{}, desiredCondition v1alpha1.IstioCondition,
+	generation int64) (bool, *v1alpha1.IstioStatus) {
+	needsReconcile := false
+	currentStatus, err := GetTypedStatus(current["status"])
 	if err != nil {
 		// the status field is in an unexpected state.
 		scope.Warn("Encountered unexpected status content.  Overwriting status.")
@@ -259,7 +266,7 @@ func ReconcileStatuses(current map[string]interface{}, desired Progress, generat
 	var currentCondition *v1alpha1.IstioCondition
 	conditionIndex := -1
 	for i, c := range currentStatus.Conditions {
-		if c.Type == "Reconciled" {
+		if c.Type == desiredCondition.Type {
 			currentCondition = currentStatus.Conditions[i]
 			conditionIndex = i
 		}
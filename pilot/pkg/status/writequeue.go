@@ -0,0 +1,109 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/clock"
+
+	"istio.io/api/meta/v1alpha1"
+	"istio.io/istio/pilot/pkg/model"
+)
+
+// statusWrite is a condition to be written onto a config resource.
+type statusWrite struct {
+	gvr       schema.GroupVersionResource
+	config    model.ConfigVersion
+	condition v1alpha1.IstioCondition
+}
+
+func (w statusWrite) key() string {
+	return w.config.Kind + "/" + w.config.Namespace + "/" + w.config.Name
+}
+
+// writeQueue batches status writes to avoid overloading the API server. A write queued for a resource
+// that already has a pending write replaces it, so rapid changes are coalesced and each resource is
+// written at most once per batch interval.
+type writeQueue struct {
+	BatchInterval time.Duration
+	// MaxBatchSize limits the number of writes per batch, the rest are written in later batches.
+	// Zero means no limit.
+	MaxBatchSize int
+
+	mu      sync.Mutex
+	pending map[string]statusWrite
+	// order holds the keys of pending writes, in the order they were first queued.
+	order []string
+	write func(context.Context, statusWrite)
+	clock clock.Clock
+}
+
+func newWriteQueue(batchInterval time.Duration, maxBatchSize int, write func(context.Context, statusWrite)) *writeQueue {
+	return &writeQueue{
+		BatchInterval: batchInterval,
+		MaxBatchSize:  maxBatchSize,
+		pending:       map[string]statusWrite{},
+		write:         write,
+		clock:         clock.RealClock{},
+	}
+}
+
+// enqueue queues a write, replacing any write pending for the same resource.
+func (q *writeQueue) enqueue(w statusWrite) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	key := w.key()
+	if _, f := q.pending[key]; !f {
+		q.order = append(q.order, key)
+	}
+	q.pending[key] = w
+}
+
+// flush writes the next batch of pending writes.
+func (q *writeQueue) flush(ctx context.Context) {
+	q.mu.Lock()
+	n := len(q.order)
+	if q.MaxBatchSize > 0 && n > q.MaxBatchSize {
+		n = q.MaxBatchSize
+	}
+	batch := make([]statusWrite, 0, n)
+	for _, key := range q.order[:n] {
+		batch = append(batch, q.pending[key])
+		delete(q.pending, key)
+	}
+	q.order = q.order[n:]
+	q.mu.Unlock()
+
+	for _, w := range batch {
+		q.write(ctx, w)
+	}
+}
+
+// run flushes a batch every interval until the context is done.
+func (q *writeQueue) run(ctx context.Context) {
+	t := q.clock.Tick(q.BatchInterval)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t:
+			q.flush(ctx)
+		}
+	}
+}
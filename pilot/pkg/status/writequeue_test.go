@@ -0,0 +1,86 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"istio.io/api/meta/v1alpha1"
+	"istio.io/istio/pilot/pkg/model"
+)
+
+func queuedWrite(name, message string) statusWrite {
+	return statusWrite{
+		config:    model.ConfigVersion{Kind: "VirtualService", Namespace: "default", Name: name},
+		condition: v1alpha1.IstioCondition{Type: AcceptedCondition, Message: message},
+	}
+}
+
+func TestWriteQueueCoalesces(t *testing.T) {
+	var written []statusWrite
+	q := newWriteQueue(0, 0, func(_ context.Context, w statusWrite) {
+		written = append(written, w)
+	})
+
+	for i := 0; i < 100; i++ {
+		q.enqueue(queuedWrite("a", fmt.Sprint(i)))
+		if i%10 == 0 {
+			q.enqueue(queuedWrite("b", fmt.Sprint(i)))
+		}
+	}
+	q.flush(context.Background())
+
+	if len(written) != 2 {
+		t.Fatalf("expected 100 updates to be coalesced into 2 writes, got %d", len(written))
+	}
+	if written[0].config.Name != "a" || written[0].condition.Message != "99" {
+		t.Fatalf("expected the last update of a to be written first, got %+v", written[0])
+	}
+	if written[1].config.Name != "b" || written[1].condition.Message != "90" {
+		t.Fatalf("expected the last update of b to be written, got %+v", written[1])
+	}
+
+	written = nil
+	q.flush(context.Background())
+	if len(written) != 0 {
+		t.Fatalf("expected no writes once the queue is drained, got %d", len(written))
+	}
+}
+
+func TestWriteQueueMaxBatchSize(t *testing.T) {
+	var written []statusWrite
+	q := newWriteQueue(0, 3, func(_ context.Context, w statusWrite) {
+		written = append(written, w)
+	})
+	for i := 0; i < 5; i++ {
+		q.enqueue(queuedWrite(fmt.Sprint(i), ""))
+	}
+
+	q.flush(context.Background())
+	if len(written) != 3 {
+		t.Fatalf("expected the first batch to be limited to 3 writes, got %d", len(written))
+	}
+	q.flush(context.Background())
+	if len(written) != 5 {
+		t.Fatalf("expected the remaining writes in the next batch, got %d", len(written))
+	}
+	for i, w := range written {
+		if w.config.Name != fmt.Sprint(i) {
+			t.Fatalf("expected writes in the order they were queued, got %s at %d", w.config.Name, i)
+		}
+	}
+}
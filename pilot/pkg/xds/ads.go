@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"strconv"
 	"sync/atomic"
 	"time"
@@ -64,6 +65,13 @@ type Connection struct {
 	// Time of connection, for debugging
 	Connect time.Time
 
+	// lastRequest is the time of the last request received on the connection, in unix nanoseconds.
+	// It is accessed atomically.
+	lastRequest int64
+	// lastResponse is the time of the last response sent on the connection, or of the connection
+	// until then, in unix nanoseconds. It is accessed atomically.
+	lastResponse int64
+
 	// ConID is the connection identifier, used as a key in the connection table.
 	// Currently based on the node name and a counter.
 	ConID string
@@ -84,6 +92,10 @@ type Connection struct {
 	// stop can be used to end the connection manually via debug endpoints. Only to be used for testing.
 	stop chan struct{}
 
+	// lastDequeued is the last time a push to this connection was taken from the push queue, in unix
+	// nanoseconds. It is accessed atomically.
+	lastDequeued int64
+
 	// blockedPushes is a map of TypeUrl to push request. This is set when we attempt to push to a busy Envoy
 	// (last push not ACKed). When we get an ACK from Envoy, if the type is populated here, we will trigger
 	// the push.
@@ -105,6 +117,7 @@ func newConnection(peerAddr string, stream DiscoveryStream) *Connection {
 		stop:          make(chan struct{}),
 		PeerAddr:      peerAddr,
 		Connect:       time.Now(),
+		lastResponse:  time.Now().UnixNano(),
 		stream:        stream,
 		blockedPushes: map[string]*model.PushRequest{},
 	}
@@ -142,6 +155,7 @@ func (s *DiscoveryServer) receive(con *Connection, reqChannel chan *discovery.Di
 			totalXDSInternalErrors.Increment()
 			return
 		}
+		atomic.StoreInt64(&con.lastRequest, time.Now().UnixNano())
 		// This should be only set for the first request. The node id may not be set - for example malicious clients.
 		if firstReq {
 			firstReq = false
@@ -161,6 +175,11 @@ func (s *DiscoveryServer) receive(con *Connection, reqChannel chan *discovery.Di
 					s.InternalGen.OnDisconnect(con)
 				}
 			}()
+			if _, delta := con.stream.(*deltaStream); delta && !con.proxy.XDSCapabilities.Has(model.XDSCapabilityDelta) {
+				*errP = status.Errorf(codes.Unimplemented, "delta XDS is only served to proxies advertising the %q capability",
+					model.XDSCapabilityDelta)
+				return
+			}
 		}
 
 		select {
@@ -221,16 +240,22 @@ func (s *DiscoveryServer) StreamAggregatedResources(stream discovery.AggregatedD
 	// cachesSynced logic to readiness probe to handle cases where kube-proxy
 	// ip tables update latencies.
 	// See https://github.com/istio/istio/issues/25495.
-	if !s.IsServerReady() {
+	// Streams established during warm-up are held until the server is ready, if configured.
+	ctx := stream.Context()
+	if !s.waitForServerReady(ctx) {
 		return errors.New("server is not ready to serve discovery information")
 	}
 
-	ctx := stream.Context()
 	peerAddr := "0.0.0.0"
 	if peerInfo, ok := peer.FromContext(ctx); ok {
 		peerAddr = peerInfo.Addr.String()
 	}
 
+	if err := s.acquireStream(peerAddr); err != nil {
+		return err
+	}
+	defer s.releaseStream(peerAddr)
+
 	ids, err := s.authenticate(ctx)
 	if err != nil {
 		return err
@@ -264,6 +289,14 @@ func (s *DiscoveryServer) StreamAggregatedResources(stream discovery.AggregatedD
 	reqChannel := make(chan *discovery.DiscoveryRequest, 1)
 	go s.receive(con, reqChannel, &receiveError)
 
+	// Connections which stop answering responses are evicted if an idle timeout is configured.
+	var idleCheck <-chan time.Time
+	if features.XDSIdleTimeout > 0 {
+		ticker := time.NewTicker(features.XDSIdleTimeout / 2)
+		defer ticker.Stop()
+		idleCheck = ticker.C
+	}
+
 	for {
 		// Block until either a request is received or a push is triggered.
 		// We need 2 go routines because 'read' blocks in Recv().
@@ -295,6 +328,11 @@ func (s *DiscoveryServer) StreamAggregatedResources(stream discovery.AggregatedD
 			if err != nil {
 				return err
 			}
+		case <-idleCheck:
+			if idle := con.Idle(); idle > features.XDSIdleTimeout {
+				adsLog.Infof("ADS: %q %s evicted after being idle for %v", con.PeerAddr, con.ConID, idle)
+				return status.Errorf(codes.DeadlineExceeded, "connection idle for %v", idle)
+			}
 		case <-con.stop:
 			return nil
 		}
@@ -357,15 +395,23 @@ func (s *DiscoveryServer) shouldRespond(con *Connection, request *discovery.Disc
 
 	// If there is mismatch in the nonce, that is a case of expired/stale nonce.
 	// A nonce becomes stale following a newer nonce being sent to Envoy.
+	// The client has yet to process a newer response, which it will ACK or NACK, so the
+	// request must not update the watched resources.
 	if request.ResponseNonce != previousInfo.NonceSent {
 		adsLog.Debugf("ADS:%s: REQ %s Expired nonce received %s, sent %s", stype,
 			con.ConID, request.ResponseNonce, previousInfo.NonceSent)
 		xdsExpiredNonce.With(typeTag.Value(v3.GetMetricType(request.TypeUrl))).Increment()
+		return false
+	}
+
+	// The client previously ACKed this type but now reports no version, meaning it has dropped its
+	// state, for example following a restart. Reset our view of the type and respond from scratch.
+	if request.VersionInfo == "" && previousInfo.VersionAcked != "" {
+		adsLog.Debugf("ADS:%s: RESET %s %s", stype, con.ConID, request.ResponseNonce)
 		con.proxy.Lock()
-		con.proxy.WatchedResources[request.TypeUrl].NonceNacked = ""
-		con.proxy.WatchedResources[request.TypeUrl].LastRequest = request
+		con.proxy.WatchedResources[request.TypeUrl] = &model.WatchedResource{TypeUrl: request.TypeUrl, ResourceNames: request.ResourceNames, LastRequest: request}
 		con.proxy.Unlock()
-		return false
+		return true
 	}
 
 	// If it comes here, that means nonce match. This an ACK. We should record
@@ -375,6 +421,9 @@ func (s *DiscoveryServer) shouldRespond(con *Connection, request *discovery.Disc
 	con.proxy.WatchedResources[request.TypeUrl].VersionAcked = request.VersionInfo
 	con.proxy.WatchedResources[request.TypeUrl].NonceAcked = request.ResponseNonce
 	con.proxy.WatchedResources[request.TypeUrl].NonceNacked = ""
+	if !previousInfo.LastSent.IsZero() {
+		con.proxy.WatchedResources[request.TypeUrl].AckLatency = time.Since(previousInfo.LastSent)
+	}
 	con.proxy.WatchedResources[request.TypeUrl].ResourceNames = request.ResourceNames
 	con.proxy.WatchedResources[request.TypeUrl].LastRequest = request
 	con.proxy.Unlock()
@@ -477,6 +526,74 @@ func (s *DiscoveryServer) initConnection(node *core.Node, con *Connection) error
 	return nil
 }
 
+// checkProxyVersion refuses proxies older than the configured minimum version, as they may not understand
+// the generated config. Newer proxies get config gated on their version by the generators.
+func checkProxyVersion(proxy *model.Proxy, minimum *model.IstioVersion) error {
+	if minimum == nil {
+		return nil
+	}
+	if !proxy.IstioVersion.AtLeast(minimum) {
+		return status.Errorf(codes.FailedPrecondition, "proxy version %s is older than the minimum supported version %s",
+			proxy.Metadata.IstioVersion, features.MinimumProxyVersion)
+	}
+	return nil
+}
+
+// parseMinimumProxyVersion parses the configured minimum proxy version. An invalid version is ignored,
+// as it would otherwise refuse every proxy.
+func parseMinimumProxyVersion(version string) *model.IstioVersion {
+	if version == "" {
+		return nil
+	}
+	minimum := model.ParseIstioVersion(version)
+	if minimum == model.MaxIstioVersion {
+		adsLog.Errorf("ignoring invalid PILOT_MINIMUM_PROXY_VERSION %q, expected a version such as 1.8", version)
+		return nil
+	}
+	return minimum
+}
+
+// streamIP returns the IP part of a peer address, used to count streams per proxy IP.
+func streamIP(peerAddr string) string {
+	if ip, _, err := net.SplitHostPort(peerAddr); err == nil {
+		return ip
+	}
+	return peerAddr
+}
+
+// acquireStream accounts for a new stream from peerAddr, refusing it if its IP already reached the
+// configured maximum number of streams. The limit is keyed on the peer IP rather than the node ID, since
+// the stream is accounted for before the node is known; proxies behind the same NAT or east-west gateway
+// therefore share it.
+func (s *DiscoveryServer) acquireStream(peerAddr string) error {
+	if features.MaxStreamsPerIP <= 0 {
+		return nil
+	}
+	ip := streamIP(peerAddr)
+	s.streamsPerIPMutex.Lock()
+	defer s.streamsPerIPMutex.Unlock()
+	if s.streamsPerIP[ip] >= features.MaxStreamsPerIP {
+		adsLog.Warnf("ADS: refusing stream from %s, it already has %d streams", peerAddr, s.streamsPerIP[ip])
+		return status.Errorf(codes.ResourceExhausted, "too many XDS streams from %s, the limit is %d", ip, features.MaxStreamsPerIP)
+	}
+	s.streamsPerIP[ip]++
+	return nil
+}
+
+func (s *DiscoveryServer) releaseStream(peerAddr string) {
+	if features.MaxStreamsPerIP <= 0 {
+		return
+	}
+	ip := streamIP(peerAddr)
+	s.streamsPerIPMutex.Lock()
+	defer s.streamsPerIPMutex.Unlock()
+	if s.streamsPerIP[ip] <= 1 {
+		delete(s.streamsPerIP, ip)
+	} else {
+		s.streamsPerIP[ip]--
+	}
+}
+
 func checkConnectionIdentity(con *Connection) (*spiffe.Identity, error) {
 	for _, rawID := range con.Identities {
 		spiffeID, err := spiffe.ParseIdentity(rawID)
@@ -509,6 +626,9 @@ func (s *DiscoveryServer) initProxy(node *core.Node, con *Connection) (*model.Pr
 	if err != nil {
 		return nil, err
 	}
+	if err := checkProxyVersion(proxy, s.minimumProxyVersion); err != nil {
+		return nil, err
+	}
 	// Update the config namespace associated with this proxy
 	proxy.ConfigNamespace = model.GetProxyConfigNamespace(proxy)
 
@@ -584,16 +704,13 @@ func (s *DiscoveryServer) preProcessRequest(proxy *model.Proxy, req *discovery.D
 	return true
 }
 
-// DeltaAggregatedResources is not implemented.
-// Instead, Generators may send only updates/add, with Delete indicated by an empty spec.
-// This works if both ends follow this model. For example EDS and the API generator follow this
-// pattern.
-//
-// The delta protocol changes the request, adding unsubscribe/subscribe instead of sending full
-// list of resources. On the response it adds 'removed resources' and sends changes for everything.
-// TODO: we could implement this method if needed, the change is not very big.
+// DeltaAggregatedResources serves the incremental XDS protocol to proxies advertising the delta
+// capability in their metadata; other proxies are rejected with Unimplemented.
+// The stream is adapted to the state of the world protocol, and served by the same push logic.
+// Generators may already send only updates/add, for example EDS on incremental pushes; these are
+// sent as is, while resources of wildcard types missing from a response are reported as removed.
 func (s *DiscoveryServer) DeltaAggregatedResources(stream discovery.AggregatedDiscoveryService_DeltaAggregatedResourcesServer) error {
-	return status.Errorf(codes.Unimplemented, "not implemented")
+	return s.StreamAggregatedResources(newDeltaStream(stream))
 }
 
 // Compute and send the new configuration for a connection. This is blocking and may be slow
@@ -819,16 +936,37 @@ func (s *DiscoveryServer) removeCon(conID string) {
 	}
 }
 
-// Send with timeout
-func (conn *Connection) send(res *discovery.DiscoveryResponse) error {
+// Send with timeout. A failed send is not retried: once SendMsg fails, gRPC aborts the stream and every
+// later send on it fails as well, and after a timeout the original send may still be in flight. The push
+// is instead dropped, counted and logged with enough context to tell which config the proxy is missing;
+// the proxy gets the config again once it reconnects.
+// resources are the named resources of the response, if known, which delta streams send by name.
+func (conn *Connection) send(res *discovery.DiscoveryResponse, resources model.Resources) error {
+	err := conn.sendWithTimeout(res, resources)
+	if err != nil {
+		adsLog.Errorf("ADS:%s: dropping push to %s (version %s, nonce %s, %d resources): %v",
+			v3.GetShortType(res.TypeUrl), conn.ConID, res.VersionInfo, res.Nonce, len(res.Resources), err)
+		xdsDroppedPushes.With(typeTag.Value(v3.GetMetricType(res.TypeUrl))).Increment()
+	}
+	return err
+}
+
+func (conn *Connection) sendWithTimeout(res *discovery.DiscoveryResponse, resources model.Resources) error {
 	errChan := make(chan error, 1)
 
+	// Recorded before sending, so that it is always older than the ACK of the response.
+	atomic.StoreInt64(&conn.lastResponse, time.Now().UnixNano())
+
 	// sendTimeout may be modified via environment
 	t := time.NewTimer(sendTimeout)
 	go func() {
 		start := time.Now()
 		defer func() { recordSendTime(time.Since(start)) }()
-		errChan <- conn.stream.Send(res)
+		if delta, ok := conn.stream.(*deltaStream); ok {
+			errChan <- delta.sendResources(res, resources)
+		} else {
+			errChan <- conn.stream.Send(res)
+		}
 		close(errChan)
 	}()
 
@@ -886,6 +1024,17 @@ func (conn *Connection) NonceAcked(typeUrl string) string {
 	return ""
 }
 
+// nolint
+// AckLatency returns the time the proxy took to ACK the last acknowledged response of the type.
+func (conn *Connection) AckLatency(typeUrl string) time.Duration {
+	conn.proxy.RLock()
+	defer conn.proxy.RUnlock()
+	if conn.proxy.WatchedResources != nil && conn.proxy.WatchedResources[typeUrl] != nil {
+		return conn.proxy.WatchedResources[typeUrl].AckLatency
+	}
+	return 0
+}
+
 // nolint
 func (conn *Connection) NonceSent(typeUrl string) string {
 	conn.proxy.RLock()
@@ -896,6 +1045,17 @@ func (conn *Connection) NonceSent(typeUrl string) string {
 	return ""
 }
 
+// Idle returns how long the connection has been waiting for the proxy to ACK or NACK the last response,
+// or to send its first request. Proxies have nothing to send while there are no pushes, so a connection
+// without a pending response is never idle.
+func (conn *Connection) Idle() time.Duration {
+	lastResponse := atomic.LoadInt64(&conn.lastResponse)
+	if atomic.LoadInt64(&conn.lastRequest) >= lastResponse {
+		return 0
+	}
+	return time.Since(time.Unix(0, lastResponse))
+}
+
 func (conn *Connection) Clusters() []string {
 	conn.proxy.RLock()
 	defer conn.proxy.RUnlock()
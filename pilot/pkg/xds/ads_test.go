@@ -41,6 +41,7 @@ import (
 	istioagent "istio.io/istio/pkg/istio-agent"
 	"istio.io/istio/pkg/security"
 	"istio.io/istio/pkg/spiffe"
+	"istio.io/istio/pkg/test/util/retry"
 	"istio.io/istio/tests/util"
 	"istio.io/pkg/log"
 )
@@ -239,6 +240,148 @@ func TestAdsUnsubscribe(t *testing.T) {
 	ads.ExpectNoResponse()
 }
 
+func TestAdsExpiredNonce(t *testing.T) {
+	s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{})
+
+	ads := s.ConnectADS().WithType(v3.EndpointType)
+	expired := ads.RequestResponseAck(&discovery.DiscoveryRequest{ResourceNames: []string{"fake-cluster"}})
+
+	// A new push makes the first nonce expire
+	xds.AdsPushAll(s.Discovery)
+	res := ads.ExpectResponse()
+
+	// Requests with the expired nonce are ignored, even if the resources change
+	ads.Request(&discovery.DiscoveryRequest{
+		ResourceNames: []string{"fake-cluster", "other-cluster"},
+		ResponseNonce: expired.Nonce,
+		VersionInfo:   expired.VersionInfo})
+	ads.ExpectNoResponse()
+
+	// The stream is not disrupted; a request with the current nonce is still handled
+	ads.Request(&discovery.DiscoveryRequest{
+		ResourceNames: []string{"fake-cluster", "other-cluster"},
+		ResponseNonce: res.Nonce,
+		VersionInfo:   res.VersionInfo})
+	ads.ExpectResponse()
+}
+
+func TestAdsVersionReset(t *testing.T) {
+	s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{})
+	cases := []struct {
+		typeURL   string
+		resources []string
+	}{
+		{v3.ClusterType, nil},
+		{v3.ListenerType, nil},
+		{v3.EndpointType, []string{"fake-cluster"}},
+	}
+	for _, tt := range cases {
+		t.Run(v3.GetShortType(tt.typeURL), func(t *testing.T) {
+			ads := s.ConnectADS().WithType(tt.typeURL)
+			res := ads.RequestResponseAck(&discovery.DiscoveryRequest{ResourceNames: tt.resources})
+
+			// ACK again, ensure we do not respond
+			ads.Request(&discovery.DiscoveryRequest{
+				ResourceNames: tt.resources,
+				ResponseNonce: res.Nonce,
+				VersionInfo:   res.VersionInfo})
+			ads.ExpectNoResponse()
+
+			// Client drops its state and reports an empty version; expect a full re-push
+			ads.Request(&discovery.DiscoveryRequest{
+				ResourceNames: tt.resources,
+				ResponseNonce: res.Nonce,
+				VersionInfo:   ""})
+			repush := ads.ExpectResponse()
+			if len(repush.Resources) != len(res.Resources) {
+				t.Fatalf("expected full re-push of %d resources, got %d", len(res.Resources), len(repush.Resources))
+			}
+		})
+	}
+}
+
+func TestAdsMinimumProxyVersion(t *testing.T) {
+	old := features.MinimumProxyVersion
+	features.MinimumProxyVersion = "1.8"
+	t.Cleanup(func() { features.MinimumProxyVersion = old })
+
+	s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{})
+	request := func(version string) *discovery.DiscoveryRequest {
+		return &discovery.DiscoveryRequest{Node: &core.Node{
+			Id:       "sidecar~1.1.1.1~test.default~default.svc.cluster.local",
+			Metadata: model.NodeMetadata{IstioVersion: version}.ToStruct(),
+		}}
+	}
+
+	// Proxies at or above the minimum version, or without a version, get config.
+	s.ConnectADS().RequestResponseAck(request("1.8.0"))
+	s.ConnectADS().RequestResponseAck(request(""))
+
+	// Older proxies are refused and the stream is closed without a response.
+	ads := s.ConnectADS()
+	ads.Request(request("1.7.5"))
+	ads.ExpectNoResponse()
+	if n := len(s.Discovery.Clients()); n != 2 {
+		t.Fatalf("expected the old proxy to be refused, got %d connected clients", n)
+	}
+}
+
+func TestAdsInvalidMinimumProxyVersion(t *testing.T) {
+	old := features.MinimumProxyVersion
+	features.MinimumProxyVersion = "latest"
+	t.Cleanup(func() { features.MinimumProxyVersion = old })
+
+	// An invalid minimum version is ignored rather than refusing every proxy.
+	s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{})
+	s.ConnectADS().RequestResponseAck(&discovery.DiscoveryRequest{Node: &core.Node{
+		Id:       "sidecar~1.1.1.1~test.default~default.svc.cluster.local",
+		Metadata: model.NodeMetadata{IstioVersion: "1.8.0"}.ToStruct(),
+	}})
+}
+
+func TestAdsMaxStreamsPerIP(t *testing.T) {
+	old := features.MaxStreamsPerIP
+	features.MaxStreamsPerIP = 1
+	t.Cleanup(func() { features.MaxStreamsPerIP = old })
+
+	s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{})
+	s.ConnectADS().WithType(v3.ClusterType).RequestResponseAck(nil)
+
+	// All test connections come from the same address, so the second stream exceeds the limit.
+	ads := s.ConnectADS().WithType(v3.ClusterType)
+	ads.Request(nil)
+	ads.ExpectNoResponse()
+	if n := len(s.Discovery.Clients()); n != 1 {
+		t.Fatalf("expected the second stream to be refused, got %d connected clients", n)
+	}
+}
+
+func TestAdsIdleEviction(t *testing.T) {
+	old := features.XDSIdleTimeout
+	features.XDSIdleTimeout = 100 * time.Millisecond
+	t.Cleanup(func() { features.XDSIdleTimeout = old })
+
+	s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{})
+	ads := s.ConnectADS().WithType(v3.ClusterType)
+	ads.RequestResponseAck(nil)
+
+	// A proxy that ACKed everything has nothing to send while there are no pushes, and is kept.
+	time.Sleep(3 * features.XDSIdleTimeout)
+	if n := len(s.Discovery.Clients()); n != 1 {
+		t.Fatalf("expected the quiet connection to be kept, got %d connected clients", n)
+	}
+
+	// The proxy never ACKs the next push, so the connection is evicted.
+	xds.AdsPushAll(s.Discovery)
+	ads.ExpectResponse()
+	retry.UntilSuccessOrFail(t, func() error {
+		if n := len(s.Discovery.Clients()); n != 0 {
+			return fmt.Errorf("expected idle connection to be evicted, got %d connected clients", n)
+		}
+		return nil
+	}, retry.Timeout(time.Second*5))
+}
+
 // Regression for envoy restart and overlapping connections
 func TestAdsReconnect(t *testing.T) {
 	s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{})
@@ -130,7 +130,7 @@ func BenchmarkRouteGeneration(b *testing.B) {
 					b.Fatal("Got no routes!")
 				}
 			}
-			logDebug(b, c)
+			logDebug(b, model.ResourcesToAny(c))
 		})
 	}
 }
@@ -148,7 +148,7 @@ func BenchmarkClusterGeneration(b *testing.B) {
 					b.Fatal("Got no clusters!")
 				}
 			}
-			logDebug(b, c)
+			logDebug(b, model.ResourcesToAny(c))
 		})
 	}
 }
@@ -166,7 +166,7 @@ func BenchmarkListenerGeneration(b *testing.B) {
 					b.Fatal("Got no listeners!")
 				}
 			}
-			logDebug(b, c)
+			logDebug(b, model.ResourcesToAny(c))
 		})
 	}
 }
@@ -184,7 +184,7 @@ func BenchmarkNameTableGeneration(b *testing.B) {
 					b.Fatal("Got no name tables!")
 				}
 			}
-			logDebug(b, c)
+			logDebug(b, model.ResourcesToAny(c))
 		})
 	}
 }
@@ -227,7 +227,7 @@ func BenchmarkSecretGeneration(b *testing.B) {
 					b.Fatal("Got no secrets!")
 				}
 			}
-			logDebug(b, c)
+			logDebug(b, model.ResourcesToAny(c))
 		})
 	}
 }
@@ -362,7 +362,7 @@ var debugGeneration = env.RegisterBoolVar("DEBUG_CONFIG_DUMP", false, "if enable
 var benchmarkScope = log.RegisterScope("benchmark", "", 0)
 
 // Add additional debug info for a test
-func logDebug(b *testing.B, m model.Resources) {
+func logDebug(b *testing.B, m []*any.Any) {
 	b.Helper()
 	b.StopTimer()
 
@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"html/template"
+	"io/ioutil"
 	"net/http"
 	"net/http/pprof"
 	"sort"
@@ -30,10 +31,13 @@ import (
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
+	"sigs.k8s.io/yaml"
 
+	networking "istio.io/api/networking/v1alpha3"
 	"istio.io/istio/pilot/pkg/config/kube/crd"
 	"istio.io/istio/pilot/pkg/features"
 	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pilot/pkg/networking/core/v1alpha3/envoyfilter"
 	"istio.io/istio/pilot/pkg/networking/util"
 	"istio.io/istio/pilot/pkg/serviceregistry"
 	"istio.io/istio/pilot/pkg/serviceregistry/aggregate"
@@ -41,6 +45,8 @@ import (
 	v3 "istio.io/istio/pilot/pkg/xds/v3"
 	"istio.io/istio/pkg/config"
 	"istio.io/istio/pkg/config/schema/collection"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/config/validation"
 	"istio.io/pkg/log"
 )
 
@@ -92,6 +98,10 @@ type AdsClient struct {
 	ConnectedAt  time.Time           `json:"connectedAt"`
 	PeerAddress  string              `json:"address"`
 	Watches      map[string][]string `json:"watches"`
+	// Age is how long the connection has been established.
+	Age string `json:"age"`
+	// Idle is how long ago the proxy last sent a request, including ACKs.
+	Idle string `json:"idle"`
 }
 
 // AdsClients is collection of AdsClient connected to this Istiod.
@@ -112,6 +122,9 @@ type SyncStatus struct {
 	RouteAcked    string `json:"route_acked,omitempty"`
 	EndpointSent  string `json:"endpoint_sent,omitempty"`
 	EndpointAcked string `json:"endpoint_acked,omitempty"`
+	// AckLatency maps the short type (CDS, LDS, RDS, EDS) to the time the proxy took to ACK the last
+	// acknowledged response of that type.
+	AckLatency map[string]string `json:"ack_latency,omitempty"`
 }
 
 // SyncedVersions shows what resourceVersion of a given resource has been acked by Envoy.
@@ -168,16 +181,23 @@ func (s *DiscoveryServer) AddDebugHandlers(mux *http.ServeMux, enableProfiling b
 	s.addDebugHandler(mux, "/debug/config_distribution", "Version status of all Envoys connected to this Pilot instance", s.distributedVersions)
 
 	s.addDebugHandler(mux, "/debug/registryz", "Debug support for registry", s.registryz)
+	s.addDebugHandler(mux, "/debug/registry_dump", "Export of the service registry as YAML", s.RegistryDump)
 	s.addDebugHandler(mux, "/debug/endpointz", "Debug support for endpoints", s.endpointz)
 	s.addDebugHandler(mux, "/debug/endpointShardz", "Info about the endpoint shards", s.endpointShardz)
 	s.addDebugHandler(mux, "/debug/cachez", "Info about the internal XDS caches", s.cachez)
+	s.addDebugHandler(mux, "/debug/push_queue", "State of the queue of proxies waiting for a push", s.pushQueuez)
 	s.addDebugHandler(mux, "/debug/configz", "Debug support for config", s.configz)
 	s.addDebugHandler(mux, "/debug/resourcesz", "Debug support for watched resources", s.resourcez)
 	s.addDebugHandler(mux, "/debug/instancesz", "Debug support for service instances", s.instancesz)
 
 	s.addDebugHandler(mux, "/debug/authorizationz", "Internal authorization policies", s.Authorizationz)
+	s.addDebugHandler(mux, "/debug/envoyfilterz", "EnvoyFilters applied to the passed in proxyID", s.EnvoyFilterz)
+	s.addDebugHandler(mux, "/debug/envoyfilter_preview",
+		"Previews the effect of the posted EnvoyFilter on the passed in proxyID, without applying it", s.EnvoyFilterPreview)
 	s.addDebugHandler(mux, "/debug/config_dump", "ConfigDump in the form of the Envoy admin config dump API for passed in proxyID", s.ConfigDump)
 	s.addDebugHandler(mux, "/debug/push_status", "Last PushContext Details", s.PushStatusHandler)
+	s.addDebugHandler(mux, "/debug/config_versions", "Versions of the configs applied in the current PushContext",
+		s.ConfigVersionsHandler)
 
 	s.addDebugHandler(mux, "/debug/inject", "Active inject template", s.InjectTemplateHandler(webhook))
 	s.addDebugHandler(mux, "/debug/mesh", "Active mesh config", s.MeshHandler)
@@ -206,6 +226,7 @@ func (s *DiscoveryServer) Syncz(w http.ResponseWriter, _ *http.Request) {
 				RouteAcked:    con.NonceAcked(v3.RouteType),
 				EndpointSent:  con.NonceSent(v3.EndpointType),
 				EndpointAcked: con.NonceAcked(v3.EndpointType),
+				AckLatency:    ackLatencies(con),
 			})
 		}
 	}
@@ -219,6 +240,20 @@ func (s *DiscoveryServer) Syncz(w http.ResponseWriter, _ *http.Request) {
 	_, _ = w.Write(out)
 }
 
+// ackLatencies returns the ACK latency of each acknowledged xDS type of the connection.
+func ackLatencies(con *Connection) map[string]string {
+	var out map[string]string
+	for _, typeURL := range []string{v3.ClusterType, v3.ListenerType, v3.RouteType, v3.EndpointType} {
+		if latency := con.AckLatency(typeURL); latency > 0 {
+			if out == nil {
+				out = map[string]string{}
+			}
+			out[v3.GetShortType(typeURL)] = latency.String()
+		}
+	}
+	return out
+}
+
 // registryz providees debug support for registry - adding and listing model items.
 // Can be combined with the push debug interface to reproduce changes.
 func (s *DiscoveryServer) registryz(w http.ResponseWriter, req *http.Request) {
@@ -241,6 +276,111 @@ func (s *DiscoveryServer) registryz(w http.ResponseWriter, req *http.Request) {
 	_, _ = fmt.Fprintln(w, "{}]")
 }
 
+// ServiceRegistryDump is a stable representation of the services, ports and endpoints known to the service registry.
+type ServiceRegistryDump struct {
+	Services []ServiceDump `json:"services"`
+}
+
+// ServiceDump is the exported form of a single service.
+type ServiceDump struct {
+	Hostname  string            `json:"hostname"`
+	Namespace string            `json:"namespace,omitempty"`
+	Address   string            `json:"address,omitempty"`
+	Registry  string            `json:"registry,omitempty"`
+	Ports     []ServicePortDump `json:"ports"`
+}
+
+// ServicePortDump is the exported form of a service port, along with the endpoints backing it.
+type ServicePortDump struct {
+	Name      string         `json:"name,omitempty"`
+	Port      int            `json:"port"`
+	Protocol  string         `json:"protocol"`
+	Endpoints []EndpointDump `json:"endpoints"`
+}
+
+// EndpointDump is the exported form of a single endpoint.
+type EndpointDump struct {
+	Address        string            `json:"address"`
+	Port           uint32            `json:"port"`
+	Labels         map[string]string `json:"labels,omitempty"`
+	ServiceAccount string            `json:"serviceAccount,omitempty"`
+	Network        string            `json:"network,omitempty"`
+	Locality       string            `json:"locality,omitempty"`
+}
+
+// RegistryDump exports the current service registry as YAML. Services, ports and endpoints are sorted
+// so the output can be diffed between calls and istiod instances.
+func (s *DiscoveryServer) RegistryDump(w http.ResponseWriter, _ *http.Request) {
+	dump, err := buildServiceRegistryDump(s.Env.ServiceDiscovery)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = fmt.Fprintf(w, "unable to list services: %v", err)
+		return
+	}
+	out, err := yaml.Marshal(dump)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = fmt.Fprintf(w, "unable to marshal registry dump: %v", err)
+		return
+	}
+	w.Header().Add("Content-Type", "application/yaml")
+	_, _ = w.Write(out)
+}
+
+func buildServiceRegistryDump(sd model.ServiceDiscovery) (ServiceRegistryDump, error) {
+	services, err := sd.Services()
+	if err != nil {
+		return ServiceRegistryDump{}, err
+	}
+	dump := ServiceRegistryDump{Services: make([]ServiceDump, 0, len(services))}
+	for _, svc := range services {
+		sdump := ServiceDump{
+			Hostname:  string(svc.Hostname),
+			Namespace: svc.Attributes.Namespace,
+			Address:   svc.Address,
+			Registry:  svc.Attributes.ServiceRegistry,
+			Ports:     make([]ServicePortDump, 0, len(svc.Ports)),
+		}
+		for _, port := range svc.Ports {
+			pdump := ServicePortDump{
+				Name:      port.Name,
+				Port:      port.Port,
+				Protocol:  string(port.Protocol),
+				Endpoints: make([]EndpointDump, 0),
+			}
+			for _, instance := range sd.InstancesByPort(svc, port.Port, nil) {
+				ep := instance.Endpoint
+				pdump.Endpoints = append(pdump.Endpoints, EndpointDump{
+					Address:        ep.Address,
+					Port:           ep.EndpointPort,
+					Labels:         ep.Labels,
+					ServiceAccount: ep.ServiceAccount,
+					Network:        ep.Network,
+					Locality:       ep.Locality.Label,
+				})
+			}
+			sort.Slice(pdump.Endpoints, func(i, j int) bool {
+				if pdump.Endpoints[i].Address == pdump.Endpoints[j].Address {
+					return pdump.Endpoints[i].Port < pdump.Endpoints[j].Port
+				}
+				return pdump.Endpoints[i].Address < pdump.Endpoints[j].Address
+			})
+			sdump.Ports = append(sdump.Ports, pdump)
+		}
+		sort.Slice(sdump.Ports, func(i, j int) bool {
+			return sdump.Ports[i].Port < sdump.Ports[j].Port
+		})
+		dump.Services = append(dump.Services, sdump)
+	}
+	sort.Slice(dump.Services, func(i, j int) bool {
+		if dump.Services[i].Hostname == dump.Services[j].Hostname {
+			return dump.Services[i].Namespace < dump.Services[j].Namespace
+		}
+		return dump.Services[i].Hostname < dump.Services[j].Hostname
+	})
+	return dump, nil
+}
+
 // Dumps info about the endpoint shards, tracked using the new direct interface.
 // Legacy registry provides are synced to the new data structure as well, during
 // the full push.
@@ -265,6 +405,29 @@ func (s *DiscoveryServer) cachez(w http.ResponseWriter, req *http.Request) {
 	_, _ = w.Write(bytes)
 }
 
+// PushQueueDebug is the state of the push queue, as returned by /debug/push_queue.
+type PushQueueDebug struct {
+	Pending           int  `json:"pending"`
+	Shedding          bool `json:"shedding"`
+	SheddingThreshold int  `json:"sheddingThreshold"`
+}
+
+func (s *DiscoveryServer) pushQueuez(w http.ResponseWriter, req *http.Request) {
+	state := PushQueueDebug{
+		Pending:           s.pushQueue.Pending(),
+		Shedding:          s.pushQueue.Shedding(),
+		SheddingThreshold: s.pushQueue.SheddingThreshold,
+	}
+	bytes, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = fmt.Fprintf(w, "unable to marshal push queue state: %v", err)
+		return
+	}
+	w.Header().Add("Content-Type", "application/json")
+	_, _ = w.Write(bytes)
+}
+
 // Endpoint debugging
 func (s *DiscoveryServer) endpointz(w http.ResponseWriter, req *http.Request) {
 	_ = req.ParseForm()
@@ -437,6 +600,201 @@ func (s *DiscoveryServer) Authorizationz(w http.ResponseWriter, req *http.Reques
 	}
 }
 
+// EnvoyFilterDebug describes an EnvoyFilter applied to a proxy.
+type EnvoyFilterDebug struct {
+	Name      string                  `json:"name"`
+	Namespace string                  `json:"namespace"`
+	Patches   []EnvoyFilterPatchDebug `json:"patches"`
+}
+
+// EnvoyFilterPatchDebug describes a single patch of an EnvoyFilter matching a proxy.
+type EnvoyFilterPatchDebug struct {
+	ApplyTo   string `json:"apply_to"`
+	Operation string `json:"operation"`
+	Context   string `json:"context"`
+}
+
+// EnvoyFilterz lists the EnvoyFilters applied to the passed in proxyID, in the order they are applied,
+// along with the patches matching the proxy.
+func (s *DiscoveryServer) EnvoyFilterz(w http.ResponseWriter, req *http.Request) {
+	proxyID := req.URL.Query().Get("proxyID")
+	if proxyID == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("You must provide a proxyID in the query string"))
+		return
+	}
+	con := s.getProxyConnection(proxyID)
+	if con == nil {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("Proxy not connected to this Pilot instance"))
+		return
+	}
+
+	filters := make([]EnvoyFilterDebug, 0)
+	for _, efw := range s.globalPushContext().MatchedEnvoyFilters(con.proxy) {
+		applyTos := make([]int, 0, len(efw.Patches))
+		for applyTo := range efw.Patches {
+			applyTos = append(applyTos, int(applyTo))
+		}
+		sort.Ints(applyTos)
+		patches := make([]EnvoyFilterPatchDebug, 0)
+		for _, applyTo := range applyTos {
+			for _, cp := range efw.Patches[networking.EnvoyFilter_ApplyTo(applyTo)] {
+				patches = append(patches, EnvoyFilterPatchDebug{
+					ApplyTo:   cp.ApplyTo.String(),
+					Operation: cp.Operation.String(),
+					Context:   cp.Match.GetContext().String(),
+				})
+			}
+		}
+		filters = append(filters, EnvoyFilterDebug{
+			Name:      efw.Name,
+			Namespace: efw.Namespace,
+			Patches:   patches,
+		})
+	}
+	w.Header().Add("Content-Type", "application/json")
+	if b, err := json.MarshalIndent(filters, "  ", "  "); err == nil {
+		_, _ = w.Write(b)
+	}
+}
+
+// EnvoyFilterPreviewDebug lists the resources of a proxy an EnvoyFilter would change.
+type EnvoyFilterPreviewDebug struct {
+	Listeners []ResourceDiffDebug `json:"listeners"`
+	Clusters  []ResourceDiffDebug `json:"clusters"`
+}
+
+// ResourceDiffDebug holds a resource before and after applying an EnvoyFilter. Before is empty
+// for added resources, and After is empty for removed ones.
+type ResourceDiffDebug struct {
+	Name   string          `json:"name"`
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+}
+
+// EnvoyFilterPreview shows the effect the EnvoyFilter posted in the request body would have on the
+// listeners and clusters of the passed in proxyID. The EnvoyFilter is not applied to the mesh.
+func (s *DiscoveryServer) EnvoyFilterPreview(w http.ResponseWriter, req *http.Request) {
+	proxyID := req.URL.Query().Get("proxyID")
+	if proxyID == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("You must provide a proxyID in the query string"))
+		return
+	}
+	con := s.getProxyConnection(proxyID)
+	if con == nil {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("Proxy not connected to this Pilot instance"))
+		return
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+		return
+	}
+	configs, _, err := crd.ParseInputs(string(body))
+	if err != nil || len(configs) != 1 || configs[0].GroupVersionKind != gvk.EnvoyFilter {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("You must provide a single EnvoyFilter in the request body"))
+		return
+	}
+
+	cfg := &configs[0]
+	if _, err := validation.ValidateEnvoyFilter(*cfg); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(fmt.Sprintf("Invalid EnvoyFilter: %v", err)))
+		return
+	}
+
+	// The current version of the EnvoyFilter, if any, is left out of the config the preview is applied
+	// to, so that the preview shows the effect of replacing it.
+	push, err := s.pushContextWithoutEnvoyFilter(cfg.Namespace, cfg.Name)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(err.Error()))
+		return
+	}
+	efw := push.PreviewEnvoyFilter(con.proxy, cfg)
+	preview, err := envoyfilter.Preview(con.proxy, push, efw,
+		s.ConfigGenerator.BuildListeners(con.proxy, push), s.ConfigGenerator.BuildClusters(con.proxy, push))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+		return
+	}
+	out := EnvoyFilterPreviewDebug{}
+	if out.Listeners, err = resourceDiffsDebug(preview.Listeners); err == nil {
+		out.Clusters, err = resourceDiffsDebug(preview.Clusters)
+	}
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(err.Error()))
+		return
+	}
+	w.Header().Add("Content-Type", "application/json")
+	if b, err := json.MarshalIndent(out, "  ", "  "); err == nil {
+		_, _ = w.Write(b)
+	}
+}
+
+// pushContextWithoutEnvoyFilter returns a copy of the current push context, without the given EnvoyFilter.
+func (s *DiscoveryServer) pushContextWithoutEnvoyFilter(namespace, name string) (*model.PushContext, error) {
+	env := *s.Env
+	env.IstioConfigStore = &envoyFilterExcludingStore{IstioConfigStore: s.Env.IstioConfigStore, namespace: namespace, name: name}
+	push := model.NewPushContext()
+	err := push.InitContext(&env, s.globalPushContext(), &model.PushRequest{
+		Full:           true,
+		ConfigsUpdated: map[model.ConfigKey]struct{}{{Kind: gvk.EnvoyFilter, Name: name, Namespace: namespace}: {}},
+	})
+	return push, err
+}
+
+// envoyFilterExcludingStore is a config store hiding one EnvoyFilter.
+type envoyFilterExcludingStore struct {
+	model.IstioConfigStore
+	namespace, name string
+}
+
+func (e *envoyFilterExcludingStore) List(typ config.GroupVersionKind, namespace string) ([]config.Config, error) {
+	configs, err := e.IstioConfigStore.List(typ, namespace)
+	if err != nil || typ != gvk.EnvoyFilter {
+		return configs, err
+	}
+	out := make([]config.Config, 0, len(configs))
+	for _, c := range configs {
+		if c.Namespace != e.namespace || c.Name != e.name {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+func resourceDiffsDebug(diffs []envoyfilter.ResourceDiff) ([]ResourceDiffDebug, error) {
+	jsonm := &jsonpb.Marshaler{}
+	out := make([]ResourceDiffDebug, 0, len(diffs))
+	for _, d := range diffs {
+		rd := ResourceDiffDebug{Name: d.Name}
+		if d.Before != nil {
+			b, err := jsonm.MarshalToString(d.Before)
+			if err != nil {
+				return nil, err
+			}
+			rd.Before = json.RawMessage(b)
+		}
+		if d.After != nil {
+			a, err := jsonm.MarshalToString(d.After)
+			if err != nil {
+				return nil, err
+			}
+			rd.After = json.RawMessage(a)
+		}
+		out = append(out, rd)
+	}
+	return out, nil
+}
+
 // adsz implements a status and debug interface for ADS.
 // It is mapped to /debug/adsz
 func (s *DiscoveryServer) adsz(w http.ResponseWriter, req *http.Request) {
@@ -455,6 +813,8 @@ func (s *DiscoveryServer) adsz(w http.ResponseWriter, req *http.Request) {
 			ConnectedAt:  c.Connect,
 			PeerAddress:  c.PeerAddr,
 			Watches:      map[string][]string{},
+			Age:          time.Since(c.Connect).Round(time.Second).String(),
+			Idle:         c.Idle().Round(time.Second).String(),
 		}
 		c.proxy.RLock()
 		for k, wr := range c.proxy.WatchedResources {
@@ -563,9 +923,9 @@ func (s *DiscoveryServer) configDump(conn *Connection) (*adminapi.ConfigDump, er
 	if s.Generators[v3.SecretType] != nil {
 		secrets := s.Generators[v3.SecretType].Generate(conn.proxy, s.globalPushContext(), conn.Watched(v3.SecretType), nil)
 		if len(secrets) > 0 {
-			for _, secretAny := range secrets {
+			for _, secretResource := range secrets {
 				secret := &tls.Secret{}
-				if err := ptypes.UnmarshalAny(secretAny, secret); err != nil {
+				if err := ptypes.UnmarshalAny(secretResource.Resource, secret); err != nil {
 					log.Warnf("failed to unmarshal secret: %v", err)
 				}
 				if secret.GetTlsCertificate() != nil {
@@ -644,6 +1004,19 @@ func (s *DiscoveryServer) PushStatusHandler(w http.ResponseWriter, req *http.Req
 	_, _ = w.Write(out)
 }
 
+// ConfigVersionsHandler dumps the versions of the config resources the current PushContext was built from.
+func (s *DiscoveryServer) ConfigVersionsHandler(w http.ResponseWriter, req *http.Request) {
+	out, err := json.MarshalIndent(s.globalPushContext().ConfigVersions(), "", "  ")
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = fmt.Fprintf(w, "unable to marshal config versions: %v", err)
+		return
+	}
+	w.Header().Add("Content-Type", "application/json")
+
+	_, _ = w.Write(out)
+}
+
 // lists all the supported debug endpoints.
 func (s *DiscoveryServer) Debug(w http.ResponseWriter, req *http.Request) {
 	type debugEndpoint struct {
@@ -705,7 +1078,7 @@ func (s *DiscoveryServer) Ndsz(w http.ResponseWriter, req *http.Request) {
 			return
 		}
 		jsonm := &jsonpb.Marshaler{Indent: "  "}
-		_ = jsonm.Marshal(w, nds[0])
+		_ = jsonm.Marshal(w, nds[0].Resource)
 	}
 }
 
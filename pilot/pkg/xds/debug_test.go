@@ -19,14 +19,23 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"sigs.k8s.io/yaml"
 
+	networking "istio.io/api/networking/v1alpha3"
 	"istio.io/istio/istioctl/pkg/util/configdump"
 	"istio.io/istio/pilot/pkg/model"
 	"istio.io/istio/pilot/pkg/xds"
 	v3 "istio.io/istio/pilot/pkg/xds/v3"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/protocol"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/test/util/retry"
 )
 
 func TestSyncz(t *testing.T) {
@@ -65,6 +74,41 @@ func TestSyncz(t *testing.T) {
 		node, _ := model.ParseServiceNodeWithMetadata(ads.ID, &model.NodeMetadata{})
 		verifySyncStatus(t, s.Discovery, node.ID, true, false)
 	})
+	t.Run("records the ack latency per type", func(t *testing.T) {
+		s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{})
+		ads := s.ConnectADS().WithType(v3.ClusterType)
+
+		ads.Request(&discovery.DiscoveryRequest{})
+		res := ads.ExpectResponse()
+		delay := 200 * time.Millisecond
+		time.Sleep(delay)
+		ads.Request(&discovery.DiscoveryRequest{ResponseNonce: res.Nonce, VersionInfo: res.VersionInfo})
+
+		node, _ := model.ParseServiceNodeWithMetadata(ads.ID, &model.NodeMetadata{})
+		retry.UntilSuccessOrFail(t, func() error {
+			for _, ss := range getSyncStatus(t, s.Discovery) {
+				if ss.ProxyID != node.ID {
+					continue
+				}
+				raw, f := ss.AckLatency["CDS"]
+				if !f {
+					return fmt.Errorf("no CDS ack latency recorded: %v", ss.AckLatency)
+				}
+				latency, err := time.ParseDuration(raw)
+				if err != nil {
+					return err
+				}
+				if latency < delay {
+					return fmt.Errorf("expected ack latency of at least %v, got %v", delay, latency)
+				}
+				if _, f := ss.AckLatency["LDS"]; f {
+					return fmt.Errorf("unexpected LDS ack latency for a type never requested: %v", ss.AckLatency)
+				}
+				return nil
+			}
+			return fmt.Errorf("node id %v not found", node.ID)
+		}, retry.Timeout(5*time.Second))
+	})
 }
 
 func getSyncStatus(t *testing.T, server *xds.DiscoveryServer) []xds.SyncStatus {
@@ -200,6 +244,66 @@ func getConfigDump(t *testing.T, s *xds.DiscoveryServer, proxyID string, wantCod
 	return got
 }
 
+func TestRegistryDump(t *testing.T) {
+	s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{})
+	s.Discovery.MemRegistry.AddService("dump.default.svc.cluster.local", &model.Service{
+		Hostname: "dump.default.svc.cluster.local",
+		Address:  "10.0.0.1",
+		Ports: model.PortList{
+			{Name: "tcp", Port: 9090, Protocol: protocol.TCP},
+			{Name: "http", Port: 80, Protocol: protocol.HTTP},
+		},
+	})
+	s.Discovery.MemRegistry.AddEndpoint("dump.default.svc.cluster.local", "http", 80, "10.1.0.2", 8080)
+	s.Discovery.MemRegistry.AddEndpoint("dump.default.svc.cluster.local", "http", 80, "10.1.0.1", 8080)
+
+	req, err := http.NewRequest("GET", "/debug/registry_dump", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr := httptest.NewRecorder()
+	http.HandlerFunc(s.Discovery.RegistryDump).ServeHTTP(rr, req)
+	if rr.Code != 200 {
+		t.Fatalf("unexpected status code %d: %s", rr.Code, rr.Body.String())
+	}
+
+	got := xds.ServiceRegistryDump{}
+	if err := yaml.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	want := xds.ServiceDump{
+		Hostname: "dump.default.svc.cluster.local",
+		Address:  "10.0.0.1",
+		Registry: "Mock",
+		Ports: []xds.ServicePortDump{
+			{
+				Name:     "http",
+				Port:     80,
+				Protocol: "HTTP",
+				Endpoints: []xds.EndpointDump{
+					{Address: "10.1.0.1", Port: 8080},
+					{Address: "10.1.0.2", Port: 8080},
+				},
+			},
+			{
+				Name:      "tcp",
+				Port:      9090,
+				Protocol:  "TCP",
+				Endpoints: []xds.EndpointDump{},
+			},
+		},
+	}
+	for _, svc := range got.Services {
+		if svc.Hostname == want.Hostname {
+			if !reflect.DeepEqual(svc, want) {
+				t.Fatalf("got service dump %+v, want %+v", svc, want)
+			}
+			return
+		}
+	}
+	t.Fatalf("service %v not found in registry dump:\n%s", want.Hostname, rr.Body.String())
+}
+
 func TestDebugHandlers(t *testing.T) {
 	s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{})
 	req, err := http.NewRequest("GET", "/debug", nil)
@@ -213,3 +317,195 @@ func TestDebugHandlers(t *testing.T) {
 		t.Errorf("Error in generatating debug endpoint list")
 	}
 }
+
+func TestConfigVersions(t *testing.T) {
+	s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{})
+	vs := config.Config{
+		Meta: config.Meta{
+			GroupVersionKind: gvk.VirtualService,
+			Name:             "reviews",
+			Namespace:        "default",
+		},
+		Spec: &networking.VirtualService{
+			Hosts: []string{"reviews.default.svc.cluster.local"},
+			Http: []*networking.HTTPRoute{{
+				Route: []*networking.HTTPRouteDestination{{
+					Destination: &networking.Destination{Host: "reviews.default.svc.cluster.local"},
+				}},
+			}},
+		},
+	}
+	if _, err := s.Store().Create(vs); err != nil {
+		t.Fatal(err)
+	}
+	created := s.Store().Get(gvk.VirtualService, vs.Name, vs.Namespace)
+	if created == nil {
+		t.Fatalf("virtual service %s/%s not found", vs.Namespace, vs.Name)
+	}
+
+	retry.UntilSuccessOrFail(t, func() error {
+		req, err := http.NewRequest("GET", "/debug/config_versions", nil)
+		if err != nil {
+			return err
+		}
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(s.Discovery.ConfigVersionsHandler).ServeHTTP(rr, req)
+		if rr.Code != http.StatusOK {
+			return fmt.Errorf("unexpected status code %d", rr.Code)
+		}
+		var versions []model.ConfigVersion
+		if err := json.Unmarshal(rr.Body.Bytes(), &versions); err != nil {
+			return err
+		}
+		for _, v := range versions {
+			if v.Kind == gvk.VirtualService.Kind && v.Name == vs.Name && v.Namespace == vs.Namespace {
+				if v.ResourceVersion != created.ResourceVersion {
+					return fmt.Errorf("got resource version %q, want %q", v.ResourceVersion, created.ResourceVersion)
+				}
+				return nil
+			}
+		}
+		return fmt.Errorf("virtual service %s/%s not found in %v", vs.Namespace, vs.Name, versions)
+	}, retry.Timeout(time.Second*5))
+}
+
+func TestEnvoyFilterz(t *testing.T) {
+	s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{ConfigString: `
+apiVersion: networking.istio.io/v1alpha3
+kind: EnvoyFilter
+metadata:
+  name: lua
+  namespace: default
+spec:
+  configPatches:
+  - applyTo: HTTP_FILTER
+    match:
+      context: SIDECAR_INBOUND
+    patch:
+      operation: INSERT_FIRST
+      value:
+        name: envoy.filters.http.lua
+---
+apiVersion: networking.istio.io/v1alpha3
+kind: EnvoyFilter
+metadata:
+  name: other
+  namespace: default
+spec:
+  workloadSelector:
+    labels:
+      app: other
+  configPatches:
+  - applyTo: CLUSTER
+    patch:
+      operation: REMOVE
+`})
+	ads := s.ConnectADS()
+	ads.RequestResponseAck(&discovery.DiscoveryRequest{TypeUrl: v3.ClusterType})
+
+	req, err := http.NewRequest("GET", "/debug/envoyfilterz?proxyID=test.default", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr := httptest.NewRecorder()
+	http.HandlerFunc(s.Discovery.EnvoyFilterz).ServeHTTP(rr, req)
+	if rr.Code != 200 {
+		t.Fatalf("unexpected status code %d: %s", rr.Code, rr.Body.String())
+	}
+	got := []xds.EnvoyFilterDebug{}
+	if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	want := []xds.EnvoyFilterDebug{{
+		Name:      "lua",
+		Namespace: "default",
+		Patches: []xds.EnvoyFilterPatchDebug{{
+			ApplyTo:   "HTTP_FILTER",
+			Operation: "INSERT_FIRST",
+			Context:   "SIDECAR_INBOUND",
+		}},
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestEnvoyFilterPreview(t *testing.T) {
+	filter := func(timeout string) string {
+		return `
+apiVersion: networking.istio.io/v1alpha3
+kind: EnvoyFilter
+metadata:
+  name: timeout
+  namespace: default
+spec:
+  configPatches:
+  - applyTo: CLUSTER
+    match:
+      context: SIDECAR_OUTBOUND
+      cluster:
+        name: BlackHoleCluster
+    patch:
+      operation: MERGE
+      value:
+        connect_timeout: ` + timeout + `
+`
+	}
+	// The live version of the previewed EnvoyFilter is replaced, not applied underneath the preview.
+	s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{ConfigString: filter("5s")})
+	ads := s.ConnectADS()
+	ads.RequestResponseAck(&discovery.DiscoveryRequest{TypeUrl: v3.ClusterType})
+
+	preview := func(body string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest("POST", "/debug/envoyfilter_preview?proxyID=test.default", strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(s.Discovery.EnvoyFilterPreview).ServeHTTP(rr, req)
+		return rr
+	}
+	rr := preview(filter("7s"))
+	if rr.Code != 200 {
+		t.Fatalf("unexpected status code %d: %s", rr.Code, rr.Body.String())
+	}
+	got := xds.EnvoyFilterPreviewDebug{}
+	if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Listeners) != 0 {
+		t.Errorf("expected no listener changes, got %v", got.Listeners)
+	}
+	if len(got.Clusters) != 1 || got.Clusters[0].Name != "BlackHoleCluster" {
+		t.Fatalf("expected only BlackHoleCluster to change, got %v", got.Clusters)
+	}
+	if !strings.Contains(string(got.Clusters[0].After), `"connectTimeout":"7s"`) ||
+		strings.Contains(string(got.Clusters[0].Before), `"connectTimeout":"7s"`) ||
+		strings.Contains(string(got.Clusters[0].Before), `"connectTimeout":"5s"`) {
+		t.Errorf("unexpected diff: %+v", got.Clusters[0])
+	}
+
+	// The previewed filter must not be applied to the live config.
+	for _, c := range s.Clusters(s.SetupProxy(nil)) {
+		if c.Name == "BlackHoleCluster" && c.GetConnectTimeout().GetSeconds() != 5 {
+			t.Fatalf("preview modified the live config: %v", c.GetConnectTimeout())
+		}
+	}
+
+	// Invalid filters are rejected before being previewed.
+	invalid := `
+apiVersion: networking.istio.io/v1alpha3
+kind: EnvoyFilter
+metadata:
+  name: invalid
+  namespace: default
+spec:
+  configPatches:
+  - applyTo: CLUSTER
+    patch:
+      operation: MERGE
+`
+	if rr := preview(invalid); rr.Code != 400 || !strings.Contains(rr.Body.String(), "missing patch value") {
+		t.Errorf("expected invalid filter to be rejected, got %d: %s", rr.Code, rr.Body.String())
+	}
+}
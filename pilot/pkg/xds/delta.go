@@ -0,0 +1,143 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds
+
+import (
+	"sort"
+	"sync"
+
+	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+
+	"istio.io/istio/pilot/pkg/model"
+)
+
+// DeltaDiscoveryStream is a server interface for incremental XDS.
+type DeltaDiscoveryStream = discovery.AggregatedDiscoveryService_DeltaAggregatedResourcesServer
+
+// deltaStream adapts an incremental XDS stream to the state of the world stream used by the rest of
+// the server, so that delta connections share the same request handling and push logic.
+// Requests are converted by tracking the subscribed resource names of each type. Responses are
+// converted by naming each resource with the name given by its generator and, for wildcard types where
+// every response holds all resources, reporting the resources missing from the previous response as removed.
+type deltaStream struct {
+	DeltaDiscoveryStream
+
+	mu sync.Mutex
+	// subscribed holds the resource names the client subscribed to, by type.
+	subscribed map[string]map[string]struct{}
+	// versions holds the version of the last response sent, by type. Delta requests do not carry a
+	// version, so it is added to ACKs to keep the state of the world ACK handling working.
+	versions map[string]string
+	// sent holds the names of the resources in the last response of each wildcard type.
+	sent map[string]map[string]struct{}
+}
+
+var _ DiscoveryStream = &deltaStream{}
+
+func newDeltaStream(stream DeltaDiscoveryStream) *deltaStream {
+	return &deltaStream{
+		DeltaDiscoveryStream: stream,
+		subscribed:           map[string]map[string]struct{}{},
+		versions:             map[string]string{},
+		sent:                 map[string]map[string]struct{}{},
+	}
+}
+
+// Recv reads the next delta request and returns the equivalent state of the world request.
+func (d *deltaStream) Recv() (*discovery.DiscoveryRequest, error) {
+	req, err := d.DeltaDiscoveryStream.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	names := d.subscribed[req.TypeUrl]
+	if names == nil {
+		names = map[string]struct{}{}
+		d.subscribed[req.TypeUrl] = names
+	}
+	for _, n := range req.ResourceNamesSubscribe {
+		names[n] = struct{}{}
+	}
+	for _, n := range req.ResourceNamesUnsubscribe {
+		delete(names, n)
+	}
+	resourceNames := make([]string, 0, len(names))
+	for n := range names {
+		resourceNames = append(resourceNames, n)
+	}
+	sort.Strings(resourceNames)
+
+	out := &discovery.DiscoveryRequest{
+		Node:          req.Node,
+		TypeUrl:       req.TypeUrl,
+		ResourceNames: resourceNames,
+		ResponseNonce: req.ResponseNonce,
+		ErrorDetail:   req.ErrorDetail,
+	}
+	if req.ResponseNonce != "" {
+		out.VersionInfo = d.versions[req.TypeUrl]
+	}
+	return out, nil
+}
+
+// Send converts a state of the world response to a delta response and sends it. The names of the
+// resources are not known, so they are sent unnamed and not tracked for removal.
+func (d *deltaStream) Send(res *discovery.DiscoveryResponse) error {
+	return d.sendResources(res, nil)
+}
+
+// sendResources converts a state of the world response, whose resources are named by resources, to a
+// delta response and sends it. If resources is nil, the resources of the response are sent unnamed.
+func (d *deltaStream) sendResources(res *discovery.DiscoveryResponse, resources model.Resources) error {
+	if resources == nil {
+		resources = make(model.Resources, 0, len(res.Resources))
+		for _, r := range res.Resources {
+			resources = append(resources, &discovery.Resource{Resource: r})
+		}
+	}
+	out := &discovery.DeltaDiscoveryResponse{
+		TypeUrl:           res.TypeUrl,
+		SystemVersionInfo: res.VersionInfo,
+		Nonce:             res.Nonce,
+	}
+	names := make(map[string]struct{}, len(resources))
+	for _, r := range resources {
+		if r.Name != "" {
+			names[r.Name] = struct{}{}
+		}
+		out.Resources = append(out.Resources, &discovery.Resource{
+			Name:     r.Name,
+			Version:  res.VersionInfo,
+			Resource: r.Resource,
+		})
+	}
+
+	d.mu.Lock()
+	d.versions[res.TypeUrl] = res.VersionInfo
+	if isWildcardTypeURL(res.TypeUrl) {
+		for n := range d.sent[res.TypeUrl] {
+			if _, f := names[n]; !f {
+				out.RemovedResources = append(out.RemovedResources, n)
+			}
+		}
+		sort.Strings(out.RemovedResources)
+		d.sent[res.TypeUrl] = names
+	}
+	d.mu.Unlock()
+
+	return d.DeltaDiscoveryStream.Send(out)
+}
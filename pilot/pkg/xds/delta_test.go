@@ -0,0 +1,149 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pilot/pkg/xds"
+	v3 "istio.io/istio/pilot/pkg/xds/v3"
+	"istio.io/istio/pkg/config/schema/gvk"
+)
+
+const deltaConfig = `
+apiVersion: networking.istio.io/v1alpha3
+kind: ServiceEntry
+metadata:
+  name: a
+  namespace: default
+spec:
+  hosts:
+  - a.example.com
+  ports:
+  - number: 80
+    name: http
+    protocol: HTTP
+  resolution: DNS
+`
+
+func connectDelta(t *testing.T, s *xds.FakeDiscoveryServer) discovery.AggregatedDiscoveryService_DeltaAggregatedResourcesClient {
+	conn, err := grpc.Dial("buffcon", grpc.WithInsecure(), grpc.WithBlock(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return s.Listener.Dial()
+	}))
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(func() {
+		cancel()
+		_ = conn.Close()
+	})
+	client, err := discovery.NewAggregatedDiscoveryServiceClient(conn).DeltaAggregatedResources(ctx)
+	if err != nil {
+		t.Fatalf("delta stream failed: %v", err)
+	}
+	return client
+}
+
+func recvDelta(t *testing.T, client discovery.AggregatedDiscoveryService_DeltaAggregatedResourcesClient) *discovery.DeltaDiscoveryResponse {
+	t.Helper()
+	type result struct {
+		res *discovery.DeltaDiscoveryResponse
+		err error
+	}
+	ch := make(chan result, 1)
+	go func() {
+		res, err := client.Recv()
+		ch <- result{res, err}
+	}()
+	select {
+	case r := <-ch:
+		if r.err != nil {
+			t.Fatalf("failed to receive delta response: %v", r.err)
+		}
+		return r.res
+	case <-time.After(5 * time.Second):
+		t.Fatalf("did not get delta response in time")
+	}
+	return nil
+}
+
+func TestDeltaAds(t *testing.T) {
+	node := func(capabilities ...string) *core.Node {
+		return &core.Node{
+			Id:       "sidecar~1.1.1.1~test.default~default.svc.cluster.local",
+			Metadata: (&model.NodeMetadata{XDSCapabilities: capabilities}).ToStruct(),
+		}
+	}
+
+	t.Run("delta capable", func(t *testing.T) {
+		s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{ConfigString: deltaConfig})
+		client := connectDelta(t, s)
+		if err := client.Send(&discovery.DeltaDiscoveryRequest{Node: node("delta"), TypeUrl: v3.ClusterType}); err != nil {
+			t.Fatal(err)
+		}
+		res := recvDelta(t, client)
+		names := map[string]bool{}
+		for _, r := range res.Resources {
+			if r.Resource.GetTypeUrl() != v3.ClusterType {
+				t.Fatalf("unexpected resource type %v", r.Resource.GetTypeUrl())
+			}
+			if r.Name == "" {
+				t.Fatalf("expected every cluster to be named, got %v", r)
+			}
+			names[r.Name] = true
+		}
+		if !names["outbound|80||a.example.com"] {
+			t.Fatalf("expected named cluster for a.example.com, got %v", names)
+		}
+		if err := client.Send(&discovery.DeltaDiscoveryRequest{TypeUrl: v3.ClusterType, ResponseNonce: res.Nonce}); err != nil {
+			t.Fatal(err)
+		}
+
+		s.Store().Delete(gvk.ServiceEntry, "a", "default")
+		s.Discovery.ConfigUpdate(&model.PushRequest{Full: true})
+		res = recvDelta(t, client)
+		removed := false
+		for _, n := range res.RemovedResources {
+			if n == "outbound|80||a.example.com" {
+				removed = true
+			}
+		}
+		if !removed {
+			t.Fatalf("expected cluster for a.example.com to be removed, got %v", res.RemovedResources)
+		}
+	})
+
+	t.Run("not advertised", func(t *testing.T) {
+		s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{ConfigString: deltaConfig})
+		client := connectDelta(t, s)
+		if err := client.Send(&discovery.DeltaDiscoveryRequest{Node: node(), TypeUrl: v3.ClusterType}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Recv(); status.Code(err) != codes.Unimplemented {
+			t.Fatalf("expected delta to be rejected as unimplemented, got %v", err)
+		}
+	})
+}
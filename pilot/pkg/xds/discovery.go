@@ -15,6 +15,7 @@
 package xds
 
 import (
+	"context"
 	"strconv"
 	"sync"
 	"time"
@@ -43,6 +44,8 @@ var (
 	version = "0"
 	// versionNum counts versions
 	versionNum = atomic.NewUint64(0)
+	// nonceNum counts nonces, when using the counter nonce format
+	nonceNum = atomic.NewUint64(0)
 
 	periodicRefreshMetrics = 10 * time.Second
 )
@@ -109,6 +112,10 @@ type DiscoveryServer struct {
 	adsClients      map[string]*Connection
 	adsClientsMutex sync.RWMutex
 
+	// streamsPerIP counts the active XDS streams per proxy IP, to enforce features.MaxStreamsPerIP.
+	streamsPerIP      map[string]int
+	streamsPerIPMutex sync.Mutex
+
 	StatusReporter DistributionStatusCache
 
 	// Authenticators for XDS requests. Should be same/subset of the CA authenticators.
@@ -119,6 +126,13 @@ type DiscoveryServer struct {
 
 	// serverReady indicates caches have been synced up and server is ready to process requests.
 	serverReady atomic.Bool
+	// serverReadyCh is closed once the server is ready.
+	serverReadyCh   chan struct{}
+	serverReadyOnce sync.Once
+	// warmupTimeout is how long streams established before the server is ready wait for it.
+	warmupTimeout time.Duration
+	// minimumProxyVersion is the oldest proxy version accepted, if set.
+	minimumProxyVersion *model.IstioVersion
 
 	debounceOptions debounceOptions
 
@@ -159,13 +173,18 @@ func NewDiscoveryServer(env *model.Environment, plugins []string, instanceID str
 		pushQueue:               NewPushQueue(),
 		debugHandlers:           map[string]string{},
 		adsClients:              map[string]*Connection{},
+		streamsPerIP:            map[string]int{},
 		debounceOptions: debounceOptions{
 			debounceAfter:     features.DebounceAfter,
 			debounceMax:       features.DebounceMax,
 			enableEDSDebounce: features.EnableEDSDebounce.Get(),
 		},
-		Cache:      model.DisabledCache{},
-		instanceID: instanceID,
+		Cache:         model.DisabledCache{},
+		instanceID:    instanceID,
+		serverReadyCh: make(chan struct{}),
+		warmupTimeout: features.XDSWarmupTimeout,
+
+		minimumProxyVersion: parseMinimumProxyVersion(features.MinimumProxyVersion),
 	}
 
 	// Flush cached discovery responses when detecting jwt public key change.
@@ -198,12 +217,35 @@ var (
 func (s *DiscoveryServer) CachesSynced() {
 	adsLog.Infof("All caches have been synced up in %v, marking server ready", time.Since(processStartTime))
 	s.serverReady.Store(true)
+	s.serverReadyOnce.Do(func() {
+		if s.serverReadyCh != nil {
+			close(s.serverReadyCh)
+		}
+	})
 }
 
 func (s *DiscoveryServer) IsServerReady() bool {
 	return s.serverReady.Load()
 }
 
+// waitForServerReady blocks until the server is ready, the warm-up timeout expires or the context
+// is done. It returns whether the server is ready.
+func (s *DiscoveryServer) waitForServerReady(ctx context.Context) bool {
+	if s.IsServerReady() || s.warmupTimeout <= 0 || s.serverReadyCh == nil {
+		return s.IsServerReady()
+	}
+	timer := time.NewTimer(s.warmupTimeout)
+	defer timer.Stop()
+	select {
+	case <-s.serverReadyCh:
+		return true
+	case <-timer.C:
+		return s.IsServerReady()
+	case <-ctx.Done():
+		return false
+	}
+}
+
 func (s *DiscoveryServer) Start(stopCh <-chan struct{}) {
 	if s.InternalGen != nil {
 		s.InternalGen.Run(stopCh)
@@ -292,7 +334,19 @@ func (s *DiscoveryServer) Push(req *model.PushRequest) {
 	go s.AdsPushAll(versionLocal, req)
 }
 
+const (
+	// uuidNonceFormat generates nonces with a random UUID suffix.
+	uuidNonceFormat = "uuid"
+	// counterNonceFormat generates nonces with a monotonically increasing counter suffix.
+	counterNonceFormat = "counter"
+)
+
+// nonce generates a unique nonce for a response. Clients must treat nonces as opaque; the server only
+// ever compares the nonce in a request against the last nonce it sent for that type.
 func nonce(noncePrefix string) string {
+	if features.XDSNonceFormat == counterNonceFormat {
+		return noncePrefix + strconv.FormatUint(nonceNum.Inc(), 10)
+	}
 	return noncePrefix + uuid.New().String()
 }
 
@@ -309,6 +363,11 @@ func (s *DiscoveryServer) globalPushContext() *model.PushContext {
 	return s.Env.PushContext
 }
 
+// GlobalPushContext returns the push context of the last successful push.
+func (s *DiscoveryServer) GlobalPushContext() *model.PushContext {
+	return s.globalPushContext()
+}
+
 // ConfigUpdate implements ConfigUpdater interface, used to request pushes.
 // It replaces the 'clear cache' from v1.
 func (s *DiscoveryServer) ConfigUpdate(req *model.PushRequest) {
@@ -477,6 +536,7 @@ func (s *DiscoveryServer) initGenerators() {
 	s.Generators[v3.RouteType] = &RdsGenerator{Server: s}
 	s.Generators[v3.EndpointType] = edsGen
 	s.Generators[v3.NameTableType] = &NdsGenerator{Server: s}
+	s.Generators[v3.ExtensionConfigurationType] = &EcdsGenerator{Server: s}
 
 	s.Generators["grpc"] = &grpcgen.GrpcConfigGenerator{}
 	s.Generators["grpc/"+v3.EndpointType] = edsGen
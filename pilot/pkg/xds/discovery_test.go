@@ -18,14 +18,20 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"go.opencensus.io/stats/view"
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"istio.io/istio/pilot/pkg/features"
 	"istio.io/istio/pilot/pkg/model"
 	v3 "istio.io/istio/pilot/pkg/xds/v3"
 	"istio.io/istio/pkg/test/util/retry"
@@ -178,6 +184,111 @@ func (h *fakeStream) Context() context.Context {
 	return context.Background()
 }
 
+// flakyStream fails its first sends, up to failures, with err.
+type flakyStream struct {
+	fakeStream
+	failures int
+	err      error
+	sends    int32
+}
+
+func (h *flakyStream) Send(*discovery.DiscoveryResponse) error {
+	if atomic.AddInt32(&h.sends, 1) <= int32(h.failures) {
+		return h.err
+	}
+	return nil
+}
+
+func TestShouldRespondExpiredNonce(t *testing.T) {
+	s := &DiscoveryServer{}
+	newConnection := func() *Connection {
+		return &Connection{
+			ConID: "stale",
+			proxy: &model.Proxy{ID: "stale", WatchedResources: map[string]*model.WatchedResource{
+				v3.ClusterType: {TypeUrl: v3.ClusterType, ResourceNames: []string{"a"}, NonceSent: "n2"},
+			}},
+		}
+	}
+
+	t.Run("ack", func(t *testing.T) {
+		con := newConnection()
+		req := &discovery.DiscoveryRequest{TypeUrl: v3.ClusterType, ResponseNonce: "n1", ResourceNames: []string{"a", "b"}}
+		if s.shouldRespond(con, req) {
+			t.Fatal("expected no response to an expired nonce")
+		}
+		if w := con.proxy.WatchedResources[v3.ClusterType]; !reflect.DeepEqual(w.ResourceNames, []string{"a"}) || w.NonceAcked != "" {
+			t.Fatalf("expected an expired ACK not to update the watched resources, got %+v", w)
+		}
+	})
+
+	t.Run("nack", func(t *testing.T) {
+		con := newConnection()
+		req := &discovery.DiscoveryRequest{TypeUrl: v3.ClusterType, ResponseNonce: "n1", ErrorDetail: &rpcstatus.Status{Message: "rejected"}}
+		if s.shouldRespond(con, req) {
+			t.Fatal("expected no response to a NACK")
+		}
+		if nacked := con.proxy.WatchedResources[v3.ClusterType].NonceNacked; nacked != "n1" {
+			t.Fatalf("expected a NACK with an expired nonce to still be recorded, got %q", nacked)
+		}
+	})
+}
+
+func TestSendFailure(t *testing.T) {
+	newConnection := func(stream DiscoveryStream) *Connection {
+		return &Connection{
+			ConID:  "flaky",
+			proxy:  &model.Proxy{WatchedResources: map[string]*model.WatchedResource{}},
+			stream: stream,
+		}
+	}
+	res := &discovery.DiscoveryResponse{TypeUrl: v3.ClusterType, VersionInfo: "v1", Nonce: "n1"}
+	droppedPushes := func() float64 {
+		rows, err := view.RetrieveData("pilot_xds_dropped_pushes")
+		if err != nil {
+			t.Fatalf("failed to get value for pilot_xds_dropped_pushes: %v", err)
+		}
+		for _, row := range rows {
+			for _, tag := range row.Tags {
+				if tag.Key.Name() == "type" && tag.Value == v3.GetMetricType(v3.ClusterType) {
+					return row.Data.(*view.SumData).Value
+				}
+			}
+		}
+		return 0
+	}
+
+	t.Run("success", func(t *testing.T) {
+		stream := &flakyStream{}
+		con := newConnection(stream)
+		if err := con.send(res, nil); err != nil {
+			t.Fatalf("expected send to succeed, got %v", err)
+		}
+		if sent := con.NonceSent(v3.ClusterType); sent != "n1" {
+			t.Fatalf("expected nonce n1 to be recorded as sent, got %q", sent)
+		}
+	})
+
+	for _, code := range []codes.Code{codes.ResourceExhausted, codes.Aborted, codes.Unavailable} {
+		t.Run(code.String(), func(t *testing.T) {
+			stream := &flakyStream{failures: 1, err: status.Error(code, "failed")}
+			con := newConnection(stream)
+			dropped := droppedPushes()
+			if err := con.send(res, nil); status.Code(err) != code {
+				t.Fatalf("expected push to be dropped with %v, got %v", code, err)
+			}
+			if got := atomic.LoadInt32(&stream.sends); got != 1 {
+				t.Fatalf("expected a failed send not to be retried, got %d attempts", got)
+			}
+			if got := droppedPushes(); got != dropped+1 {
+				t.Fatalf("expected the dropped push to be counted, got %v after %v", got, dropped)
+			}
+			if sent := con.NonceSent(v3.ClusterType); sent != "" {
+				t.Fatalf("expected a dropped push not to be recorded as sent, got %q", sent)
+			}
+		})
+	}
+}
+
 func TestDebounce(t *testing.T) {
 	// This test tests the timeout and debouncing of config updates
 	// If it is flaking, DebounceAfter may need to be increased, or the code refactored to mock time.
@@ -437,6 +548,27 @@ func TestShouldRespond(t *testing.T) {
 			},
 			response: false,
 		},
+		{
+			name: "reset after ack",
+			connection: &Connection{
+				proxy: &model.Proxy{
+					WatchedResources: map[string]*model.WatchedResource{
+						v3.ClusterType: {
+							VersionSent:  "v1",
+							NonceSent:    "nonce",
+							VersionAcked: "v1",
+							NonceAcked:   "nonce",
+						},
+					},
+				},
+			},
+			request: &discovery.DiscoveryRequest{
+				TypeUrl:       v3.ClusterType,
+				VersionInfo:   "",
+				ResponseNonce: "nonce",
+			},
+			response: true,
+		},
 		{
 			name: "unsubscribe EDS",
 			connection: &Connection{
@@ -475,3 +607,67 @@ func TestShouldRespond(t *testing.T) {
 		})
 	}
 }
+
+func TestNonce(t *testing.T) {
+	original := features.XDSNonceFormat
+	t.Cleanup(func() {
+		features.XDSNonceFormat = original
+	})
+	for _, format := range []string{uuidNonceFormat, counterNonceFormat} {
+		t.Run(format, func(t *testing.T) {
+			features.XDSNonceFormat = format
+			first, second := nonce("v1"), nonce("v1")
+			if !strings.HasPrefix(first, "v1") || !strings.HasPrefix(second, "v1") {
+				t.Fatalf("expected nonces %v and %v to have the version prefix", first, second)
+			}
+			if first == second {
+				t.Fatalf("expected unique nonces, got %v twice", first)
+			}
+		})
+	}
+}
+
+func TestWaitForServerReady(t *testing.T) {
+	t.Run("ready", func(t *testing.T) {
+		s := &DiscoveryServer{serverReadyCh: make(chan struct{}), warmupTimeout: time.Second}
+		s.CachesSynced()
+		if !s.waitForServerReady(context.Background()) {
+			t.Fatalf("expected server to be ready")
+		}
+	})
+	t.Run("no warm-up", func(t *testing.T) {
+		s := &DiscoveryServer{serverReadyCh: make(chan struct{})}
+		if s.waitForServerReady(context.Background()) {
+			t.Fatalf("expected stream to be rejected before the server is ready")
+		}
+	})
+	t.Run("delayed until ready", func(t *testing.T) {
+		s := &DiscoveryServer{serverReadyCh: make(chan struct{}), warmupTimeout: time.Minute}
+		delay := 100 * time.Millisecond
+		go func() {
+			time.Sleep(delay)
+			s.CachesSynced()
+		}()
+		start := time.Now()
+		if !s.waitForServerReady(context.Background()) {
+			t.Fatalf("expected server to become ready")
+		}
+		if elapsed := time.Since(start); elapsed < delay {
+			t.Fatalf("expected stream to be delayed for at least %v, was %v", delay, elapsed)
+		}
+	})
+	t.Run("timeout", func(t *testing.T) {
+		s := &DiscoveryServer{serverReadyCh: make(chan struct{}), warmupTimeout: 10 * time.Millisecond}
+		if s.waitForServerReady(context.Background()) {
+			t.Fatalf("expected warm-up to time out")
+		}
+	})
+	t.Run("stream closed", func(t *testing.T) {
+		s := &DiscoveryServer{serverReadyCh: make(chan struct{}), warmupTimeout: time.Minute}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if s.waitForServerReady(ctx) {
+			t.Fatalf("expected closed stream to stop waiting")
+		}
+	})
+}
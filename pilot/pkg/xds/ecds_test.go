@@ -0,0 +1,230 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds_test
+
+import (
+	"testing"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	rbachttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	wasm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/wasm/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/golang/protobuf/ptypes"
+
+	networking "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/features"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pilot/pkg/xds"
+	v3 "istio.io/istio/pilot/pkg/xds/v3"
+	"istio.io/istio/pilot/test/xdstest"
+	"istio.io/istio/pkg/config/schema/gvk"
+)
+
+const ecdsConfig = `
+apiVersion: networking.istio.io/v1alpha3
+kind: ServiceEntry
+metadata:
+  name: a
+  namespace: default
+spec:
+  hosts:
+  - a.example.com
+  addresses:
+  - 240.0.0.1
+  ports:
+  - number: 80
+    name: http
+    protocol: HTTP
+  resolution: STATIC
+  endpoints:
+  - address: 1.2.3.4
+---
+apiVersion: networking.istio.io/v1alpha3
+kind: EnvoyFilter
+metadata:
+  name: remote
+  namespace: default
+  annotations:
+    wasm.istio.io/fail-open: "true"
+spec:
+  configPatches:
+  - applyTo: HTTP_FILTER
+    match:
+      context: SIDECAR_OUTBOUND
+      listener:
+        filterChain:
+          filter:
+            name: envoy.filters.network.http_connection_manager
+            subFilter:
+              name: envoy.filters.http.router
+    patch:
+      operation: INSERT_BEFORE
+      value:
+        name: remote-wasm
+        typed_config:
+          "@type": type.googleapis.com/envoy.extensions.filters.http.wasm.v3.Wasm
+          config:
+            vm_config:
+              runtime: envoy.wasm.runtime.v8
+              code:
+                remote:
+                  http_uri:
+                    uri: https://example.com/filter.wasm
+                    cluster: outbound|443||example.com
+                    timeout: 10s
+                  sha256: abc123
+---
+apiVersion: networking.istio.io/v1alpha3
+kind: EnvoyFilter
+metadata:
+  name: local
+  namespace: default
+spec:
+  configPatches:
+  - applyTo: HTTP_FILTER
+    match:
+      context: SIDECAR_OUTBOUND
+      listener:
+        filterChain:
+          filter:
+            name: envoy.filters.network.http_connection_manager
+            subFilter:
+              name: envoy.filters.http.router
+    patch:
+      operation: INSERT_BEFORE
+      value:
+        name: local-wasm
+        typed_config:
+          "@type": type.googleapis.com/envoy.extensions.filters.http.wasm.v3.Wasm
+          config:
+            vm_config:
+              runtime: envoy.wasm.runtime.v8
+              code:
+                local:
+                  filename: /etc/istio/extensions/filter.wasm
+`
+
+func TestECDSRemoteWasm(t *testing.T) {
+	defer func(enabled bool) {
+		features.EnableRemoteWasmECDS = enabled
+	}(features.EnableRemoteWasmECDS)
+	features.EnableRemoteWasmECDS = true
+	s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{ConfigString: ecdsConfig})
+
+	t.Run("ecds", func(t *testing.T) {
+		ads := s.ConnectADS().WithType(v3.ExtensionConfigurationType)
+		res := ads.RequestResponseAck(&discovery.DiscoveryRequest{ResourceNames: []string{"remote-wasm", "local-wasm"}})
+		if len(res.Resources) != 1 {
+			t.Fatalf("expected only the remote filter, got %d resources", len(res.Resources))
+		}
+		ec := &core.TypedExtensionConfig{}
+		if err := ptypes.UnmarshalAny(res.Resources[0], ec); err != nil {
+			t.Fatal(err)
+		}
+		if ec.Name != "remote-wasm" {
+			t.Fatalf("unexpected extension config %q", ec.Name)
+		}
+		w := &wasm.Wasm{}
+		if err := ptypes.UnmarshalAny(ec.TypedConfig, w); err != nil {
+			t.Fatal(err)
+		}
+		remote := w.GetConfig().GetVmConfig().GetCode().GetRemote()
+		if remote.GetHttpUri().GetUri() != "https://example.com/filter.wasm" || remote.GetSha256() != "abc123" {
+			t.Fatalf("unexpected remote source: %v", remote)
+		}
+	})
+
+	t.Run("listener", func(t *testing.T) {
+		filters := wasmHTTPFilters(t, s, &model.Proxy{ConfigNamespace: "default"})
+		if filters["local-wasm"].GetTypedConfig() == nil {
+			t.Fatalf("expected local filter to be inlined, got %v", filters["local-wasm"])
+		}
+		cd := filters["remote-wasm"].GetConfigDiscovery()
+		if cd == nil || cd.ConfigSource.GetAds() == nil {
+			t.Fatalf("expected remote filter to be served over ECDS, got %v", filters["remote-wasm"])
+		}
+		// Fail open: the default config is an RBAC filter without rules, applied only if fetching fails
+		rb := &rbachttp.RBAC{}
+		if err := ptypes.UnmarshalAny(cd.DefaultConfig, rb); err != nil {
+			t.Fatal(err)
+		}
+		if rb.Rules != nil || cd.ApplyDefaultConfigWithoutWarming {
+			t.Fatalf("expected fail open default config applied after warming, got %v", cd)
+		}
+		if len(cd.TypeUrls) != 2 || cd.TypeUrls[1] != cd.DefaultConfig.TypeUrl {
+			t.Fatalf("expected the default config type to be accepted, got %v", cd.TypeUrls)
+		}
+	})
+
+	t.Run("old proxy", func(t *testing.T) {
+		filters := wasmHTTPFilters(t, s, &model.Proxy{
+			ConfigNamespace: "default",
+			Metadata:        &model.NodeMetadata{IstioVersion: "1.8.0"},
+		})
+		if filters["remote-wasm"].GetTypedConfig() == nil {
+			t.Fatalf("expected remote filter to be inlined for a proxy without ECDS support, got %v", filters["remote-wasm"])
+		}
+	})
+
+	t.Run("preview for old proxy", func(t *testing.T) {
+		cfg := s.PushContext().IstioConfigStore.Get(gvk.EnvoyFilter, "remote", "default")
+		if cfg == nil {
+			t.Fatal("expected the remote EnvoyFilter")
+		}
+		proxy := s.SetupProxy(&model.Proxy{
+			ConfigNamespace: "default",
+			Metadata:        &model.NodeMetadata{IstioVersion: "1.8.0"},
+		})
+		efw := s.PushContext().PreviewEnvoyFilter(proxy, cfg)
+		patches := efw.Patches[networking.EnvoyFilter_HTTP_FILTER]
+		if len(patches) != 1 || patches[0].ExtensionConfig != nil {
+			t.Fatalf("expected the previewed filter to be inlined for a proxy without ECDS support, got %v", patches)
+		}
+	})
+}
+
+func TestECDSRemoteWasmDisabled(t *testing.T) {
+	s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{ConfigString: ecdsConfig})
+	filters := wasmHTTPFilters(t, s, &model.Proxy{ConfigNamespace: "default"})
+	if filters["remote-wasm"].GetTypedConfig() == nil {
+		t.Fatalf("expected remote filter to be inlined unless enabled, got %v", filters["remote-wasm"])
+	}
+}
+
+// wasmHTTPFilters returns the WASM HTTP filters on the outbound listener of the test service.
+func wasmHTTPFilters(t *testing.T, s *xds.FakeDiscoveryServer, p *model.Proxy) map[string]*hcm.HttpFilter {
+	t.Helper()
+	l := xdstest.ExtractListener("0.0.0.0_80", s.Listeners(s.SetupProxy(p)))
+	if l == nil {
+		t.Fatal("expected listener 0.0.0.0_80")
+	}
+	found := map[string]*hcm.HttpFilter{}
+	for _, fc := range l.FilterChains {
+		h := xdstest.ExtractHTTPConnectionManager(t, fc)
+		if h == nil {
+			continue
+		}
+		for _, f := range h.HttpFilters {
+			if f.Name == "local-wasm" || f.Name == "remote-wasm" {
+				found[f.Name] = f
+			}
+		}
+	}
+	if found["local-wasm"] == nil || found["remote-wasm"] == nil {
+		t.Fatalf("expected both wasm filters, got %v", found)
+	}
+	return found
+}
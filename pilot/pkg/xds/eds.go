@@ -333,7 +333,7 @@ func (eds *EdsGenerator) Generate(proxy *model.Proxy, push *model.PushContext, w
 	if !req.Full {
 		edsUpdatedServices = model.ConfigNamesOfKind(req.ConfigsUpdated, gvk.ServiceEntry)
 	}
-	resources := make([]*any.Any, 0)
+	resources := make(model.Resources, 0)
 	empty := 0
 
 	cached := 0
@@ -349,7 +349,7 @@ func (eds *EdsGenerator) Generate(proxy *model.Proxy, push *model.PushContext, w
 		}
 		builder := NewEndpointBuilder(clusterName, proxy, push)
 		if marshalledEndpoint, f := eds.Server.Cache.Get(builder); f {
-			resources = append(resources, marshalledEndpoint)
+			resources = append(resources, &discovery.Resource{Name: clusterName, Resource: marshalledEndpoint})
 			cached++
 		} else {
 			l := eds.Server.generateEndpoints(builder)
@@ -362,7 +362,7 @@ func (eds *EdsGenerator) Generate(proxy *model.Proxy, push *model.PushContext, w
 				empty++
 			}
 			resource := util.MessageToAny(l)
-			resources = append(resources, resource)
+			resources = append(resources, &discovery.Resource{Name: clusterName, Resource: resource})
 			eds.Server.Cache.Add(builder, resource)
 		}
 	}
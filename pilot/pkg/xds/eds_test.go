@@ -24,6 +24,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -33,12 +34,15 @@ import (
 
 	endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 
+	meshconfig "istio.io/api/mesh/v1alpha1"
+	"istio.io/istio/pilot/pkg/features"
 	"istio.io/istio/pilot/pkg/model"
 	"istio.io/istio/pilot/pkg/networking"
 	"istio.io/istio/pilot/pkg/xds"
 	v3 "istio.io/istio/pilot/pkg/xds/v3"
 	"istio.io/istio/pkg/adsc"
 	"istio.io/istio/pkg/config/host"
+	"istio.io/istio/pkg/config/mesh"
 	"istio.io/istio/pkg/config/protocol"
 	"istio.io/istio/pkg/config/schema/gvk"
 	"istio.io/istio/pkg/test/env"
@@ -243,6 +247,57 @@ func TestNoTunnelServerEndpointEds(t *testing.T) {
 	})
 }
 
+func TestEdsIPv6(t *testing.T) {
+	s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{})
+
+	// A dual-stack service, with endpoints from both families in the same cluster.
+	s.Discovery.MemRegistry.AddHTTPService("ipv6.test.svc.cluster.local", "10.10.1.3", 8080)
+	eps := newEndpointWithAccount("10.0.0.1", "hello-sa", "v1")
+	eps = append(eps, newEndpointWithAccount("2001:db8::1", "hello-sa", "v1")...)
+	eps = append(eps, newEndpointWithAccount("[2001:db8:0:0:0:0:0:2]", "hello-sa", "v1")...)
+	s.Discovery.MemRegistry.SetEndpoints("ipv6.test.svc.cluster.local", "", eps)
+
+	adscConn := s.Connect(&model.Proxy{IPAddresses: []string{"10.10.10.10"}}, nil, watchAll)
+	cluster := "outbound|8080||ipv6.test.svc.cluster.local"
+	for _, expected := range []string{"10.0.0.1", "2001:db8::1", "2001:db8::2"} {
+		testEndpoints(expected, cluster, adscConn, t)
+	}
+}
+
+func TestEdsServiceEntryUDS(t *testing.T) {
+	s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{ConfigString: `
+apiVersion: networking.istio.io/v1alpha3
+kind: ServiceEntry
+metadata:
+  name: uds
+  namespace: default
+spec:
+  hosts:
+  - uds.example.com
+  ports:
+  - number: 80
+    name: http
+    protocol: HTTP
+  resolution: STATIC
+  endpoints:
+  - address: unix:///var/run/uds/socket
+`})
+	adscConn := s.Connect(&model.Proxy{IPAddresses: []string{"10.10.10.10"}}, nil, watchAll)
+
+	cluster := "outbound|80||uds.example.com"
+	lbe, f := adscConn.GetEndpoints()[cluster]
+	if !f || len(lbe.Endpoints) != 1 || len(lbe.Endpoints[0].LbEndpoints) != 1 {
+		t.Fatalf("expected a single UDS endpoint for %v, got %v", cluster, adscConn.EndpointsJSON())
+	}
+	addr := lbe.Endpoints[0].LbEndpoints[0].GetEndpoint().GetAddress()
+	if addr.GetSocketAddress() != nil {
+		t.Fatalf("expected a pipe address, got socket address %v", addr.GetSocketAddress())
+	}
+	if path := addr.GetPipe().GetPath(); path != "/var/run/uds/socket" {
+		t.Fatalf("expected Pipe to %s, got %s", "/var/run/uds/socket", path)
+	}
+}
+
 func mustReadFile(t *testing.T, fpaths ...string) string {
 	result := ""
 	for _, fpath := range fpaths {
@@ -415,6 +470,131 @@ func TestDeleteService(t *testing.T) {
 	}
 }
 
+// TestEdsMultiCluster verifies that endpoints of a service reported by several clusters are
+// aggregated into a single load assignment, and that an address reused in another network is
+// reached through that network's gateway.
+func TestEdsMultiCluster(t *testing.T) {
+	s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{
+		NetworksWatcher: mesh.NewFixedNetworksWatcher(&meshconfig.MeshNetworks{
+			Networks: map[string]*meshconfig.Network{
+				"network1": {Gateways: []*meshconfig.Network_IstioNetworkGateway{{
+					Gw:   &meshconfig.Network_IstioNetworkGateway_Address{Address: "1.1.1.1"},
+					Port: 15443,
+				}}},
+				"network2": {Gateways: []*meshconfig.Network_IstioNetworkGateway{{
+					Gw:   &meshconfig.Network_IstioNetworkGateway_Address{Address: "2.2.2.2"},
+					Port: 15443,
+				}}},
+			},
+		}),
+	})
+	hostname := "multicluster.default.svc.cluster.local"
+	s.Discovery.MemRegistry.AddService(host.Name(hostname), &model.Service{
+		Hostname: host.Name(hostname),
+		Ports: model.PortList{{
+			Name:     "http",
+			Port:     8080,
+			Protocol: protocol.HTTP,
+		}},
+	})
+	fullPush(s)
+
+	cluster := "outbound|8080||" + hostname
+	endpointsFor := func(network string) []string {
+		t.Helper()
+		p := s.SetupProxy(&model.Proxy{Metadata: &model.NodeMetadata{Network: network}})
+		for _, cla := range s.Endpoints(p) {
+			if cla.ClusterName != cluster {
+				continue
+			}
+			var got []string
+			for _, llb := range cla.Endpoints {
+				for _, lb := range llb.LbEndpoints {
+					got = append(got, lb.GetEndpoint().Address.GetSocketAddress().Address)
+				}
+			}
+			sort.Strings(got)
+			return got
+		}
+		t.Fatalf("no load assignment for %v", cluster)
+		return nil
+	}
+
+	t.Run("aggregated", func(t *testing.T) {
+		s.Discovery.EDSUpdate("cluster1", hostname, "default", []*model.IstioEndpoint{
+			{Address: "10.0.0.1", EndpointPort: 8080, ServicePortName: "http", Network: "network1"},
+		})
+		s.Discovery.EDSUpdate("cluster2", hostname, "default", []*model.IstioEndpoint{
+			{Address: "10.0.0.2", EndpointPort: 8080, ServicePortName: "http", Network: "network1"},
+		})
+		if got, want := endpointsFor("network1"), []string{"10.0.0.1", "10.0.0.2"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("expected endpoints from both clusters %v, got %v", want, got)
+		}
+	})
+
+	t.Run("same address in different networks", func(t *testing.T) {
+		s.Discovery.EDSUpdate("cluster2", hostname, "default", []*model.IstioEndpoint{
+			{Address: "10.0.0.1", EndpointPort: 8080, ServicePortName: "http", Network: "network2"},
+		})
+		if got, want := endpointsFor("network1"), []string{"10.0.0.1", "2.2.2.2"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("expected local endpoint and remote gateway %v, got %v", want, got)
+		}
+		if got, want := endpointsFor("network2"), []string{"1.1.1.1", "10.0.0.1"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("expected local endpoint and remote gateway %v, got %v", want, got)
+		}
+	})
+}
+
+// Validate that with health gating enabled, unhealthy endpoints are excluded until marked healthy.
+func TestEDSHealthGating(t *testing.T) {
+	defer func(enabled, failOpen bool) {
+		features.EnableEDSHealthGating = enabled
+		features.EDSHealthGatingFailOpen = failOpen
+	}(features.EnableEDSHealthGating, features.EDSHealthGatingFailOpen)
+	features.EnableEDSHealthGating = true
+
+	cluster := "outbound|8080||healthgate.com"
+	cases := []struct {
+		name     string
+		failOpen bool
+		initial  model.HealthStatus
+		included bool
+	}{
+		{"unhealthy", false, model.UnHealthy, false},
+		{"unhealthy fail open", true, model.UnHealthy, false},
+		{"unknown fail closed", false, model.UnknownHealth, false},
+		{"unknown fail open", true, model.UnknownHealth, true},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			features.EDSHealthGatingFailOpen = tt.failOpen
+			s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{})
+			s.Discovery.MemRegistry.AddHTTPService("healthgate.com", "", 8080)
+			s.Discovery.MemRegistry.SetEndpoints("healthgate.com", "",
+				[]*model.IstioEndpoint{
+					{
+						Address:         "10.0.0.1",
+						ServicePortName: "http-main",
+						EndpointPort:    8080,
+						HealthStatus:    tt.initial,
+					}})
+			fullPush(s)
+
+			adscConn := s.Connect(nil, nil, watchEds)
+			lbe := adscConn.GetEndpoints()[cluster]
+			if got := lbe != nil && len(lbe.Endpoints) > 0; got != tt.included {
+				t.Fatalf("expected endpoint included: %v, got endpoints %v", tt.included, adscConn.EndpointsJSON())
+			}
+
+			s.Discovery.MemRegistry.SetEndpointHealth("healthgate.com", "10.0.0.1", model.Healthy)
+			if _, err := adscConn.Wait(5*time.Second, v3.EndpointType); err != nil {
+				t.Fatal(err)
+			}
+			testEndpoints("10.0.0.1", cluster, adscConn, t)
+		})
+	}
+}
+
 func fullPush(s *xds.FakeDiscoveryServer) {
 	s.Discovery.Push(&model.PushRequest{Full: true})
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/golang/protobuf/ptypes/wrappers"
 
 	networkingapi "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/features"
 	"istio.io/istio/pilot/pkg/model"
 	"istio.io/istio/pilot/pkg/networking"
 	"istio.io/istio/pilot/pkg/networking/util"
@@ -259,6 +260,9 @@ func (b *EndpointBuilder) buildLocalityLbEndpointsFromShards(
 			if !epLabels.HasSubsetOf(ep.Labels) {
 				continue
 			}
+			if !healthGatePermits(ep) {
+				continue
+			}
 
 			locLbEps, found := localityEpMap[ep.Locality.Label]
 			if !found {
@@ -298,6 +302,22 @@ func (b *EndpointBuilder) buildLocalityLbEndpointsFromShards(
 	return locEps
 }
 
+// healthGatePermits returns whether the endpoint may be sent in EDS based on its health status.
+// When health gating is disabled, all endpoints are permitted.
+func healthGatePermits(ep *model.IstioEndpoint) bool {
+	if !features.EnableEDSHealthGating {
+		return true
+	}
+	switch ep.HealthStatus {
+	case model.Healthy:
+		return true
+	case model.UnknownHealth:
+		return features.EDSHealthGatingFailOpen
+	default:
+		return false
+	}
+}
+
 // TODO(lambdai): Handle ApplyTunnel error return value by filter out the failed endpoint.
 func (b *EndpointBuilder) ApplyTunnelSetting(llbOpts []*LocLbEndpointsAndOptions, tunnelType networking.TunnelType) []*LocLbEndpointsAndOptions {
 	for _, llb := range llbOpts {
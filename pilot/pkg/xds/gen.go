@@ -115,17 +115,17 @@ func (s *DiscoveryServer) pushXds(con *Connection, push *model.PushContext,
 		TypeUrl:     w.TypeUrl,
 		VersionInfo: currentVersion,
 		Nonce:       nonce(push.Version),
-		Resources:   cl,
+		Resources:   model.ResourcesToAny(cl),
 	}
 
 	// Approximate size by looking at the Any marshaled size. This avoids high cost
 	// proto.Size, at the expense of slightly under counting.
 	size := 0
 	for _, r := range cl {
-		size += len(r.Value)
+		size += len(r.Resource.Value)
 	}
 
-	err := con.send(resp)
+	err := con.send(resp, cl)
 	if err != nil {
 		recordSendError(w.TypeUrl, con.ConID, err)
 		return err
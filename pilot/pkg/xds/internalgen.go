@@ -168,12 +168,12 @@ func (sg *InternalGen) startPush(typeURL string, data []proto.Message) {
 //
 // We can also expose ACKS.
 func (sg *InternalGen) Generate(proxy *model.Proxy, push *model.PushContext, w *model.WatchedResource, req *model.PushRequest) model.Resources {
-	res := []*any.Any{}
+	res := model.Resources{}
 
 	switch w.TypeUrl {
 	case TypeURLConnections:
 		for _, v := range sg.Server.Clients() {
-			res = append(res, util.MessageToAny(v.node))
+			res = append(res, &discovery.Resource{Name: v.ConID, Resource: util.MessageToAny(v.node)})
 		}
 	case TypeDebugSyncronization:
 		res = sg.debugSyncz()
@@ -201,8 +201,8 @@ func isProxy(con *Connection) bool {
 		con.proxy.Metadata.ProxyConfig != nil
 }
 
-func (sg *InternalGen) debugSyncz() []*any.Any {
-	res := []*any.Any{}
+func (sg *InternalGen) debugSyncz() model.Resources {
+	res := model.Resources{}
 
 	stypes := []string{
 		v3.ListenerType,
@@ -241,7 +241,7 @@ func (sg *InternalGen) debugSyncz() []*any.Any {
 				},
 				XdsConfig: xdsConfigs,
 			}
-			res = append(res, util.MessageToAny(clientConfig))
+			res = append(res, &discovery.Resource{Name: con.ConID, Resource: util.MessageToAny(clientConfig)})
 		}
 		con.proxy.RUnlock()
 	}
@@ -259,7 +259,8 @@ func debugSyncStatus(wr *model.WatchedResource) status.ConfigStatus {
 	return status.ConfigStatus_STALE
 }
 
-func (sg *InternalGen) debugConfigDump(proxyID string) ([]*any.Any, error) {
+// debugConfigDump returns the config dump of the proxy, with one resource per section named by its type.
+func (sg *InternalGen) debugConfigDump(proxyID string) (model.Resources, error) {
 	conn := sg.Server.getProxyConnection(proxyID)
 	if conn == nil {
 		// This is "like" a 404.  The error is the client's.  However, this endpoint
@@ -272,5 +273,9 @@ func (sg *InternalGen) debugConfigDump(proxyID string) ([]*any.Any, error) {
 		return nil, err
 	}
 
-	return dump.Configs, nil
+	res := make(model.Resources, 0, len(dump.Configs))
+	for _, c := range dump.Configs {
+		res = append(res, &discovery.Resource{Name: c.TypeUrl, Resource: c})
+	}
+	return res, nil
 }
@@ -15,6 +15,9 @@
 package xds
 
 import (
+	listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+
 	"istio.io/istio/pilot/pkg/model"
 	"istio.io/istio/pilot/pkg/networking/util"
 	"istio.io/istio/pkg/config"
@@ -59,9 +62,29 @@ func (l LdsGenerator) Generate(proxy *model.Proxy, push *model.PushContext, w *m
 		return nil
 	}
 	listeners := l.Server.ConfigGenerator.BuildListeners(proxy, push)
+	if w != nil && len(w.ResourceNames) > 0 {
+		listeners = requestedListeners(listeners, w)
+	}
 	resources := model.Resources{}
 	for _, c := range listeners {
-		resources = append(resources, util.MessageToAny(c))
+		resources = append(resources, &discovery.Resource{Name: c.Name, Resource: util.MessageToAny(c)})
 	}
 	return resources
 }
+
+// requestedListeners keeps only the listeners named in the request. Like RDS, this allows proxies
+// doing their own on-demand listener discovery to fetch specific listeners; a request without names
+// still receives all listeners.
+func requestedListeners(listeners []*listener.Listener, w *model.WatchedResource) []*listener.Listener {
+	requested := make(map[string]struct{}, len(w.ResourceNames))
+	for _, n := range w.ResourceNames {
+		requested[n] = struct{}{}
+	}
+	out := make([]*listener.Listener, 0, len(requested))
+	for _, l := range listeners {
+		if _, f := requested[l.Name]; f {
+			out = append(out, l)
+		}
+	}
+	return out
+}
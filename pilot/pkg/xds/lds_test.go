@@ -253,6 +253,16 @@ func TestLDS(t *testing.T) {
 		ads.RequestResponseAck(nil)
 	})
 
+	t.Run("by name", func(t *testing.T) {
+		s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{})
+		ads := s.ConnectADS().WithType(v3.ListenerType)
+		res := ads.RequestResponseAck(&discovery.DiscoveryRequest{ResourceNames: []string{"virtualOutbound"}})
+		names := xdstest.ExtractListenerNames(xdstest.UnmarshalListeners(t, res.Resources))
+		if len(names) != 1 || names[0] != "virtualOutbound" {
+			t.Fatalf("expected only the requested listener, got %v", names)
+		}
+	})
+
 	// 'router' or 'gateway' type of listener
 	t.Run("gateway", func(t *testing.T) {
 		s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{ConfigString: mustReadfolder(t, "tests/testdata/config")})
@@ -106,6 +106,12 @@ var (
 		"Pilot XDS response write timeouts.",
 	)
 
+	xdsDroppedPushes = monitoring.NewSum(
+		"pilot_xds_dropped_pushes",
+		"Total number of XDS pushes dropped because sending them failed.",
+		monitoring.WithLabels(typeTag),
+	)
+
 	// Covers xds_builderr and xds_senderr for xds in {lds, rds, cds, eds}.
 	pushes = monitoring.NewSum(
 		"pilot_xds_pushes",
@@ -241,6 +247,7 @@ func init() {
 		monServices,
 		xdsClients,
 		xdsResponseWriteTimeouts,
+		xdsDroppedPushes,
 		pushes,
 		pushTime,
 		proxiesConvergeDelay,
@@ -15,8 +15,12 @@
 package xds
 
 import (
+	"container/heap"
 	"sync"
+	"sync/atomic"
+	"time"
 
+	"istio.io/istio/pilot/pkg/features"
 	"istio.io/istio/pilot/pkg/model"
 )
 
@@ -28,21 +32,45 @@ type PushQueue struct {
 	pending map[*Connection]*model.PushRequest
 
 	// queue maintains ordering of the queue
-	queue []*Connection
+	queue *connQueue
+
+	// gatewayQueue maintains ordering of the queued gateway connections. Gateways are latency
+	// sensitive, so they are dequeued before the connections in queue, up to GatewayBurst in a row.
+	gatewayQueue *connQueue
+
+	// GatewayBurst is the number of gateway connections dequeued in a row while connections are waiting
+	// in queue. Values below one are treated as one.
+	GatewayBurst int
+
+	// gatewayRun is the number of gateway connections dequeued since the last connection from queue.
+	gatewayRun int
 
 	// processing stores all connections that have been Dequeue(), but not MarkDone().
 	// The value stored will be initially be nil, but may be populated if the connection is Enqueue().
 	// If model.PushRequest is not nil, it will be Enqueued again once MarkDone has been called.
 	processing map[*Connection]*model.PushRequest
 
+	// SheddingThreshold is the number of queued connections above which the queue sheds load: rather
+	// than in order, connections of each priority are dequeued starting with the one pushed least recently. Since a
+	// dequeued connection becomes the most recently pushed one, every queued connection is eventually
+	// served. Zero disables load shedding.
+	SheddingThreshold int
+
+	// shedding is set if the last Dequeue() was done while shedding load.
+	shedding bool
+
 	shuttingDown bool
 }
 
 func NewPushQueue() *PushQueue {
 	return &PushQueue{
-		pending:    make(map[*Connection]*model.PushRequest),
-		processing: make(map[*Connection]*model.PushRequest),
-		cond:       sync.NewCond(&sync.Mutex{}),
+		pending:           make(map[*Connection]*model.PushRequest),
+		processing:        make(map[*Connection]*model.PushRequest),
+		queue:             newConnQueue(),
+		gatewayQueue:      newConnQueue(),
+		cond:              sync.NewCond(&sync.Mutex{}),
+		SheddingThreshold: features.PushSheddingThreshold,
+		GatewayBurst:      features.PushGatewayBurst,
 	}
 }
 
@@ -68,7 +96,7 @@ func (p *PushQueue) Enqueue(con *Connection, pushRequest *model.PushRequest) {
 	}
 
 	p.pending[con] = pushRequest
-	p.queue = append(p.queue, con)
+	p.add(con)
 	// Signal waiters on Dequeue that a new item is available
 	p.cond.Signal()
 }
@@ -79,16 +107,28 @@ func (p *PushQueue) Dequeue() (con *Connection, request *model.PushRequest, shut
 	defer p.cond.L.Unlock()
 
 	// Block until there is one to remove. Enqueue will signal when one is added.
-	for len(p.queue) == 0 && !p.shuttingDown {
+	for p.len() == 0 && !p.shuttingDown {
 		p.cond.Wait()
 	}
 
-	if len(p.queue) == 0 {
+	if p.len() == 0 {
 		// We must be shutting down.
 		return nil, nil, true
 	}
 
-	con, p.queue = p.queue[0], p.queue[1:]
+	p.shedding = p.SheddingThreshold > 0 && p.len() > p.SheddingThreshold
+	burst := p.GatewayBurst
+	if burst < 1 {
+		burst = 1
+	}
+	if p.gatewayQueue.len() > 0 && (p.queue.len() == 0 || p.gatewayRun < burst) {
+		con = p.gatewayQueue.take(p.shedding)
+		p.gatewayRun++
+	} else {
+		con = p.queue.take(p.shedding)
+		p.gatewayRun = 0
+	}
+	atomic.StoreInt64(&con.lastDequeued, time.Now().UnixNano())
 
 	request = p.pending[con]
 	delete(p.pending, con)
@@ -99,6 +139,126 @@ func (p *PushQueue) Dequeue() (con *Connection, request *model.PushRequest, shut
 	return con, request, false
 }
 
+// queuedConnection is an entry of a connQueue.
+type queuedConnection struct {
+	con *Connection
+	// lastDequeued is the lastDequeued of the connection when it was queued. It does not change while the
+	// connection is queued, as it is only updated when the connection is dequeued.
+	lastDequeued int64
+	// seq orders entries by the time they were queued.
+	seq uint64
+}
+
+// connHeap orders queued connections starting with the one pushed least recently.
+type connHeap []*queuedConnection
+
+func (h connHeap) Len() int { return len(h) }
+
+func (h connHeap) Less(i, j int) bool {
+	if h[i].lastDequeued != h[j].lastDequeued {
+		return h[i].lastDequeued < h[j].lastDequeued
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h connHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *connHeap) Push(x interface{}) { *h = append(*h, x.(*queuedConnection)) }
+
+func (h *connHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	e := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return e
+}
+
+// connQueue is a queue of connections which can be taken either in order, or starting with the one pushed
+// least recently, both in amortized logarithmic time. An entry taken one way is left in place in the other
+// and skipped when reached; stale entries are dropped once they outnumber the queued connections.
+type connQueue struct {
+	fifo           []*queuedConnection
+	byLastDequeued connHeap
+	// queued maps each queued connection to its current entry.
+	queued map[*Connection]*queuedConnection
+	seq    uint64
+}
+
+func newConnQueue() *connQueue {
+	return &connQueue{queued: map[*Connection]*queuedConnection{}}
+}
+
+func (q *connQueue) len() int {
+	return len(q.queued)
+}
+
+// push adds a connection to the end of the queue.
+func (q *connQueue) push(con *Connection) {
+	e := &queuedConnection{con: con, lastDequeued: atomic.LoadInt64(&con.lastDequeued), seq: q.seq}
+	q.seq++
+	q.queued[con] = e
+	q.fifo = append(q.fifo, e)
+	heap.Push(&q.byLastDequeued, e)
+}
+
+// take removes the next connection from the queue. This is the first one, or the least recently pushed
+// one when shedding load. The queue must not be empty.
+func (q *connQueue) take(shedding bool) *Connection {
+	var e *queuedConnection
+	for {
+		if shedding {
+			e = heap.Pop(&q.byLastDequeued).(*queuedConnection)
+		} else {
+			e = q.fifo[0]
+			q.fifo[0] = nil
+			q.fifo = q.fifo[1:]
+		}
+		if q.queued[e.con] == e {
+			break
+		}
+	}
+	delete(q.queued, e.con)
+	q.compact()
+	return e.con
+}
+
+// compact drops the stale entries once they outnumber the queued connections.
+func (q *connQueue) compact() {
+	if len(q.fifo) > 2*len(q.queued) {
+		fifo := make([]*queuedConnection, 0, len(q.queued))
+		for _, e := range q.fifo {
+			if q.queued[e.con] == e {
+				fifo = append(fifo, e)
+			}
+		}
+		q.fifo = fifo
+	}
+	if len(q.byLastDequeued) > 2*len(q.queued) {
+		h := make(connHeap, 0, len(q.queued))
+		for _, e := range q.byLastDequeued {
+			if q.queued[e.con] == e {
+				h = append(h, e)
+			}
+		}
+		heap.Init(&h)
+		q.byLastDequeued = h
+	}
+}
+
+// add appends a connection to the queue of its priority.
+func (p *PushQueue) add(con *Connection) {
+	if con.proxy != nil && con.proxy.Type == model.Router {
+		p.gatewayQueue.push(con)
+	} else {
+		p.queue.push(con)
+	}
+}
+
+func (p *PushQueue) len() int {
+	return p.gatewayQueue.len() + p.queue.len()
+}
+
 func (p *PushQueue) MarkDone(con *Connection) {
 	p.cond.L.Lock()
 	defer p.cond.L.Unlock()
@@ -109,7 +269,7 @@ func (p *PushQueue) MarkDone(con *Connection) {
 	// This means we need to add it back to the queue.
 	if request != nil {
 		p.pending[con] = request
-		p.queue = append(p.queue, con)
+		p.add(con)
 		p.cond.Signal()
 	}
 }
@@ -118,7 +278,14 @@ func (p *PushQueue) MarkDone(con *Connection) {
 func (p *PushQueue) Pending() int {
 	p.cond.L.Lock()
 	defer p.cond.L.Unlock()
-	return len(p.queue)
+	return p.len()
+}
+
+// Shedding reports whether the queue is currently shedding load.
+func (p *PushQueue) Shedding() bool {
+	p.cond.L.Lock()
+	defer p.cond.L.Unlock()
+	return p.shedding
 }
 
 // ShutDown will cause queue to ignore all new items added to it. As soon as the
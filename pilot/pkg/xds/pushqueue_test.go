@@ -291,3 +291,149 @@ func TestProxyQueue(t *testing.T) {
 		}
 	})
 }
+
+func TestProxyQueueLoadShedding(t *testing.T) {
+	now := time.Now()
+	proxies := make([]*Connection, 0, 5)
+	// proxy-2 was never pushed, the others were last pushed the given time ago.
+	for p, ago := range []time.Duration{time.Second, 5 * time.Second, 0, 3 * time.Second, 2 * time.Second} {
+		con := &Connection{ConID: fmt.Sprintf("proxy-%d", p)}
+		if ago != 0 {
+			con.lastDequeued = now.Add(-ago).UnixNano()
+		}
+		proxies = append(proxies, con)
+	}
+
+	p := NewPushQueue()
+	defer p.ShutDown()
+	p.SheddingThreshold = 2
+	for _, con := range proxies {
+		p.Enqueue(con, &model.PushRequest{})
+	}
+
+	// Under overload the most stale connections are served first.
+	ExpectDequeue(t, p, proxies[2])
+	if !p.Shedding() {
+		t.Fatalf("expected the queue to shed load with %d pending pushes", p.Pending()+1)
+	}
+	// A connection that was just served goes behind all the others.
+	p.MarkDone(proxies[2])
+	p.Enqueue(proxies[2], &model.PushRequest{})
+	ExpectDequeue(t, p, proxies[1])
+	ExpectDequeue(t, p, proxies[3])
+	ExpectDequeue(t, p, proxies[4])
+
+	// Once the queue is below the threshold, connections are served in order.
+	ExpectDequeue(t, p, proxies[0])
+	if p.Shedding() {
+		t.Fatalf("expected the queue to stop shedding load")
+	}
+	ExpectDequeue(t, p, proxies[2])
+	ExpectTimeout(t, p)
+}
+
+func TestConnQueueMixedTakes(t *testing.T) {
+	q := newConnQueue()
+	cons := make([]*Connection, 0, 100)
+	for i := 0; i < 100; i++ {
+		con := &Connection{ConID: fmt.Sprintf("proxy-%d", i), lastDequeued: int64(100 - i)}
+		cons = append(cons, con)
+		q.push(con)
+	}
+
+	// Alternating between in order and least recently pushed takes serves every connection exactly once.
+	taken := map[*Connection]struct{}{}
+	for i := 0; q.len() > 0; i++ {
+		con := q.take(i%2 == 0)
+		if _, f := taken[con]; f {
+			t.Fatalf("%s taken twice", con.ConID)
+		}
+		taken[con] = struct{}{}
+		if len(q.fifo) > 2*q.len() || len(q.byLastDequeued) > 2*q.len() {
+			t.Fatalf("stale entries were not dropped: %d queued, %d in order, %d by last push",
+				q.len(), len(q.fifo), len(q.byLastDequeued))
+		}
+	}
+	if len(taken) != len(cons) {
+		t.Fatalf("expected %d connections, got %d", len(cons), len(taken))
+	}
+}
+
+func TestProxyQueueGatewayPriority(t *testing.T) {
+	sidecars := make([]*Connection, 0, 3)
+	for i := 0; i < 3; i++ {
+		sidecars = append(sidecars, &Connection{
+			ConID: fmt.Sprintf("sidecar-%d", i),
+			proxy: &model.Proxy{Type: model.SidecarProxy},
+		})
+	}
+	gateways := make([]*Connection, 0, 2)
+	for i := 0; i < 2; i++ {
+		gateways = append(gateways, &Connection{
+			ConID: fmt.Sprintf("gateway-%d", i),
+			proxy: &model.Proxy{Type: model.Router},
+		})
+	}
+
+	p := NewPushQueue()
+	defer p.ShutDown()
+	p.Enqueue(sidecars[0], &model.PushRequest{})
+	p.Enqueue(sidecars[1], &model.PushRequest{})
+	p.Enqueue(gateways[0], &model.PushRequest{})
+	p.Enqueue(sidecars[2], &model.PushRequest{})
+	p.Enqueue(gateways[1], &model.PushRequest{})
+	if p.Pending() != 5 {
+		t.Fatalf("expected 5 pending pushes, got %d", p.Pending())
+	}
+
+	// Gateways are served first, then sidecars in the order they were queued.
+	ExpectDequeue(t, p, gateways[0])
+	ExpectDequeue(t, p, gateways[1])
+	ExpectDequeue(t, p, sidecars[0])
+
+	// A gateway queued during the push jumps ahead of the remaining sidecars.
+	p.MarkDone(gateways[0])
+	p.Enqueue(gateways[0], &model.PushRequest{})
+	ExpectDequeue(t, p, gateways[0])
+	ExpectDequeue(t, p, sidecars[1])
+	ExpectDequeue(t, p, sidecars[2])
+	ExpectTimeout(t, p)
+}
+
+func TestProxyQueueGatewayBurst(t *testing.T) {
+	sidecars := make([]*Connection, 0, 2)
+	for i := 0; i < 2; i++ {
+		sidecars = append(sidecars, &Connection{
+			ConID: fmt.Sprintf("sidecar-%d", i),
+			proxy: &model.Proxy{Type: model.SidecarProxy},
+		})
+	}
+	gateways := make([]*Connection, 0, 5)
+	for i := 0; i < 5; i++ {
+		gateways = append(gateways, &Connection{
+			ConID: fmt.Sprintf("gateway-%d", i),
+			proxy: &model.Proxy{Type: model.Router},
+		})
+	}
+
+	p := NewPushQueue()
+	defer p.ShutDown()
+	p.GatewayBurst = 2
+	for _, con := range sidecars {
+		p.Enqueue(con, &model.PushRequest{})
+	}
+	for _, con := range gateways {
+		p.Enqueue(con, &model.PushRequest{})
+	}
+
+	// At most two gateways are served before each waiting sidecar.
+	ExpectDequeue(t, p, gateways[0])
+	ExpectDequeue(t, p, gateways[1])
+	ExpectDequeue(t, p, sidecars[0])
+	ExpectDequeue(t, p, gateways[2])
+	ExpectDequeue(t, p, gateways[3])
+	ExpectDequeue(t, p, sidecars[1])
+	// Without waiting sidecars, gateways are served regardless of the burst.
+	ExpectDequeue(t, p, gateways[4])
+	ExpectTimeout(t, p)
+}
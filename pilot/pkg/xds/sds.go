@@ -20,6 +20,7 @@ import (
 
 	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	"github.com/golang/protobuf/ptypes/any"
 
 	"istio.io/istio/pilot/pkg/model"
@@ -136,7 +137,7 @@ func (s *SecretGen) Generate(proxy *model.Proxy, _ *model.PushContext, w *model.
 		}
 		if cached, f := s.cache.Get(sr); f {
 			// If it is in the Cache, add it and continue
-			results = append(results, cached)
+			results = append(results, &discovery.Resource{Name: sr.ResourceName, Resource: cached})
 			continue
 		}
 
@@ -145,7 +146,7 @@ func (s *SecretGen) Generate(proxy *model.Proxy, _ *model.PushContext, w *model.
 			secret := secrets.GetCaCert(sr.Name, sr.Namespace)
 			if secret != nil {
 				res := toEnvoyCaSecret(sr.ResourceName, secret)
-				results = append(results, res)
+				results = append(results, &discovery.Resource{Name: sr.ResourceName, Resource: res})
 				s.cache.Add(sr, res)
 			} else {
 				adsLog.Warnf("failed to fetch ca certificate for %v", sr.ResourceName)
@@ -154,7 +155,7 @@ func (s *SecretGen) Generate(proxy *model.Proxy, _ *model.PushContext, w *model.
 			key, cert := secrets.GetKeyAndCert(sr.Name, sr.Namespace)
 			if key != nil && cert != nil {
 				res := toEnvoyKeyCertSecret(sr.ResourceName, key, cert)
-				results = append(results, res)
+				results = append(results, &discovery.Resource{Name: sr.ResourceName, Resource: res})
 				s.cache.Add(sr, res)
 			} else {
 				adsLog.Warnf("failed to fetch key and certificate for %v", sr.ResourceName)
@@ -323,8 +323,8 @@ func TestGenerate(t *testing.T) {
 
 			gen := s.Discovery.Generators[v3.SecretType]
 
-			raw := xdstest.ExtractTLSSecrets(t, gen.Generate(s.SetupProxy(tt.proxy), s.PushContext(),
-				&model.WatchedResource{ResourceNames: tt.resources}, tt.request))
+			raw := xdstest.ExtractTLSSecrets(t, model.ResourcesToAny(gen.Generate(s.SetupProxy(tt.proxy), s.PushContext(),
+				&model.WatchedResource{ResourceNames: tt.resources}, tt.request)))
 
 			got := map[string]Expected{}
 			for _, scrt := range raw {
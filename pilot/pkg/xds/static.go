@@ -0,0 +1,85 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds
+
+import (
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strings"
+
+	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/golang/protobuf/proto"
+	"sigs.k8s.io/yaml"
+
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pilot/pkg/networking/util"
+	"istio.io/istio/pkg/util/protomarshal"
+)
+
+// StaticGenerator serves the same pre-baked resources to every proxy instead of generating them,
+// for testing and bootstrapping.
+type StaticGenerator struct {
+	Resources model.Resources
+}
+
+var _ model.XdsResourceGenerator = &StaticGenerator{}
+
+// NewStaticGenerator loads resources of the given type URL from a YAML file, with one resource per
+// document.
+func NewStaticGenerator(typeURL string, file string) (*StaticGenerator, error) {
+	messageType := proto.MessageType(strings.TrimPrefix(typeURL, "type.googleapis.com/"))
+	if messageType == nil {
+		return nil, fmt.Errorf("unknown resource type %s", typeURL)
+	}
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := model.Resources{}
+	for i, doc := range strings.Split(string(b), "\n---") {
+		if strings.TrimSpace(doc) == "" {
+			continue
+		}
+		js, err := yaml.YAMLToJSON([]byte(doc))
+		if err != nil {
+			return nil, fmt.Errorf("%s: document %d: %v", file, i, err)
+		}
+		msg := reflect.New(messageType.Elem()).Interface().(proto.Message)
+		if err := protomarshal.ApplyJSONStrict(string(js), msg); err != nil {
+			return nil, fmt.Errorf("%s: document %d is not a valid %s: %v", file, i, typeURL, err)
+		}
+		resources = append(resources, &discovery.Resource{Name: staticResourceName(msg), Resource: util.MessageToAny(msg)})
+	}
+	return &StaticGenerator{Resources: resources}, nil
+}
+
+// staticResourceName returns the name of a resource as referenced by clients, or an empty string if
+// the resource has no name.
+func staticResourceName(msg proto.Message) string {
+	switch m := msg.(type) {
+	case interface{ GetName() string }:
+		return m.GetName()
+	case interface{ GetClusterName() string }:
+		return m.GetClusterName()
+	}
+	return ""
+}
+
+// Generate returns the static resources, regardless of the proxy and of what changed.
+func (g *StaticGenerator) Generate(_ *model.Proxy, _ *model.PushContext, _ *model.WatchedResource, _ *model.PushRequest) model.Resources {
+	return g.Resources
+}
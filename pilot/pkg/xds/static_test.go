@@ -0,0 +1,90 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/duration"
+
+	"istio.io/istio/pilot/pkg/xds"
+	v3 "istio.io/istio/pilot/pkg/xds/v3"
+)
+
+const staticClusters = `
+name: static-a
+type: STRICT_DNS
+connectTimeout: 3s
+---
+name: static-b
+type: EDS
+`
+
+func TestStaticResources(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "clusters.yaml")
+	if err := ioutil.WriteFile(file, []byte(staticClusters), 0644); err != nil {
+		t.Fatal(err)
+	}
+	gen, err := xds.NewStaticGenerator(v3.ClusterType, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{})
+	s.Discovery.Generators[v3.ClusterType] = gen
+	res := s.ConnectADS().WithType(v3.ClusterType).RequestResponseAck(nil)
+
+	expected := []*cluster.Cluster{
+		{
+			Name:                 "static-a",
+			ClusterDiscoveryType: &cluster.Cluster_Type{Type: cluster.Cluster_STRICT_DNS},
+			ConnectTimeout:       &duration.Duration{Seconds: 3},
+		},
+		{
+			Name:                 "static-b",
+			ClusterDiscoveryType: &cluster.Cluster_Type{Type: cluster.Cluster_EDS},
+		},
+	}
+	if len(res.Resources) != len(expected) {
+		t.Fatalf("expected %d clusters, got %d", len(expected), len(res.Resources))
+	}
+	for i, r := range res.Resources {
+		got := &cluster.Cluster{}
+		if err := ptypes.UnmarshalAny(r, got); err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(got, expected[i]) {
+			t.Fatalf("expected cluster %v to be served verbatim, got %v", expected[i], got)
+		}
+	}
+}
+
+func TestStaticResourcesInvalid(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "clusters.yaml")
+	if err := ioutil.WriteFile(file, []byte("name: a\nunknownField: b\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := xds.NewStaticGenerator(v3.ClusterType, file); err == nil {
+		t.Fatal("expected an unknown field to be rejected")
+	}
+	if _, err := xds.NewStaticGenerator("type.googleapis.com/not.a.Type", file); err == nil {
+		t.Fatal("expected an unknown type to be rejected")
+	}
+}
@@ -26,6 +26,8 @@ const (
 	SecretType     = resource.SecretType
 	NameTableType  = "type.googleapis.com/istio.networking.nds.v1.NameTable"
 	HealthInfoType = "type.googleapis.com/istio.v1.HealthInformation"
+	// ExtensionConfigurationType is the type URL of resources served over ECDS
+	ExtensionConfigurationType = "type.googleapis.com/envoy.config.core.v3.TypedExtensionConfig"
 )
 
 // GetShortType returns an abbreviated form of a type, useful for logging or human friendly messages
@@ -43,6 +45,8 @@ func GetShortType(typeURL string) string {
 		return "SDS"
 	case NameTableType:
 		return "NDS"
+	case ExtensionConfigurationType:
+		return "ECDS"
 	default:
 		return typeURL
 	}
@@ -63,6 +67,8 @@ func GetMetricType(typeURL string) string {
 		return "sds"
 	case NameTableType:
 		return "nds"
+	case ExtensionConfigurationType:
+		return "ecds"
 	default:
 		return typeURL
 	}
@@ -383,6 +383,76 @@ spec:
 	if !found {
 		t.Fatalf("failed to find expected fallthrough route")
 	}
+
+	// foo.bar is not backed by any service, which should be reported
+	if _, f := s.PushContext().ProxyStatus[model.EgressProxyNotFound.Name()]["foo.bar"]; !f {
+		t.Fatalf("expected unknown egress proxy to be reported in push status")
+	}
+}
+
+func TestEgressProxyServiceEntry(t *testing.T) {
+	s := NewFakeDiscoveryServer(t, FakeOptions{
+		ConfigString: `
+apiVersion: networking.istio.io/v1alpha3
+kind: ServiceEntry
+metadata:
+  name: egress
+  namespace: egress
+spec:
+  hosts:
+  - egress.example.com
+  ports:
+  - number: 3128
+    name: tcp
+    protocol: TCP
+  resolution: STATIC
+  location: MESH_EXTERNAL
+  endpoints:
+  - address: 10.10.10.30
+---
+apiVersion: networking.istio.io/v1alpha3
+kind: Sidecar
+metadata:
+  name: sidecar-with-egressproxy
+  namespace: app
+spec:
+  outboundTrafficPolicy:
+    mode: ALLOW_ANY
+    egressProxy:
+      host: egress.example.com
+      port:
+        number: 3128
+  egress:
+  - hosts:
+    - "*/*"
+`,
+	})
+	proxy := s.SetupProxy(&model.Proxy{
+		ConfigNamespace: "app",
+	})
+
+	expectedEgressCluster := "outbound|3128||egress.example.com"
+	if xdstest.ExtractCluster(expectedEgressCluster, s.Clusters(proxy)) == nil {
+		t.Fatalf("egress proxy cluster %v was not generated", expectedEgressCluster)
+	}
+
+	found := false
+	for _, f := range xdstest.ExtractListener("virtualOutbound", s.Listeners(proxy)).FilterChains {
+		if f.FilterChainMatch != nil {
+			continue
+		}
+		if got := xdstest.ExtractTCPProxy(t, f).GetCluster(); got != expectedEgressCluster {
+			t.Fatalf("got unexpected fallback destination: %v, want %v", got, expectedEgressCluster)
+		}
+		found = true
+	}
+	if !found {
+		t.Fatalf("failed to find tcp proxy")
+	}
+
+	if _, f := s.PushContext().ProxyStatus[model.EgressProxyNotFound.Name()]["egress.example.com"]; f {
+		t.Fatalf("egress proxy backed by a service should not be reported")
+	}
 }
 
 func assertListEqual(t test.Failer, a, b []string) {
@@ -208,6 +208,18 @@ func UnmarshalRouteConfiguration(t test.Failer, resp []*any.Any) []*route.RouteC
 	return un
 }
 
+func UnmarshalListeners(t test.Failer, resp []*any.Any) []*listener.Listener {
+	un := make([]*listener.Listener, 0, len(resp))
+	for _, r := range resp {
+		u := &listener.Listener{}
+		if err := ptypes.UnmarshalAny(r, u); err != nil {
+			t.Fatal(err)
+		}
+		un = append(un, u)
+	}
+	return un
+}
+
 func UnmarshalClusterLoadAssignment(t test.Failer, resp []*any.Any) []*endpoint.ClusterLoadAssignment {
 	un := make([]*endpoint.ClusterLoadAssignment, 0, len(resp))
 	for _, r := range resp {
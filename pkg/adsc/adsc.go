@@ -196,6 +196,21 @@ type ADSC struct {
 	sync     map[string]time.Time
 	syncCh   chan string
 	Locality *core.Locality
+
+	// metrics counts the responses received, protected by mutex.
+	metrics PushMetrics
+}
+
+// PushMetrics counts the responses pushed by the server to an ADSC client.
+type PushMetrics struct {
+	// Responses is the total number of responses received.
+	Responses int
+	// Bytes is the total size of the responses received.
+	Bytes int
+	// ResponsesByType is the number of responses received, keyed by type URL.
+	ResponsesByType map[string]int
+	// ResourcesByType is the number of resources received, keyed by type URL.
+	ResourcesByType map[string]int
 }
 
 type ResponseHandler interface {
@@ -437,6 +452,8 @@ func (a *ADSC) handleRecv() {
 			return
 		}
 
+		a.recordPush(msg)
+
 		// Group-value-kind - used for high level api generator.
 		gvk := strings.SplitN(msg.TypeUrl, "/", 3)
 
@@ -536,6 +553,46 @@ func (a *ADSC) handleRecv() {
 	}
 }
 
+func (a *ADSC) recordPush(msg *discovery.DiscoveryResponse) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.metrics.ResponsesByType == nil {
+		a.metrics.ResponsesByType = map[string]int{}
+		a.metrics.ResourcesByType = map[string]int{}
+	}
+	a.metrics.Responses++
+	a.metrics.Bytes += proto.Size(msg)
+	a.metrics.ResponsesByType[msg.TypeUrl]++
+	a.metrics.ResourcesByType[msg.TypeUrl] += len(msg.Resources)
+}
+
+// Metrics returns a copy of the counters of the responses received since the client was created or the
+// counters were last reset.
+func (a *ADSC) Metrics() PushMetrics {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	out := PushMetrics{
+		Responses:       a.metrics.Responses,
+		Bytes:           a.metrics.Bytes,
+		ResponsesByType: make(map[string]int, len(a.metrics.ResponsesByType)),
+		ResourcesByType: make(map[string]int, len(a.metrics.ResourcesByType)),
+	}
+	for k, v := range a.metrics.ResponsesByType {
+		out.ResponsesByType[k] = v
+	}
+	for k, v := range a.metrics.ResourcesByType {
+		out.ResourcesByType[k] = v
+	}
+	return out
+}
+
+// ResetMetrics resets the counters of the responses received.
+func (a *ADSC) ResetMetrics() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.metrics = PushMetrics{}
+}
+
 func mcpToPilot(m *mcp.Resource) (*config.Config, error) {
 	if m == nil || m.Metadata == nil {
 		return &config.Config{}, nil
@@ -29,6 +29,7 @@ import (
 	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	xdsapi "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/grpc"
@@ -152,6 +153,74 @@ func TestADSC_Run(t *testing.T) {
 	}
 }
 
+func TestADSC_Metrics(t *testing.T) {
+	responses := []*xdsapi.DiscoveryResponse{
+		{
+			TypeUrl:   "foo",
+			Resources: []*any.Any{{TypeUrl: "foo", Value: []byte("a")}, {TypeUrl: "foo", Value: []byte("b")}},
+		},
+		{
+			TypeUrl:   "foo",
+			Resources: []*any.Any{{TypeUrl: "foo", Value: []byte("c")}},
+		},
+		{
+			TypeUrl: "bar",
+		},
+	}
+	expectedBytes := 0
+	for _, r := range responses {
+		expectedBytes += proto.Size(r)
+	}
+	StreamHandler = func(stream xdsapi.AggregatedDiscoveryService_StreamAggregatedResourcesServer) error {
+		for _, r := range responses {
+			_ = stream.Send(r)
+		}
+		return nil
+	}
+	l, err := net.Listen("tcp", "127.0.0.1:49134")
+	if err != nil {
+		t.Fatalf("Unable to listen with tcp err %v", err)
+	}
+	xds := grpc.NewServer()
+	xdsapi.RegisterAggregatedDiscoveryServiceServer(xds, new(testAdscRunServer))
+	go func() {
+		_ = xds.Serve(l)
+	}()
+	defer xds.GracefulStop()
+
+	adsc := &ADSC{
+		url:         "127.0.0.1:49134",
+		Received:    make(map[string]*xdsapi.DiscoveryResponse),
+		Updates:     make(chan string),
+		XDSUpdates:  make(chan *xdsapi.DiscoveryResponse),
+		RecvWg:      sync.WaitGroup{},
+		cfg:         &Config{},
+		VersionInfo: map[string]string{},
+	}
+	if err := adsc.Dial(); err != nil {
+		t.Fatalf("Dial error: %v", err)
+	}
+	if err := adsc.Run(); err != nil {
+		t.Fatalf("ADSC: failed running %v", err)
+	}
+	adsc.RecvWg.Wait()
+
+	expected := PushMetrics{
+		Responses:       3,
+		Bytes:           expectedBytes,
+		ResponsesByType: map[string]int{"foo": 2, "bar": 1},
+		ResourcesByType: map[string]int{"foo": 3, "bar": 0},
+	}
+	if got := adsc.Metrics(); !cmp.Equal(got, expected) {
+		t.Errorf("unexpected metrics: %v", cmp.Diff(expected, got))
+	}
+
+	adsc.ResetMetrics()
+	if got := adsc.Metrics(); got.Responses != 0 || got.Bytes != 0 || len(got.ResponsesByType) != 0 || len(got.ResourcesByType) != 0 {
+		t.Errorf("expected metrics to be reset, got %+v", got)
+	}
+}
+
 func TestADSC_Save(t *testing.T) {
 	tests := []struct {
 		desc         string
@@ -565,6 +565,52 @@ func loadProxyConfig(base, out string, _ *testing.T) (*meshconfig.ProxyConfig, e
 	return cfg, nil
 }
 
+func TestRender(t *testing.T) {
+	proxyConfig, err := loadProxyConfig("default", os.TempDir(), t)
+	if err != nil {
+		t.Fatal(err)
+	}
+	node := "sidecar~1.2.3.4~foo.bar~bar.svc.cluster.local"
+	content, err := Render(Config{
+		Node:     node,
+		Proxy:    proxyConfig,
+		NodeIPs:  []string{"1.2.3.4"},
+		LocalEnv: []string{"ISTIO_META_CLUSTER_ID=Kubernetes"},
+	}, proxyConfig.CustomConfigFile)
+	if err != nil {
+		t.Fatalf("failed to render bootstrap: %v", err)
+	}
+
+	b, err := Validate(content)
+	if err != nil {
+		t.Fatalf("rendered bootstrap is invalid: %v", err)
+	}
+	if got := b.GetNode().GetId(); got != node {
+		t.Errorf("expected node id %q, got %q", node, got)
+	}
+	if got := b.GetNode().GetCluster(); got != "istio-proxy" {
+		t.Errorf("expected node cluster %q, got %q", "istio-proxy", got)
+	}
+	if b.GetDynamicResources().GetAdsConfig() == nil {
+		t.Errorf("expected ADS config to be set")
+	}
+	found := false
+	for _, c := range b.GetStaticResources().GetClusters() {
+		if c.Name == "xds-grpc" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected xds-grpc static cluster")
+	}
+}
+
+func TestValidateInvalidBootstrap(t *testing.T) {
+	if _, err := Validate([]byte(`{"node": {"id": "foo"}, "unknown": true}`)); err == nil {
+		t.Fatalf("expected unknown field to be rejected")
+	}
+}
+
 func TestIsIPv6Proxy(t *testing.T) {
 	tests := []struct {
 		name     string
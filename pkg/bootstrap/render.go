@@ -0,0 +1,60 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bootstrap
+
+import (
+	"bytes"
+	"fmt"
+
+	bootstrapv3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	"github.com/ghodss/yaml"
+	"github.com/golang/protobuf/jsonpb"
+
+	"istio.io/istio/pkg/bootstrap/platform"
+)
+
+// Render generates the Envoy bootstrap for the given config from templateFile, without
+// writing it to disk or contacting Pilot, and validates the result. If no platform is
+// configured, the platform is treated as unknown instead of probing metadata servers.
+func Render(cfg Config, templateFile string) ([]byte, error) {
+	if cfg.PlatEnv == nil {
+		cfg.PlatEnv = &platform.Unknown{}
+	}
+
+	var out bytes.Buffer
+	if err := New(cfg).WriteTo(templateFile, &out); err != nil {
+		return nil, err
+	}
+	if _, err := Validate(out.Bytes()); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// Validate parses a JSON or YAML Envoy bootstrap and runs the Envoy bootstrap validation on it.
+func Validate(content []byte) (*bootstrapv3.Bootstrap, error) {
+	js, err := yaml.YAMLToJSON(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert bootstrap to JSON: %v", err)
+	}
+	b := &bootstrapv3.Bootstrap{}
+	if err := jsonpb.Unmarshal(bytes.NewReader(js), b); err != nil {
+		return nil, fmt.Errorf("failed to parse bootstrap: %v", err)
+	}
+	if err := b.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid bootstrap: %v", err)
+	}
+	return b, nil
+}
@@ -2667,10 +2667,23 @@ func validateLocalities(localities []string) error {
 
 // ValidateMeshNetworks validates meshnetworks.
 func ValidateMeshNetworks(meshnetworks *meshconfig.MeshNetworks) (errs error) {
+	multiNetwork := false
+	for _, network := range meshnetworks.Networks {
+		if len(network.Gateways) > 0 {
+			multiNetwork = true
+			break
+		}
+	}
 	for name, network := range meshnetworks.Networks {
 		if err := validateNetwork(network); err != nil {
 			errs = multierror.Append(errs, multierror.Prefix(err, fmt.Sprintf("invalid network %v:", name)))
 		}
+		// Endpoints in a network without gateways can only be reached directly, so once other networks
+		// are behind gateways they are unreachable from those networks. This is valid for networks that
+		// only send traffic out, so only warn.
+		if multiNetwork && len(network.Endpoints) > 0 && len(network.Gateways) == 0 {
+			scope.Warnf("network %v has endpoints but no gateways, its endpoints cannot be reached from other networks", name)
+		}
 	}
 	return
 }
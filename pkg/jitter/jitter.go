@@ -0,0 +1,69 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jitter provides the random delays used to spread out work that many instances would otherwise
+// start at the same time, such as certificate requests and rotations. Jitter can be disabled globally,
+// so that tests can rely on deterministic timing.
+package jitter
+
+import (
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"istio.io/pkg/env"
+)
+
+var (
+	disabledEnv = env.RegisterBoolVar(
+		"ISTIO_DISABLE_JITTER",
+		false,
+		"If true, no random jitter is added to delays, making timing deterministic. Intended for testing only.",
+	).Get()
+
+	// disabled is 1 if jitter is disabled.
+	disabled int32
+
+	randMutex sync.Mutex
+	rnd       = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+func init() {
+	SetDisabled(disabledEnv)
+}
+
+// Disabled returns true if jitter is disabled.
+func Disabled() bool {
+	return atomic.LoadInt32(&disabled) == 1
+}
+
+// SetDisabled disables or enables jitter for the whole process.
+func SetDisabled(d bool) {
+	var v int32
+	if d {
+		v = 1
+	}
+	atomic.StoreInt32(&disabled, v)
+}
+
+// Duration returns a random duration in [0, max). It returns 0 if jitter is disabled or max is not positive.
+func Duration(max time.Duration) time.Duration {
+	if max <= 0 || Disabled() {
+		return 0
+	}
+	randMutex.Lock()
+	defer randMutex.Unlock()
+	return time.Duration(rnd.Int63n(int64(max)))
+}
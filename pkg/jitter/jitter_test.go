@@ -0,0 +1,41 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jitter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDuration(t *testing.T) {
+	defer SetDisabled(Disabled())
+
+	SetDisabled(false)
+	for i := 0; i < 100; i++ {
+		if d := Duration(time.Second); d < 0 || d >= time.Second {
+			t.Fatalf("expected jitter in [0, 1s), got %v", d)
+		}
+	}
+	if d := Duration(0); d != 0 {
+		t.Fatalf("expected no jitter for zero max, got %v", d)
+	}
+
+	SetDisabled(true)
+	for i := 0; i < 100; i++ {
+		if d := Duration(time.Second); d != 0 {
+			t.Fatalf("expected no jitter when disabled, got %v", d)
+		}
+	}
+}
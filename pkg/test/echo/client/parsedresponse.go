@@ -38,6 +38,7 @@ var (
 	responseHeaderFieldRegex = regexp.MustCompile(string(response.ResponseHeader) + "=(.*)")
 	URLFieldRegex            = regexp.MustCompile(string(response.URLField) + "=(.*)")
 	ClusterFieldRegex        = regexp.MustCompile(string(response.ClusterField) + "=(.*)")
+	forwardedClientCertRegex = regexp.MustCompile("(?i)" + string(response.ForwardedClientCertField) + "=(.*)")
 )
 
 // ParsedResponse represents a response to a single echo request.
@@ -60,6 +61,9 @@ type ParsedResponse struct {
 	Hostname string
 	// The cluster where the server is deployed.
 	Cluster string
+	// ForwardedClientCert is the X-Forwarded-Client-Cert header received by the server. It is only set
+	// if the request was received over mutual TLS.
+	ForwardedClientCert string
 	// RawResponse gives a map of all values returned in the response (headers, etc)
 	RawResponse map[string]string
 }
@@ -69,6 +73,22 @@ func (r *ParsedResponse) IsOK() bool {
 	return r.Code == response.StatusCodeOK
 }
 
+// PeerPrincipal returns the principal of the client that called the server over mutual TLS, taken from
+// the URI of the last element of the X-Forwarded-Client-Cert header, or "" if there is none.
+func (r *ParsedResponse) PeerPrincipal() string {
+	if r.ForwardedClientCert == "" {
+		return ""
+	}
+	elements := strings.Split(r.ForwardedClientCert, ",")
+	for _, kv := range strings.Split(elements[len(elements)-1], ";") {
+		parts := strings.SplitN(strings.TrimSpace(kv), "=", 2)
+		if len(parts) == 2 && strings.EqualFold(parts[0], "URI") {
+			return strings.TrimPrefix(parts[1], "spiffe://")
+		}
+	}
+	return ""
+}
+
 // Count occurrences of the given text within the body of this response.
 func (r *ParsedResponse) Count(text string) int {
 	return strings.Count(r.Body, text)
@@ -85,6 +105,7 @@ func (r *ParsedResponse) String() string {
 	out += fmt.Sprintf("Host:     %s\n", r.Host)
 	out += fmt.Sprintf("Hostname: %s\n", r.Hostname)
 	out += fmt.Sprintf("Cluster:  %s\n", r.Cluster)
+	out += fmt.Sprintf("XFCC:     %s\n", r.ForwardedClientCert)
 
 	return out
 }
@@ -187,6 +208,53 @@ func (r ParsedResponses) CheckPortOrFail(t test.Failer, expected int) ParsedResp
 	return r
 }
 
+// CheckTCPEcho checks that each response succeeded and that the given message was echoed back by the server,
+// as is done by the TCP echo endpoint.
+func (r ParsedResponses) CheckTCPEcho(message string) error {
+	echoed := "body] " + message + "\n"
+	return r.Check(func(i int, response *ParsedResponse) error {
+		if !response.IsOK() {
+			return fmt.Errorf("response[%d] Status Code: %s", i, response.Code)
+		}
+		if !strings.Contains(response.Body, echoed) {
+			return fmt.Errorf("response[%d] did not echo message %q, received %q", i, message, response.Body)
+		}
+		return nil
+	})
+}
+
+func (r ParsedResponses) CheckTCPEchoOrFail(t test.Failer, message string) ParsedResponses {
+	t.Helper()
+	if err := r.CheckTCPEcho(message); err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+// CheckMTLS checks that each request was received over mutual TLS from a client with the given principal,
+// for example "cluster.local/ns/foo/sa/bar". A "spiffe://" prefix on the principal is ignored.
+func (r ParsedResponses) CheckMTLS(expectedPrincipal string) error {
+	expectedPrincipal = strings.TrimPrefix(expectedPrincipal, "spiffe://")
+	return r.Check(func(i int, response *ParsedResponse) error {
+		if response.ForwardedClientCert == "" {
+			return fmt.Errorf("response[%d] was not received over mTLS: no X-Forwarded-Client-Cert header", i)
+		}
+		if got := response.PeerPrincipal(); got != expectedPrincipal {
+			return fmt.Errorf("response[%d] peer principal: expected %s, received %s (X-Forwarded-Client-Cert: %s)",
+				i, expectedPrincipal, got, response.ForwardedClientCert)
+		}
+		return nil
+	})
+}
+
+func (r ParsedResponses) CheckMTLSOrFail(t test.Failer, expectedPrincipal string) ParsedResponses {
+	t.Helper()
+	if err := r.CheckMTLS(expectedPrincipal); err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
 func (r ParsedResponses) clusterDistribution() map[string]int {
 	hits := map[string]int{}
 	for _, rr := range r {
@@ -337,6 +405,11 @@ func parseResponse(output string) *ParsedResponse {
 		out.Cluster = match[1]
 	}
 
+	match = forwardedClientCertRegex.FindStringSubmatch(output)
+	if match != nil {
+		out.ForwardedClientCert = match[1]
+	}
+
 	out.RawResponse = map[string]string{}
 
 	matches := responseHeaderFieldRegex.FindAllStringSubmatch(output, -1)
@@ -42,4 +42,6 @@ const (
 	MethodField         Field = "Method"
 	ResponseHeader      Field = "ResponseHeader"
 	ClusterField        Field = "Cluster"
+	// ForwardedClientCertField is the header added by the server side proxy for requests received over mTLS.
+	ForwardedClientCertField Field = "X-Forwarded-Client-Cert"
 )
@@ -18,8 +18,11 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
 	"time"
 
+	"google.golang.org/grpc/codes"
+
 	"istio.io/istio/pkg/test/echo/client"
 	"istio.io/istio/pkg/test/echo/common/scheme"
 	"istio.io/istio/pkg/test/framework/resource"
@@ -161,6 +164,14 @@ func ExpectHost(expected string) Validator {
 	})
 }
 
+// ExpectMTLS returns a Validator that checks that the responses were received over mutual TLS from a client
+// with the given principal.
+func ExpectMTLS(expectedPrincipal string) Validator {
+	return ValidatorFunc(func(responses client.ParsedResponses, _ error) error {
+		return responses.CheckMTLS(expectedPrincipal)
+	})
+}
+
 // ExpectCode returns a Validator that checks the responses for the given response code.
 func ExpectCode(expected string) Validator {
 	return ValidatorFunc(func(responses client.ParsedResponses, _ error) error {
@@ -168,6 +179,44 @@ func ExpectCode(expected string) Validator {
 	})
 }
 
+// grpcStatusCodeRegex matches the status code in the text of a gRPC error.
+var grpcStatusCodeRegex = regexp.MustCompile(`code = (\w+)`)
+
+// ExpectGRPCStatus returns a Validator that checks that a gRPC call completed with the given status code.
+// Errors from calls forwarded by an echo instance only preserve the gRPC status as text, possibly wrapped in
+// the status of the forwarding call, so the innermost code found in the error message is used.
+func ExpectGRPCStatus(expected codes.Code) Validator {
+	return ValidatorFunc(func(responses client.ParsedResponses, err error) error {
+		if expected == codes.OK {
+			if err != nil {
+				return fmt.Errorf("expected gRPC status %s, but encountered: %v", expected, err)
+			}
+			return responses.CheckOK()
+		}
+		if err == nil {
+			return fmt.Errorf("expected gRPC status %s, but the call succeeded", expected)
+		}
+		matches := grpcStatusCodeRegex.FindAllStringSubmatch(err.Error(), -1)
+		if len(matches) == 0 {
+			return fmt.Errorf("expected gRPC status %s, but encountered a non-gRPC error: %v", expected, err)
+		}
+		if got := matches[len(matches)-1][1]; got != expected.String() {
+			return fmt.Errorf("expected gRPC status %s, got %s: %v", expected, got, err)
+		}
+		return nil
+	})
+}
+
+// ExpectTCPEcho returns a Validator that checks that the given message was echoed back by a TCP server.
+func ExpectTCPEcho(message string) Validator {
+	return ValidatorFunc(func(responses client.ParsedResponses, err error) error {
+		if err != nil {
+			return fmt.Errorf("expected no error, but encountered: %v", err)
+		}
+		return responses.CheckTCPEcho(message)
+	})
+}
+
 // ValidatorFunc is a function that serves as a Validator.
 type ValidatorFunc func(client.ParsedResponses, error) error
 
@@ -0,0 +1,261 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"istio.io/istio/pkg/config/protocol"
+	"istio.io/istio/pkg/test/echo/common/response"
+	"istio.io/istio/pkg/test/echo/common/scheme"
+	"istio.io/istio/pkg/test/echo/proto"
+	"istio.io/istio/pkg/test/framework/components/echo"
+)
+
+// fakeGRPCEcho is a gRPC echo backend that rejects requests with the message "deny".
+type fakeGRPCEcho struct{}
+
+func (fakeGRPCEcho) Echo(_ context.Context, req *proto.EchoRequest) (*proto.EchoResponse, error) {
+	if req.Message == "deny" {
+		return nil, status.Error(codes.PermissionDenied, "denied by fake backend")
+	}
+	return &proto.EchoResponse{
+		Message: string(response.StatusCodeField) + "=" + response.StatusCodeOK + "\n" + req.Message,
+	}, nil
+}
+
+func (fakeGRPCEcho) ForwardEcho(context.Context, *proto.ForwardEchoRequest) (*proto.ForwardEchoResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "not implemented")
+}
+
+func startFakeGRPCEcho(t *testing.T) int {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer()
+	proto.RegisterEchoTestServiceServer(s, fakeGRPCEcho{})
+	go func() {
+		_ = s.Serve(l)
+	}()
+	t.Cleanup(s.Stop)
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+func startFakeTCPEcho(t *testing.T) int {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = l.Close()
+	})
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				buf := make([]byte, 1024)
+				n, err := conn.Read(buf)
+				if err != nil {
+					return
+				}
+				_, _ = conn.Write([]byte(string(response.StatusCodeField) + "=" + response.StatusCodeOK + "\n"))
+				_, _ = conn.Write(buf[:n])
+			}()
+		}
+	}()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+func TestExpectGRPCStatus(t *testing.T) {
+	port := startFakeGRPCEcho(t)
+	cases := []struct {
+		name      string
+		message   string
+		validator echo.Validator
+		wantErr   bool
+	}{
+		{
+			name:      "ok",
+			message:   "hello",
+			validator: echo.ExpectGRPCStatus(codes.OK),
+		},
+		{
+			name:      "expected denied",
+			message:   "deny",
+			validator: echo.ExpectGRPCStatus(codes.PermissionDenied),
+		},
+		{
+			name:      "unexpected denied",
+			message:   "deny",
+			validator: echo.ExpectGRPCStatus(codes.OK),
+			wantErr:   true,
+		},
+		{
+			name:      "wrong code",
+			message:   "deny",
+			validator: echo.ExpectGRPCStatus(codes.Unavailable),
+			wantErr:   true,
+		},
+		{
+			name:      "expected failure but succeeded",
+			message:   "hello",
+			validator: echo.ExpectGRPCStatus(codes.PermissionDenied),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := CallEcho(&echo.CallOptions{
+				Address: "127.0.0.1",
+				Port: &echo.Port{
+					Name:        "grpc",
+					Protocol:    protocol.GRPC,
+					ServicePort: port,
+				},
+				Message:   tt.message,
+				Validator: tt.validator,
+			}, false)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("wantErr %v, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestExpectTCPEcho(t *testing.T) {
+	port := startFakeTCPEcho(t)
+	cases := []struct {
+		name     string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "echoed",
+			expected: "hello",
+		},
+		{
+			name:     "not echoed",
+			expected: "goodbye",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := CallEcho(&echo.CallOptions{
+				Address: "127.0.0.1",
+				Port: &echo.Port{
+					Name:        "tcp",
+					Protocol:    protocol.TCP,
+					ServicePort: port,
+				},
+				Message:   "hello",
+				Validator: echo.ExpectTCPEcho(tt.expected),
+			}, false)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("wantErr %v, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// startFakeHTTPEcho starts an HTTP echo backend that reports the X-Forwarded-Client-Cert header it receives,
+// as a server behind a sidecar would for requests received over mTLS.
+func startFakeHTTPEcho(t *testing.T) int {
+	t.Helper()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if xfcc := r.Header.Get(string(response.ForwardedClientCertField)); xfcc != "" {
+			_, _ = fmt.Fprintf(w, "%s=%s\n", response.ForwardedClientCertField, xfcc)
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s.Listener.Addr().(*net.TCPAddr).Port
+}
+
+func TestExpectMTLS(t *testing.T) {
+	port := startFakeHTTPEcho(t)
+	xfcc := "By=spiffe://cluster.local/ns/server/sa/default;" +
+		"Hash=ab12;Subject=\"\";URI=spiffe://cluster.local/ns/client/sa/default"
+	cases := []struct {
+		name      string
+		xfcc      string
+		principal string
+		wantErr   bool
+	}{
+		{
+			name:      "matching principal",
+			xfcc:      xfcc,
+			principal: "cluster.local/ns/client/sa/default",
+		},
+		{
+			name:      "matching spiffe principal",
+			xfcc:      xfcc,
+			principal: "spiffe://cluster.local/ns/client/sa/default",
+		},
+		{
+			name:      "last hop is used",
+			xfcc:      "URI=spiffe://cluster.local/ns/other/sa/default," + xfcc,
+			principal: "cluster.local/ns/client/sa/default",
+		},
+		{
+			name:      "wrong principal",
+			xfcc:      xfcc,
+			principal: "cluster.local/ns/other/sa/default",
+			wantErr:   true,
+		},
+		{
+			name:      "plaintext",
+			principal: "cluster.local/ns/client/sa/default",
+			wantErr:   true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			headers := http.Header{}
+			if tt.xfcc != "" {
+				headers.Set(string(response.ForwardedClientCertField), tt.xfcc)
+			}
+			_, err := CallEcho(&echo.CallOptions{
+				Address: "127.0.0.1",
+				Port: &echo.Port{
+					Name:        "http",
+					Protocol:    protocol.HTTP,
+					ServicePort: port,
+				},
+				Scheme:    scheme.HTTP,
+				Headers:   headers,
+				Validator: echo.ExpectMTLS(tt.principal),
+			}, false)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("wantErr %v, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
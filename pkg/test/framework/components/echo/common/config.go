@@ -57,6 +57,9 @@ func FillInDefaults(ctx resource.Context, defaultDomain string, c *echo.Config)
 		if c.Subsets[i].Version == "" {
 			c.Subsets[i].Version = c.Version
 		}
+		if c.Subsets[i].Replicas == 0 {
+			c.Subsets[i].Replicas = 1
+		}
 	}
 
 	// Fill in the default cluster.
@@ -0,0 +1,85 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"istio.io/istio/istioctl/pkg/writer/compare"
+	"istio.io/istio/pkg/test"
+)
+
+// ConfigSnapshot is the Envoy config dump of a workload taken at a point in time, for example before
+// and after a control plane upgrade.
+type ConfigSnapshot struct {
+	// Name labels the snapshot in diffs.
+	Name string
+	// Dump is the JSON config dump.
+	Dump []byte
+}
+
+// SnapshotConfig fetches the current Envoy config dump of a workload.
+func SnapshotConfig(name string, fetch ConfigFetchFunc) (*ConfigSnapshot, error) {
+	cfg, err := fetch()
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching config for snapshot %s: %v", name, err)
+	}
+	m := jsonpb.Marshaler{Indent: "  "}
+	out, err := m.MarshalToString(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshaling config for snapshot %s: %v", name, err)
+	}
+	return &ConfigSnapshot{Name: name, Dump: []byte(out)}, nil
+}
+
+// SnapshotConfigOrFail calls SnapshotConfig and fails the test if it returns an error.
+func SnapshotConfigOrFail(t test.Failer, name string, fetch ConfigFetchFunc) *ConfigSnapshot {
+	t.Helper()
+	s, err := SnapshotConfig(name, fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// DiffConfig diffs the clusters, listeners and routes of two snapshots. Versions and update times are
+// ignored, so a control plane change that regenerates identical config does not count as drift.
+// It returns the diff and whether the config drifted.
+func DiffConfig(before, after *ConfigSnapshot) (string, bool, error) {
+	out := &bytes.Buffer{}
+	c, err := compare.NewProxyComparator(out, before.Name, before.Dump, after.Name, after.Dump)
+	if err != nil {
+		return "", false, err
+	}
+	if err := c.Diff(); err != nil {
+		return "", false, err
+	}
+	return out.String(), c.Differs(), nil
+}
+
+// AssertNoConfigDrift fails the test if the config changed between the two snapshots.
+func AssertNoConfigDrift(t test.Failer, before, after *ConfigSnapshot) {
+	t.Helper()
+	diff, drifted, err := DiffConfig(before, after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if drifted {
+		t.Fatalf("unexpected Envoy config drift between %s and %s:\n%s", before.Name, after.Name, diff)
+	}
+}
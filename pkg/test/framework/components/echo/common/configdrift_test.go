@@ -0,0 +1,75 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	envoyAdmin "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+
+	"istio.io/istio/istioctl/pkg/util/configdump"
+	"istio.io/istio/pkg/test/framework/components/echo/common"
+)
+
+func readSnapshot(t *testing.T, name string) *common.ConfigSnapshot {
+	t.Helper()
+	dump, err := ioutil.ReadFile("testdata/configdump-" + name + ".json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &common.ConfigSnapshot{Name: name, Dump: dump}
+}
+
+func TestDiffConfig(t *testing.T) {
+	before := readSnapshot(t, "before")
+
+	t.Run("regenerated config with new versions does not drift", func(t *testing.T) {
+		diff, drifted, err := common.DiffConfig(before, readSnapshot(t, "upgraded"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if drifted {
+			t.Fatalf("expected no drift, got:\n%s", diff)
+		}
+	})
+
+	t.Run("changed cluster settings drift", func(t *testing.T) {
+		diff, drifted, err := common.DiffConfig(before, readSnapshot(t, "drift"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !drifted {
+			t.Fatalf("expected drift, got:\n%s", diff)
+		}
+		if !strings.Contains(diff, "connect_timeout") || !strings.Contains(diff, "before Clusters") {
+			t.Fatalf("expected the diff to show the changed cluster, got:\n%s", diff)
+		}
+	})
+
+	t.Run("snapshot round trips the config dump", func(t *testing.T) {
+		cfg := &configdump.Wrapper{}
+		if err := json.Unmarshal(before.Dump, cfg); err != nil {
+			t.Fatal(err)
+		}
+		snapshot, err := common.SnapshotConfig("snapshot", func() (*envoyAdmin.ConfigDump, error) { return cfg.ConfigDump, nil })
+		if err != nil {
+			t.Fatal(err)
+		}
+		common.AssertNoConfigDrift(t, before, snapshot)
+	})
+}
@@ -112,6 +112,14 @@ func (e *testConfig) CallWithRetryOrFail(_ test.Failer, _ echo.CallOptions, _ ..
 	panic("implement me")
 }
 
+func (*testConfig) Scale(_ int, _ ...retry.Option) error {
+	panic("not implemented")
+}
+
+func (*testConfig) ScaleOrFail(_ test.Failer, _ int, _ ...retry.Option) {
+	panic("not implemented")
+}
+
 func (*testConfig) Sidecar() echo.Sidecar {
 	panic("not implemented")
 }
@@ -101,11 +101,22 @@ type Config struct {
 type SubsetConfig struct {
 	// The version of the deployment.
 	Version string
+	// Replicas of the deployment. Defaults to 1.
+	Replicas int
 	// Annotations provides metadata hints for deployment of the instance.
 	Annotations Annotations
 	// TODO: port more into workload config.
 }
 
+// NoSidecarSubset returns a SubsetConfig for the given version that is deployed without a sidecar. This allows a
+// single echo instance to run with injection disabled, for example as a baseline to compare injected workloads against.
+func NoSidecarSubset(version string) SubsetConfig {
+	return SubsetConfig{
+		Version:     version,
+		Annotations: NewAnnotations().SetBool(SidecarInject, false),
+	}
+}
+
 // String implements the Configuration interface (which implements fmt.Stringer)
 func (c Config) String() string {
 	return fmt.Sprint("{service: ", c.Service, ", version: ", c.Version, "}")
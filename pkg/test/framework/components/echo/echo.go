@@ -76,6 +76,11 @@ type Instance interface {
 	// options. If no options are provided, uses defaults.
 	CallWithRetry(options CallOptions, retryOptions ...retry.Option) (client.ParsedResponses, error)
 	CallWithRetryOrFail(t test.Failer, options CallOptions, retryOptions ...retry.Option) client.ParsedResponses
+
+	// Scale changes the number of replicas for each subset of this Instance and waits until the
+	// service endpoints have converged on the new replica count. Workloads are refreshed afterwards.
+	Scale(replicas int, retryOptions ...retry.Option) error
+	ScaleOrFail(t test.Failer, replicas int, retryOptions ...retry.Option)
 }
 
 // Workload port exposed by an Echo instance
@@ -72,7 +72,7 @@ kind: Deployment
 metadata:
   name: {{ $.Service }}-{{ $subset.Version }}
 spec:
-  replicas: 1
+  replicas: {{ $subset.Replicas }}
   selector:
     matchLabels:
       app: {{ $.Service }}
@@ -224,7 +224,7 @@ kind: Deployment
 metadata:
   name: {{ $.Service }}-{{ $subset.Version }}
 spec:
-  replicas: 1
+  replicas: {{ $subset.Replicas }}
   selector:
     matchLabels:
       istio.io/test-vm: {{ $.Service }}
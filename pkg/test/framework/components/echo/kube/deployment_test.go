@@ -14,8 +14,12 @@
 package kube
 
 import (
+	"strings"
 	"testing"
 
+	appsv1 "k8s.io/api/apps/v1"
+	"sigs.k8s.io/yaml"
+
 	testutil "istio.io/istio/pilot/test/util"
 	"istio.io/istio/pkg/config/protocol"
 	"istio.io/istio/pkg/test/framework/components/echo"
@@ -81,6 +85,22 @@ func TestDeploymentYAML(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:         "nosidecar",
+			wantFilePath: "testdata/nosidecar.yaml",
+			config: echo.Config{
+				Service: "foo",
+				Ports: []echo.Port{
+					{
+						Name:         "http",
+						Protocol:     protocol.HTTP,
+						InstancePort: 8090,
+						ServicePort:  8090,
+					},
+				},
+				Subsets: []echo.SubsetConfig{echo.NoSidecarSubset("v1")},
+			},
+		},
 		{
 			name:         "healthcheck-rewrite",
 			wantFilePath: "testdata/healthcheck-rewrite.yaml",
@@ -162,3 +182,77 @@ func TestDeploymentYAML(t *testing.T) {
 		})
 	}
 }
+
+func TestDeploymentYAMLCanary(t *testing.T) {
+	cfg := echo.Config{
+		Service: "canary",
+		Ports: []echo.Port{
+			{
+				Name:         "http",
+				Protocol:     protocol.HTTP,
+				InstancePort: 8090,
+				ServicePort:  8090,
+			},
+		},
+		Subsets: []echo.SubsetConfig{
+			{
+				Version:  "v1",
+				Replicas: 3,
+			},
+			{
+				Version: "v2",
+			},
+		},
+		Cluster: resource.FakeCluster{
+			NameValue: "cluster-0",
+		},
+	}
+	if err := common.FillInDefaults(nil, "", &cfg); err != nil {
+		t.Fatalf("failed filling in defaults: %v", err)
+	}
+	_, deploymentYAML, err := generateYAMLWithSettings(cfg, settings, kube.Cluster{
+		ExtendedClient: kubetest.MockClient{},
+	})
+	if err != nil {
+		t.Fatalf("failed to generate yaml %v", err)
+	}
+
+	got := map[string]appsv1.Deployment{}
+	for _, doc := range strings.Split(deploymentYAML, "---") {
+		d := appsv1.Deployment{}
+		if err := yaml.Unmarshal([]byte(doc), &d); err != nil {
+			t.Fatalf("failed to parse deployment: %v", err)
+		}
+		if d.Kind != "Deployment" {
+			continue
+		}
+		got[d.Name] = d
+	}
+
+	want := map[string]int32{
+		"canary-v1": 3,
+		"canary-v2": 1,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d deployments, want %d", len(got), len(want))
+	}
+	for name, replicas := range want {
+		d, ok := got[name]
+		if !ok {
+			t.Fatalf("missing deployment %s", name)
+		}
+		version := strings.TrimPrefix(name, "canary-")
+		if v := d.Spec.Template.Labels["version"]; v != version {
+			t.Errorf("%s: got version label %q, want %q", name, v, version)
+		}
+		if v := d.Spec.Selector.MatchLabels["version"]; v != version {
+			t.Errorf("%s: got version selector %q, want %q", name, v, version)
+		}
+		if app := d.Spec.Template.Labels["app"]; app != "canary" {
+			t.Errorf("%s: got app label %q, want %q", name, app, "canary")
+		}
+		if d.Spec.Replicas == nil || *d.Spec.Replicas != replicas {
+			t.Errorf("%s: got replicas %v, want %d", name, d.Spec.Replicas, replicas)
+		}
+	}
+}
@@ -43,6 +43,7 @@ import (
 	"istio.io/istio/pkg/test/framework/components/istio"
 	"istio.io/istio/pkg/test/framework/components/istioctl"
 	"istio.io/istio/pkg/test/framework/resource"
+	kubetest "istio.io/istio/pkg/test/kube"
 	"istio.io/istio/pkg/test/scopes"
 	"istio.io/istio/pkg/test/util/retry"
 	"istio.io/istio/pkg/util/gogoprotomarshal"
@@ -584,3 +585,85 @@ func (c *instance) CallWithRetryOrFail(t test.Failer, opts echo.CallOptions,
 	}
 	return r
 }
+
+func (c *instance) Scale(replicas int, retryOptions ...retry.Option) error {
+	if c.cfg.DeployAsVM {
+		return fmt.Errorf("scaling is not supported for VM echo %s/%s", c.cfg.Namespace.Name(), c.cfg.Service)
+	}
+	if replicas < 1 {
+		return fmt.Errorf("invalid replica count %d for echo %s/%s", replicas, c.cfg.Namespace.Name(), c.cfg.Service)
+	}
+
+	opts := append([]retry.Option{retry.Timeout(c.cfg.ReadinessTimeout)}, retryOptions...)
+	if err := scaleAndWait(c.cluster, c.cfg, replicas, opts...); err != nil {
+		return err
+	}
+	for i := range c.cfg.Subsets {
+		c.cfg.Subsets[i].Replicas = replicas
+	}
+
+	// The old workloads may point at pods that no longer exist, so rebuild them from the current pods.
+	// After a scale down the removed pods linger while terminating, so wait until exactly the requested
+	// number of live pods remain.
+	fetch := kubetest.NewPodMustFetch(c.cluster, c.cfg.Namespace.Name(), fmt.Sprintf("app=%s", c.cfg.Service))
+	pods, err := kubetest.WaitUntilPodsAreReady(liveReplicaFetch(fetch, replicas*len(c.cfg.Subsets)), opts...)
+	if err != nil {
+		return err
+	}
+	if err := c.Close(); err != nil {
+		scopes.Framework.Warnf("failed closing workloads for echo %s: %v", c.cfg.Service, err)
+	}
+	return c.initialize(pods)
+}
+
+func (c *instance) ScaleOrFail(t test.Failer, replicas int, retryOptions ...retry.Option) {
+	t.Helper()
+	if err := c.Scale(replicas, retryOptions...); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// liveReplicaFetch wraps fetch so that it skips pods that are being deleted and fails unless exactly
+// want pods remain.
+func liveReplicaFetch(fetch kubetest.PodFetchFunc, want int) kubetest.PodFetchFunc {
+	return func() ([]kubeCore.Pod, error) {
+		fetched, err := fetch()
+		if err != nil {
+			return nil, err
+		}
+		pods := make([]kubeCore.Pod, 0, len(fetched))
+		for _, p := range fetched {
+			if p.DeletionTimestamp == nil {
+				pods = append(pods, p)
+			}
+		}
+		if len(pods) != want {
+			return nil, fmt.Errorf("expected %d live pods, found %d", want, len(pods))
+		}
+		return pods, nil
+	}
+}
+
+// scaleAndWait sets the replica count of every subset deployment for the echo service and waits
+// until the service endpoints report the new total number of ready addresses.
+func scaleAndWait(client kubernetes.Interface, cfg echo.Config, replicas int, opts ...retry.Option) error {
+	ns := cfg.Namespace.Name()
+	for _, subset := range cfg.Subsets {
+		name := fmt.Sprintf("%s-%s", cfg.Service, subset.Version)
+		d, err := client.AppsV1().Deployments(ns).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed getting deployment %s/%s: %v", ns, name, err)
+		}
+		r := int32(replicas)
+		d.Spec.Replicas = &r
+		if _, err := client.AppsV1().Deployments(ns).Update(context.TODO(), d, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed scaling deployment %s/%s to %d: %v", ns, name, replicas, err)
+		}
+	}
+
+	want := replicas * len(cfg.Subsets)
+	if _, err := kubetest.WaitUntilServiceEndpointsReadyCount(client, ns, cfg.Service, want, opts...); err != nil {
+		return fmt.Errorf("echo %s/%s did not converge on %d endpoints: %v", ns, cfg.Service, want, err)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	kubeCore "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	istioKube "istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/test/framework/components/echo"
+	"istio.io/istio/pkg/test/util/retry"
+)
+
+type fakeNamespace string
+
+func (n fakeNamespace) Name() string {
+	return string(n)
+}
+
+func (n fakeNamespace) SetLabel(string, string) error {
+	return nil
+}
+
+func fakeEndpoints(ready int) *kubeCore.Endpoints {
+	eps := &kubeCore.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "echo"},
+		Subsets:    []kubeCore.EndpointSubset{{}},
+	}
+	for i := 0; i < ready; i++ {
+		eps.Subsets[0].Addresses = append(eps.Subsets[0].Addresses, kubeCore.EndpointAddress{IP: fmt.Sprintf("10.0.0.%d", i)})
+	}
+	return eps
+}
+
+func TestScaleAndWait(t *testing.T) {
+	one := int32(1)
+	cfg := echo.Config{
+		Service:   "foo",
+		Namespace: fakeNamespace("echo"),
+		Subsets:   []echo.SubsetConfig{{Version: "v1"}, {Version: "v2"}},
+	}
+	var objects []runtime.Object
+	for _, subset := range cfg.Subsets {
+		objects = append(objects, &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo-" + subset.Version, Namespace: "echo"},
+			Spec:       appsv1.DeploymentSpec{Replicas: &one},
+		})
+	}
+	objects = append(objects, fakeEndpoints(2))
+	client := istioKube.NewFakeClient(objects...)
+
+	// Endpoints have not converged yet, so the wait must time out.
+	err := scaleAndWait(client, cfg, 3, retry.Timeout(200*time.Millisecond), retry.Delay(10*time.Millisecond))
+	if err == nil {
+		t.Fatal("expected scale to wait for 6 endpoints")
+	}
+	for _, subset := range cfg.Subsets {
+		d, err := client.AppsV1().Deployments("echo").Get(context.TODO(), "foo-"+subset.Version, metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *d.Spec.Replicas != 3 {
+			t.Fatalf("%s: got replicas %d, want 3", d.Name, *d.Spec.Replicas)
+		}
+	}
+
+	// Once the endpoints catch up with the new replica count, the wait succeeds.
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		_, _ = client.CoreV1().Endpoints("echo").Update(context.TODO(), fakeEndpoints(6), metav1.UpdateOptions{})
+	}()
+	if err := scaleAndWait(client, cfg, 3, retry.Timeout(5*time.Second), retry.Delay(10*time.Millisecond)); err != nil {
+		t.Fatal(err)
+	}
+}
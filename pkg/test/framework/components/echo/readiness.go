@@ -0,0 +1,50 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package echo
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/util/retry"
+)
+
+// WaitUntilPortsReady calls every port declared by the target Instance from the source Instance, retrying each
+// until it responds. If any port does not respond before the retry options time out, the returned error names
+// each such port.
+func WaitUntilPortsReady(from Instance, target Instance, retryOptions ...retry.Option) error {
+	var errs error
+	for _, port := range target.Config().Ports {
+		p := port
+		if _, err := from.CallWithRetry(CallOptions{
+			Target: target,
+			Port:   &p,
+		}, retryOptions...); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("port %s (%d) of %s is not ready: %v",
+				p.Name, p.ServicePort, target.Config().Service, err))
+		}
+	}
+	return errs
+}
+
+// WaitUntilPortsReadyOrFail calls WaitUntilPortsReady and fails the test if an error occurs.
+func WaitUntilPortsReadyOrFail(t test.Failer, from Instance, target Instance, retryOptions ...retry.Option) {
+	t.Helper()
+	if err := WaitUntilPortsReady(from, target, retryOptions...); err != nil {
+		t.Fatal(err)
+	}
+}
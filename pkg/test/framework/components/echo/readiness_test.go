@@ -0,0 +1,124 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package echo
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"istio.io/istio/pkg/config/protocol"
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/echo/client"
+	"istio.io/istio/pkg/test/echo/common/response"
+	"istio.io/istio/pkg/test/framework/resource"
+	"istio.io/istio/pkg/test/util/retry"
+)
+
+// fakeInstance is an echo Instance whose calls fail for ports listed in down.
+type fakeInstance struct {
+	cfg  Config
+	down map[string]bool
+}
+
+var _ Instance = &fakeInstance{}
+
+func (f *fakeInstance) ID() resource.ID { return nil }
+
+func (f *fakeInstance) Config() Config { return f.cfg }
+
+func (f *fakeInstance) Address() string { return "" }
+
+func (f *fakeInstance) Workloads() ([]Workload, error) { return nil, nil }
+
+func (f *fakeInstance) WorkloadsOrFail(test.Failer) []Workload { return nil }
+
+func (f *fakeInstance) Call(opts CallOptions) (client.ParsedResponses, error) {
+	if opts.Target.(*fakeInstance).down[opts.Port.Name] {
+		return nil, errors.New("connection refused")
+	}
+	return client.ParsedResponses{{Code: response.StatusCodeOK}}, nil
+}
+
+func (f *fakeInstance) CallOrFail(t test.Failer, opts CallOptions) client.ParsedResponses {
+	r, err := f.Call(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func (f *fakeInstance) CallWithRetry(opts CallOptions, _ ...retry.Option) (client.ParsedResponses, error) {
+	return f.Call(opts)
+}
+
+func (f *fakeInstance) CallWithRetryOrFail(t test.Failer, opts CallOptions, _ ...retry.Option) client.ParsedResponses {
+	return f.CallOrFail(t, opts)
+}
+
+func (f *fakeInstance) Scale(int, ...retry.Option) error { return nil }
+
+func (f *fakeInstance) ScaleOrFail(test.Failer, int, ...retry.Option) {}
+
+func TestWaitUntilPortsReady(t *testing.T) {
+	ports := []Port{
+		{Name: "http", Protocol: protocol.HTTP, ServicePort: 80},
+		{Name: "tcp", Protocol: protocol.TCP, ServicePort: 9000},
+		{Name: "grpc", Protocol: protocol.GRPC, ServicePort: 7070},
+	}
+	cases := []struct {
+		name      string
+		down      map[string]bool
+		wantPorts []string
+	}{
+		{
+			name: "all ready",
+		},
+		{
+			name:      "one port down",
+			down:      map[string]bool{"tcp": true},
+			wantPorts: []string{"port tcp (9000)"},
+		},
+		{
+			name:      "multiple ports down",
+			down:      map[string]bool{"tcp": true, "grpc": true},
+			wantPorts: []string{"port tcp (9000)", "port grpc (7070)"},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			from := &fakeInstance{cfg: Config{Service: "a"}}
+			target := &fakeInstance{cfg: Config{Service: "b", Ports: ports}, down: tt.down}
+			err := WaitUntilPortsReady(from, target)
+			if len(tt.wantPorts) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error naming %v", tt.wantPorts)
+			}
+			for _, p := range tt.wantPorts {
+				if !strings.Contains(err.Error(), p) {
+					t.Errorf("expected error to name %q, got: %v", p, err)
+				}
+			}
+			if strings.Contains(err.Error(), "port http") {
+				t.Errorf("expected ready port http not to be named, got: %v", err)
+			}
+		})
+	}
+}
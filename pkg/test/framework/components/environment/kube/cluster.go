@@ -16,10 +16,18 @@ package kube
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"istio.io/istio/pkg/kube"
 	"istio.io/istio/pkg/test/framework/resource"
+	"istio.io/istio/pkg/test/util/retry"
 )
 
 var _ resource.Cluster = Cluster{}
@@ -99,3 +107,64 @@ func (c Cluster) Config() resource.Cluster {
 	}
 	return c.clusters[i]
 }
+
+// GetNetworkPolicy returns the NetworkPolicy with the given namespace and name.
+func (c Cluster) GetNetworkPolicy(namespace, name string) (*networkingv1.NetworkPolicy, error) {
+	return c.NetworkingV1().NetworkPolicies(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+}
+
+// GetRole returns the Role with the given namespace and name.
+func (c Cluster) GetRole(namespace, name string) (*rbacv1.Role, error) {
+	return c.RbacV1().Roles(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+}
+
+// GetRoleBinding returns the RoleBinding with the given namespace and name.
+func (c Cluster) GetRoleBinding(namespace, name string) (*rbacv1.RoleBinding, error) {
+	return c.RbacV1().RoleBindings(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+}
+
+// GetClusterRole returns the ClusterRole with the given name.
+func (c Cluster) GetClusterRole(name string) (*rbacv1.ClusterRole, error) {
+	return c.RbacV1().ClusterRoles().Get(context.TODO(), name, metav1.GetOptions{})
+}
+
+// GetClusterRoleBinding returns the ClusterRoleBinding with the given name.
+func (c Cluster) GetClusterRoleBinding(name string) (*rbacv1.ClusterRoleBinding, error) {
+	return c.RbacV1().ClusterRoleBindings().Get(context.TODO(), name, metav1.GetOptions{})
+}
+
+// WaitForDeploymentRollout waits until the latest revision of the Deployment with the given namespace and name is
+// fully rolled out, i.e. all of its replicas are updated and available.
+func (c Cluster) WaitForDeploymentRollout(namespace, name string, timeout time.Duration) error {
+	return retry.UntilSuccess(func() error {
+		d, err := c.AppsV1().Deployments(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		return deploymentRolledOut(d)
+	}, retry.Timeout(timeout), retry.Delay(100*time.Millisecond))
+}
+
+// deploymentRolledOut returns an error if the Deployment has not finished rolling out.
+func deploymentRolledOut(d *appsv1.Deployment) error {
+	if d.Status.ObservedGeneration < d.Generation {
+		return fmt.Errorf("deployment %s/%s: waiting for generation %d to be observed", d.Namespace, d.Name, d.Generation)
+	}
+	replicas := int32(1)
+	if d.Spec.Replicas != nil {
+		replicas = *d.Spec.Replicas
+	}
+	if d.Status.UpdatedReplicas < replicas {
+		return fmt.Errorf("deployment %s/%s: %d of %d replicas updated",
+			d.Namespace, d.Name, d.Status.UpdatedReplicas, replicas)
+	}
+	if d.Status.Replicas > d.Status.UpdatedReplicas {
+		return fmt.Errorf("deployment %s/%s: %d old replicas pending termination",
+			d.Namespace, d.Name, d.Status.Replicas-d.Status.UpdatedReplicas)
+	}
+	if d.Status.AvailableReplicas < replicas {
+		return fmt.Errorf("deployment %s/%s: %d of %d replicas available",
+			d.Namespace, d.Name, d.Status.AvailableReplicas, replicas)
+	}
+	return nil
+}
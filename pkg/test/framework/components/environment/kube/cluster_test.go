@@ -0,0 +1,138 @@
+//  Copyright Istio Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package kube
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"istio.io/istio/pkg/kube"
+)
+
+func TestGetNetworkPolicy(t *testing.T) {
+	c := Cluster{ExtendedClient: kube.NewFakeClient(&networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "istiod", Namespace: "istio-system"},
+	})}
+
+	np, err := c.GetNetworkPolicy("istio-system", "istiod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if np.Name != "istiod" {
+		t.Errorf("got network policy %s, want istiod", np.Name)
+	}
+
+	if _, err := c.GetNetworkPolicy("istio-system", "missing"); !errors.IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestGetRBAC(t *testing.T) {
+	meta := metav1.ObjectMeta{Name: "istiod", Namespace: "istio-system"}
+	clusterMeta := metav1.ObjectMeta{Name: "istiod-istio-system"}
+	c := Cluster{ExtendedClient: kube.NewFakeClient(
+		&rbacv1.Role{ObjectMeta: meta},
+		&rbacv1.RoleBinding{ObjectMeta: meta},
+		&rbacv1.ClusterRole{ObjectMeta: clusterMeta},
+		&rbacv1.ClusterRoleBinding{ObjectMeta: clusterMeta},
+	)}
+
+	cases := []struct {
+		name string
+		get  func(name string) (metav1.Object, error)
+		want string
+	}{
+		{
+			name: "Role",
+			get: func(name string) (metav1.Object, error) {
+				return c.GetRole("istio-system", name)
+			},
+			want: meta.Name,
+		},
+		{
+			name: "RoleBinding",
+			get: func(name string) (metav1.Object, error) {
+				return c.GetRoleBinding("istio-system", name)
+			},
+			want: meta.Name,
+		},
+		{
+			name: "ClusterRole",
+			get: func(name string) (metav1.Object, error) {
+				return c.GetClusterRole(name)
+			},
+			want: clusterMeta.Name,
+		},
+		{
+			name: "ClusterRoleBinding",
+			get: func(name string) (metav1.Object, error) {
+				return c.GetClusterRoleBinding(name)
+			},
+			want: clusterMeta.Name,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			obj, err := tt.get(tt.want)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if obj.GetName() != tt.want {
+				t.Errorf("got %s %s, want %s", tt.name, obj.GetName(), tt.want)
+			}
+			if _, err := tt.get("missing"); !errors.IsNotFound(err) {
+				t.Errorf("expected not found error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestWaitForDeploymentRollout(t *testing.T) {
+	replicas := int32(2)
+	d := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "istiod", Namespace: "istio-system", Generation: 1},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 1,
+			Replicas:           2,
+			UpdatedReplicas:    2,
+			AvailableReplicas:  1,
+		},
+	}
+	c := Cluster{ExtendedClient: kube.NewFakeClient(d)}
+
+	if err := c.WaitForDeploymentRollout("istio-system", "istiod", 200*time.Millisecond); err == nil {
+		t.Fatal("expected timeout while replicas are unavailable")
+	}
+
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		available := d.DeepCopy()
+		available.Status.AvailableReplicas = 2
+		if _, err := c.AppsV1().Deployments("istio-system").UpdateStatus(context.TODO(), available, metav1.UpdateOptions{}); err != nil {
+			t.Error(err)
+		}
+	}()
+	if err := c.WaitForDeploymentRollout("istio-system", "istiod", 5*time.Second); err != nil {
+		t.Fatalf("expected deployment to be rolled out, got: %v", err)
+	}
+}
@@ -25,7 +25,8 @@ type Config struct {
 	Prefix string
 	// Inject indicates whether to add sidecar injection label to this namespace
 	Inject bool
-	// Revision is the namespace of custom injector instance
+	// Revision is the control plane revision to inject from. If set along with Inject, the namespace
+	// is labeled with istio.io/rev instead of the default istio-injection label.
 	Revision string
 	// Labels to be applied to namespace
 	Labels map[string]string
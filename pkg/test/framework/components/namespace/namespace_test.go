@@ -18,6 +18,8 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+
+	"istio.io/api/label"
 )
 
 func TestConfigRevisionOverwrite(t *testing.T) {
@@ -68,3 +70,44 @@ func TestConfigRevisionOverwrite(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateNamespaceLabels(t *testing.T) {
+	testCases := []struct {
+		name string
+		cfg  Config
+		want map[string]string
+	}{
+		{
+			name: "NoInjection",
+			cfg:  Config{Prefix: "default"},
+			want: map[string]string{"istio-testing": "istio-test"},
+		},
+		{
+			name: "DefaultInjection",
+			cfg:  Config{Prefix: "default", Inject: true},
+			want: map[string]string{"istio-testing": "istio-test", "istio-injection": "enabled"},
+		},
+		{
+			name: "RevisionInjection",
+			cfg:  Config{Prefix: "default", Inject: true, Revision: "canary"},
+			want: map[string]string{"istio-testing": "istio-test", label.IstioRev: "canary"},
+		},
+		{
+			name: "RevisionWithoutInjection",
+			cfg:  Config{Prefix: "default", Revision: "canary"},
+			want: map[string]string{"istio-testing": "istio-test"},
+		},
+		{
+			name: "ExtraLabels",
+			cfg:  Config{Prefix: "default", Inject: true, Revision: "canary", Labels: map[string]string{"foo": "bar"}},
+			want: map[string]string{"istio-testing": "istio-test", label.IstioRev: "canary", "foo": "bar"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(createNamespaceLabels(&tc.cfg)).Should(Equal(tc.want))
+		})
+	}
+}
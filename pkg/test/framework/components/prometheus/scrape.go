@@ -0,0 +1,76 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"fmt"
+	"net/http"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/framework/resource"
+)
+
+const (
+	// MergedMetricsPort is the port on which the agent serves the merged proxy and application
+	// metrics, when meshConfig.enablePrometheusMerge is enabled.
+	MergedMetricsPort = 15020
+	// MergedMetricsPath is the path of the merged metrics endpoint.
+	MergedMetricsPath = "/stats/prometheus"
+)
+
+// ScrapeMergedMetrics scrapes the merged metrics endpoint of the given pod directly, without going through a
+// Prometheus server, and returns the parsed metric families.
+func ScrapeMergedMetrics(c resource.Cluster, podName, podNamespace string) (map[string]*dto.MetricFamily, error) {
+	fw, err := c.NewPortForwarder(podName, podNamespace, "127.0.0.1", 0, MergedMetricsPort)
+	if err != nil {
+		return nil, err
+	}
+	if err := fw.Start(); err != nil {
+		return nil, fmt.Errorf("failed port forwarding to %s/%s: %v", podNamespace, podName, err)
+	}
+	defer fw.Close()
+	return Scrape(fmt.Sprintf("http://%s%s", fw.Address(), MergedMetricsPath))
+}
+
+// ScrapeMergedMetricsOrFail calls ScrapeMergedMetrics and fails the test if an error occurs.
+func ScrapeMergedMetricsOrFail(t test.Failer, c resource.Cluster, podName, podNamespace string) map[string]*dto.MetricFamily {
+	t.Helper()
+	mfs, err := ScrapeMergedMetrics(c, podName, podNamespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mfs
+}
+
+// Scrape fetches metrics in the Prometheus text format from the given URL and returns the parsed metric families.
+func Scrape(url string) (map[string]*dto.MetricFamily, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed scraping %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed scraping %s: unexpected status %d", url, resp.StatusCode)
+	}
+	parser := expfmt.TextParser{}
+	mfs, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing metrics from %s: %v", url, err)
+	}
+	return mfs, nil
+}
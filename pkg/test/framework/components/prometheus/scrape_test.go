@@ -0,0 +1,67 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// mergedStats mimics the merged output of the agent: Envoy stats followed by application metrics.
+const mergedStats = `# TYPE envoy_cluster_upstream_cx_total counter
+envoy_cluster_upstream_cx_total{cluster_name="xds-grpc"} 3
+# TYPE istio_agent_pilot_xds gauge
+istio_agent_pilot_xds 1
+# TYPE app_requests_total counter
+app_requests_total{path="/"} 7
+`
+
+func TestScrape(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(MergedMetricsPath, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(mergedStats))
+	})
+	mux.HandleFunc("/bad", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("not { metrics"))
+	})
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	t.Run("merged", func(t *testing.T) {
+		mfs, err := Scrape(s.URL + MergedMetricsPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range []string{"envoy_cluster_upstream_cx_total", "istio_agent_pilot_xds", "app_requests_total"} {
+			if _, f := mfs[name]; !f {
+				t.Errorf("expected metric family %s, got %v", name, mfs)
+			}
+		}
+		if got := mfs["app_requests_total"].GetMetric()[0].GetCounter().GetValue(); got != 7 {
+			t.Errorf("expected app_requests_total 7, got %v", got)
+		}
+	})
+	t.Run("not found", func(t *testing.T) {
+		if _, err := Scrape(s.URL + "/missing"); err == nil {
+			t.Fatal("expected error for missing endpoint")
+		}
+	})
+	t.Run("invalid", func(t *testing.T) {
+		if _, err := Scrape(s.URL + "/bad"); err == nil {
+			t.Fatal("expected error for invalid metrics")
+		}
+	})
+}
@@ -0,0 +1,179 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wasm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+
+	kubeApiCore "k8s.io/api/core/v1"
+	kubeApiMeta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"istio.io/istio/pkg/test/framework/components/namespace"
+	"istio.io/istio/pkg/test/framework/resource"
+	testKube "istio.io/istio/pkg/test/kube"
+	"istio.io/istio/pkg/test/scopes"
+)
+
+const (
+	ns            = "wasm-server"
+	serviceName   = "wasm-server"
+	configMapName = "wasm-module"
+)
+
+// serverYAML serves the files of the module ConfigMap over HTTP.
+const serverYAML = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: wasm-server
+  labels:
+    app: wasm-server
+spec:
+  ports:
+  - name: http
+    port: 80
+  selector:
+    app: wasm-server
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: wasm-server
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app: wasm-server
+  template:
+    metadata:
+      labels:
+        app: wasm-server
+      annotations:
+        sidecar.istio.io/inject: "false"
+    spec:
+      containers:
+      - name: wasm-server
+        image: nginx:alpine
+        ports:
+        - containerPort: 80
+        volumeMounts:
+        - name: module
+          mountPath: /usr/share/nginx/html
+          readOnly: true
+      volumes:
+      - name: module
+        configMap:
+          name: wasm-module
+`
+
+var (
+	_ Instance  = &kubeComponent{}
+	_ io.Closer = &kubeComponent{}
+)
+
+type kubeComponent struct {
+	id      resource.ID
+	ns      namespace.Instance
+	cluster resource.Cluster
+	url     string
+	sha     string
+}
+
+func newKube(ctx resource.Context, cfg Config) (Instance, error) {
+	c := &kubeComponent{
+		cluster: ctx.Clusters().GetOrDefault(cfg.Cluster),
+	}
+	c.id = ctx.TrackResource(c)
+	var err error
+	scopes.Framework.Info("=== BEGIN: Deploy WASM module server ===")
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("wasm module server deployment failed: %v", err) // nolint:golint
+			scopes.Framework.Infof("=== FAILED: Deploy WASM module server ===")
+			_ = c.Close()
+		} else {
+			scopes.Framework.Info("=== SUCCEEDED: Deploy WASM module server ===")
+		}
+	}()
+
+	cm, sha, err := moduleConfigMap(cfg.ModulePath)
+	if err != nil {
+		return nil, err
+	}
+	c.sha = sha
+
+	c.ns, err = namespace.New(ctx, namespace.Config{
+		Prefix: ns,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create %q namespace for the wasm module server; err: %v", ns, err)
+	}
+
+	if _, err = c.cluster.CoreV1().ConfigMaps(c.ns.Name()).Create(context.TODO(), cm, kubeApiMeta.CreateOptions{}); err != nil {
+		return nil, fmt.Errorf("failed to create the wasm module ConfigMap: %v", err)
+	}
+	if err = ctx.Config(c.cluster).ApplyYAML(c.ns.Name(), serverYAML); err != nil {
+		return nil, fmt.Errorf("failed to apply the wasm module server: %v", err)
+	}
+	if _, _, err = testKube.WaitUntilServiceEndpointsAreReady(c.cluster, c.ns.Name(), serviceName); err != nil {
+		scopes.Framework.Infof("Error waiting for the wasm module server to be available: %v", err)
+		return nil, err
+	}
+
+	c.url = moduleURL(c.ns.Name(), cfg.ModulePath)
+	scopes.Framework.Infof("Serving wasm module %s at %s", cfg.ModulePath, c.url)
+	return c, nil
+}
+
+// moduleConfigMap returns the ConfigMap holding the module, and the hex encoded digest of the module.
+func moduleConfigMap(path string) (*kubeApiCore.ConfigMap, string, error) {
+	module, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read wasm module %s: %v", path, err)
+	}
+	sum := sha256.Sum256(module)
+	cm := &kubeApiCore.ConfigMap{
+		ObjectMeta: kubeApiMeta.ObjectMeta{Name: configMapName},
+		BinaryData: map[string][]byte{filepath.Base(path): module},
+	}
+	return cm, hex.EncodeToString(sum[:]), nil
+}
+
+// moduleURL returns the in-cluster URL of the module served from the namespace.
+func moduleURL(namespace, path string) string {
+	return fmt.Sprintf("http://%s.%s.svc.cluster.local/%s", serviceName, namespace, filepath.Base(path))
+}
+
+func (c *kubeComponent) ID() resource.ID {
+	return c.id
+}
+
+func (c *kubeComponent) Close() error {
+	return nil
+}
+
+func (c *kubeComponent) URL() string {
+	return c.url
+}
+
+func (c *kubeComponent) SHA256() string {
+	return c.sha
+}
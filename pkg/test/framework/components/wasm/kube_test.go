@@ -0,0 +1,108 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wasm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestModuleConfigMap(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wasm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	module := []byte("\x00asm\x01\x00\x00\x00")
+	path := filepath.Join(dir, "filter.wasm")
+	if err := ioutil.WriteFile(path, module, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cm, sha, err := moduleConfigMap(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cm.BinaryData["filter.wasm"]; !reflect.DeepEqual(got, module) {
+		t.Fatalf("expected the module to be served as filter.wasm, got %v", cm.BinaryData)
+	}
+	want := sha256.Sum256(module)
+	if sha != hex.EncodeToString(want[:]) {
+		t.Fatalf("unexpected digest %s", sha)
+	}
+	if url := moduleURL("wasm-server-1", path); url != "http://wasm-server.wasm-server-1.svc.cluster.local/filter.wasm" {
+		t.Fatalf("unexpected url %s", url)
+	}
+}
+
+// TestServedModule fetches the module over HTTP from a fake of the in-cluster server, which serves the
+// files of the module ConfigMap mounted as a volume, and checks it matches the digest of the component.
+func TestServedModule(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wasm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "filter.wasm")
+	if err := ioutil.WriteFile(path, []byte("\x00asm\x01\x00\x00\x00"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cm, sha, err := moduleConfigMap(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Mount the ConfigMap the way the kubelet does, one file per key, and serve it like nginx.
+	root := filepath.Join(dir, "html")
+	if err := os.Mkdir(root, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for name, data := range cm.BinaryData {
+		if err := ioutil.WriteFile(filepath.Join(root, name), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	server := httptest.NewServer(http.FileServer(http.Dir(root)))
+	defer server.Close()
+
+	u, err := url.Parse(moduleURL("wasm-server-1", path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.Get(server.URL + u.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("failed to fetch %s: %s", u.Path, resp.Status)
+	}
+	got, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256(got)
+	if digest := hex.EncodeToString(sum[:]); digest != sha {
+		t.Fatalf("served module digest %s does not match %s", digest, sha)
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wasm provides a component serving WASM modules over HTTP, so that
+// remote WASM fetch can be exercised in tests.
+package wasm
+
+import (
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/framework/resource"
+)
+
+// Instance represents a running WASM module server.
+type Instance interface {
+	resource.Resource
+
+	// URL is the in-cluster address the module can be fetched from.
+	URL() string
+	// SHA256 is the hex encoded digest of the served module.
+	SHA256() string
+}
+
+// Config defines the options for creating a WASM module server component.
+type Config struct {
+	// ModulePath is the path of the .wasm file to serve. The module is stored in a ConfigMap, so it
+	// must be smaller than 1MiB.
+	ModulePath string
+
+	// Cluster to be used in a multicluster environment
+	Cluster resource.Cluster
+}
+
+// New returns a new WASM module server instance.
+func New(ctx resource.Context, c Config) (i Instance, err error) {
+	return newKube(ctx, c)
+}
+
+// NewOrFail returns a new WASM module server instance or fails test.
+func NewOrFail(t test.Failer, ctx resource.Context, c Config) Instance {
+	t.Helper()
+	i, err := New(ctx, c)
+	if err != nil {
+		t.Fatalf("wasm.NewOrFail: %v", err)
+	}
+
+	return i
+}
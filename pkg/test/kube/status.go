@@ -0,0 +1,108 @@
+//  Copyright Istio Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package kube
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"k8s.io/apimachinery/pkg/api/errors"
+	kubeApiMeta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"istio.io/istio/pkg/test/scopes"
+	"istio.io/istio/pkg/test/util/retry"
+)
+
+// StatusPredicate checks the status of a custom resource, returning an error if it does not yet hold.
+// The status is unmarshalled into the message passed to WaitForStatus before the predicate is called.
+type StatusPredicate func(status proto.Message) error
+
+// ApplyAndWaitForStatus creates or updates the given custom resource and waits until its status,
+// unmarshalled into status, satisfies the predicate.
+func ApplyAndWaitForStatus(a dynamic.Interface, gvr schema.GroupVersionResource, obj *unstructured.Unstructured,
+	status proto.Message, predicate StatusPredicate, opts ...retry.Option) error {
+	if err := applyUnstructured(a, gvr, obj); err != nil {
+		return err
+	}
+	return WaitForStatus(a, gvr, obj.GetNamespace(), obj.GetName(), status, predicate, opts...)
+}
+
+// WaitForStatus waits until the status of the named custom resource, unmarshalled into status,
+// satisfies the predicate.
+func WaitForStatus(a dynamic.Interface, gvr schema.GroupVersionResource, namespace, name string,
+	status proto.Message, predicate StatusPredicate, opts ...retry.Option) error {
+	scopes.Framework.Infof("waiting for status of %s %s/%s", gvr.Resource, namespace, name)
+	err := retry.UntilSuccess(func() error {
+		us, err := a.Resource(gvr).Namespace(namespace).Get(context.TODO(), name, kubeApiMeta.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get %s %s/%s: %v", gvr.Resource, namespace, name, err)
+		}
+		if err := unmarshalStatus(us, status); err != nil {
+			return err
+		}
+		return predicate(status)
+	}, newRetryOptions(opts...)...)
+	if err != nil {
+		return fmt.Errorf("status of %s %s/%s did not become ready: %v", gvr.Resource, namespace, name, err)
+	}
+	return nil
+}
+
+// applyUnstructured creates the object, or updates it if it already exists.
+func applyUnstructured(a dynamic.Interface, gvr schema.GroupVersionResource, obj *unstructured.Unstructured) error {
+	ri := a.Resource(gvr).Namespace(obj.GetNamespace())
+	_, err := ri.Create(context.TODO(), obj, kubeApiMeta.CreateOptions{})
+	if err == nil {
+		return nil
+	}
+	if !errors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create %s %s/%s: %v", gvr.Resource, obj.GetNamespace(), obj.GetName(), err)
+	}
+	existing, err := ri.Get(context.TODO(), obj.GetName(), kubeApiMeta.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get %s %s/%s: %v", gvr.Resource, obj.GetNamespace(), obj.GetName(), err)
+	}
+	obj = obj.DeepCopy()
+	obj.SetResourceVersion(existing.GetResourceVersion())
+	if _, err := ri.Update(context.TODO(), obj, kubeApiMeta.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update %s %s/%s: %v", gvr.Resource, obj.GetNamespace(), obj.GetName(), err)
+	}
+	return nil
+}
+
+// unmarshalStatus unmarshals the status field of the unstructured object into out.
+func unmarshalStatus(us *unstructured.Unstructured, out proto.Message) error {
+	usStatus, ok := us.UnstructuredContent()["status"]
+	if !ok || usStatus == nil {
+		return fmt.Errorf("status not found from %s %s/%s", us.GetKind(), us.GetNamespace(), us.GetName())
+	}
+	statusString, err := json.Marshal(usStatus)
+	if err != nil {
+		return fmt.Errorf("failed to marshal status: %v", err)
+	}
+	out.Reset()
+	jspb := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err := jspb.Unmarshal(bytes.NewReader(statusString), out); err != nil {
+		return fmt.Errorf("failed to unmarshal status: %v", err)
+	}
+	return nil
+}
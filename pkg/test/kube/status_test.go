@@ -0,0 +1,99 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	api "istio.io/api/operator/v1alpha1"
+	"istio.io/istio/pkg/test/util/retry"
+)
+
+var iopGVR = schema.GroupVersionResource{
+	Group:    "install.istio.io",
+	Version:  "v1alpha1",
+	Resource: "istiooperators",
+}
+
+func fakeIstioOperator(status string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "install.istio.io/v1alpha1",
+		"kind":       "IstioOperator",
+		"metadata": map[string]interface{}{
+			"name":      "test",
+			"namespace": "istio-system",
+		},
+		"spec": map[string]interface{}{
+			"profile": "minimal",
+		},
+	}}
+	if status != "" {
+		obj.Object["status"] = map[string]interface{}{"status": status}
+	}
+	return obj
+}
+
+func installStatusHealthy(msg proto.Message) error {
+	status := msg.(*api.InstallStatus)
+	if status.Status != api.InstallStatus_HEALTHY {
+		return fmt.Errorf("got status %v", status.Status)
+	}
+	return nil
+}
+
+func TestApplyAndWaitForStatus(t *testing.T) {
+	// Each get observes the next status, simulating a controller reconciling the resource.
+	progression := []string{"", "RECONCILING", "HEALTHY"}
+	gets := 0
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	client.PrependReactor("get", "istiooperators", func(k8stesting.Action) (bool, runtime.Object, error) {
+		status := progression[len(progression)-1]
+		if gets < len(progression) {
+			status = progression[gets]
+		}
+		gets++
+		return true, fakeIstioOperator(status), nil
+	})
+
+	status := &api.InstallStatus{}
+	if err := ApplyAndWaitForStatus(client, iopGVR, fakeIstioOperator(""), status, installStatusHealthy,
+		retry.Delay(time.Millisecond), retry.Timeout(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if gets != len(progression) {
+		t.Errorf("expected %d gets, got %d", len(progression), gets)
+	}
+	if status.Status != api.InstallStatus_HEALTHY {
+		t.Errorf("expected HEALTHY status, got %v", status.Status)
+	}
+}
+
+func TestWaitForStatusTimeout(t *testing.T) {
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme(), fakeIstioOperator("ERROR"))
+	err := WaitForStatus(client, iopGVR, "istio-system", "test", &api.InstallStatus{}, installStatusHealthy,
+		retry.Delay(time.Millisecond), retry.Timeout(50*time.Millisecond))
+	if err == nil {
+		t.Fatal("expected error waiting for unhealthy status")
+	}
+}
@@ -31,6 +31,8 @@ import (
 	"istio.io/istio/pkg/test/util/retry"
 )
 
+const proxyContainerName = "istio-proxy"
+
 var (
 	defaultRetryTimeout = retry.Timeout(time.Minute * 10)
 	defaultRetryDelay   = retry.Delay(time.Second * 1)
@@ -111,6 +113,49 @@ func CheckPodsAreReady(fetchFunc PodFetchFunc) ([]kubeApiCore.Pod, error) {
 	return fetched, nil
 }
 
+// CheckPodsAreInjected checks whether the pods that are selected by the given function have the sidecar injected.
+func CheckPodsAreInjected(fetchFunc PodFetchFunc) ([]kubeApiCore.Pod, error) {
+	fetched, err := fetchFunc()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range fetched {
+		if e := CheckPodInjected(&fetched[i]); e != nil {
+			err = multierror.Append(err, e)
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fetched, nil
+}
+
+// CheckPodsAreInjectedOrFail calls CheckPodsAreInjected and fails the given test.Failer if an error occurs.
+func CheckPodsAreInjectedOrFail(t test.Failer, fetchFunc PodFetchFunc) []kubeApiCore.Pod {
+	t.Helper()
+	pods, err := CheckPodsAreInjected(fetchFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pods
+}
+
+// CheckPodInjected returns an error if the pod does not have the istio-proxy container.
+func CheckPodInjected(pod *kubeApiCore.Pod) error {
+	containers := make([]string, 0, len(pod.Spec.Containers))
+	for _, c := range pod.Spec.Containers {
+		if c.Name == proxyContainerName {
+			return nil
+		}
+		containers = append(containers, c.Name)
+	}
+	return fmt.Errorf("%s/%s: %s container not found in %v, check the injection webhook and namespace labels",
+		pod.Namespace, pod.Name, proxyContainerName, containers)
+}
+
 // DeleteOptionsForeground creates new delete options that will block until the operation completes.
 func DeleteOptionsForeground() kubeApiMeta.DeleteOptions {
 	propagationPolicy := kubeApiMeta.DeletePropagationForeground
@@ -176,6 +221,36 @@ func WaitUntilServiceEndpointsAreReady(a kubernetes.Interface, ns string, name s
 	return service, endpoints, nil
 }
 
+// WaitUntilServiceEndpointsReadyCount waits until the service with the given name/namespace has exactly
+// count ready addresses and no addresses that are not ready.
+func WaitUntilServiceEndpointsReadyCount(a kubernetes.Interface, ns string, name string, count int,
+	opts ...retry.Option) (*kubeApiCore.Endpoints, error) {
+	var endpoints *kubeApiCore.Endpoints
+	err := retry.UntilSuccess(func() error {
+		eps, err := a.CoreV1().Endpoints(ns).Get(context.TODO(), name, kubeApiMeta.GetOptions{})
+		if err != nil {
+			return err
+		}
+		ready, notReady := 0, 0
+		for _, subset := range eps.Subsets {
+			ready += len(subset.Addresses)
+			notReady += len(subset.NotReadyAddresses)
+		}
+		if ready != count || notReady != 0 {
+			return fmt.Errorf("%s/%v endpoints not converged: %d ready, %d not ready, want %d ready",
+				ns, name, ready, notReady, count)
+		}
+		endpoints = eps
+		return nil
+	}, newRetryOptions(opts...)...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return endpoints, nil
+}
+
 // WaitForSecretToExist waits for the given secret up to the given waitTime.
 func WaitForSecretToExist(a kubernetes.Interface, namespace, name string, waitTime time.Duration) (*kubeApiCore.Secret, error) {
 	secret := a.CoreV1().Secrets(namespace)
@@ -0,0 +1,78 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"strings"
+	"testing"
+
+	kubeApiCore "k8s.io/api/core/v1"
+	kubeApiMeta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func fakePod(name string, containers ...string) kubeApiCore.Pod {
+	pod := kubeApiCore.Pod{
+		ObjectMeta: kubeApiMeta.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+	}
+	for _, c := range containers {
+		pod.Spec.Containers = append(pod.Spec.Containers, kubeApiCore.Container{Name: c})
+	}
+	return pod
+}
+
+func TestCheckPodsAreInjected(t *testing.T) {
+	cases := []struct {
+		name    string
+		pods    []kubeApiCore.Pod
+		wantErr string
+	}{
+		{
+			name: "injected",
+			pods: []kubeApiCore.Pod{fakePod("a", "app", "istio-proxy")},
+		},
+		{
+			name:    "not injected",
+			pods:    []kubeApiCore.Pod{fakePod("a", "app")},
+			wantErr: "default/a: istio-proxy container not found in [app]",
+		},
+		{
+			name:    "partially injected",
+			pods:    []kubeApiCore.Pod{fakePod("a", "app", "istio-proxy"), fakePod("b", "app")},
+			wantErr: "default/b: istio-proxy container not found in [app]",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			pods, err := CheckPodsAreInjected(func() ([]kubeApiCore.Pod, error) {
+				return tt.pods, nil
+			})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if len(pods) != len(tt.pods) {
+					t.Fatalf("expected %d pods, got %d", len(tt.pods), len(pods))
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
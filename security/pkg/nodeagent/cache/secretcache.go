@@ -22,7 +22,6 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
-	"math/rand"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -32,6 +31,7 @@ import (
 	"github.com/google/uuid"
 
 	pilotmodel "istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/jitter"
 	"istio.io/istio/pkg/mcp/status"
 	"istio.io/istio/pkg/security"
 	"istio.io/istio/pkg/spiffe"
@@ -129,10 +129,6 @@ type SecretCache struct {
 	rootCert           []byte
 	rootCertExpireTime time.Time
 
-	// Source of random numbers. It is not concurrency safe, requires lock protected.
-	rand      *rand.Rand
-	randMutex *sync.Mutex
-
 	// The paths for an existing certificate chain, key and root cert files. Istio agent will
 	// use them as the source of secrets if they exist.
 	existingCertChainFile string
@@ -155,7 +151,6 @@ func NewSecretCache(fetcher *secretfetcher.SecretFetcher,
 		notifyCallback:        notifyCb,
 		rootCertMutex:         &sync.RWMutex{},
 		configOptions:         options,
-		randMutex:             &sync.Mutex{},
 		existingCertChainFile: security.DefaultCertChainFilePath,
 		existingKeyFile:       security.DefaultKeyFilePath,
 		existingRootCertFile:  security.DefaultRootCertFilePath,
@@ -163,9 +158,6 @@ func NewSecretCache(fetcher *secretfetcher.SecretFetcher,
 		fileCerts:             make(map[string]map[ConnKey]struct{}),
 		certMutex:             &sync.RWMutex{},
 	}
-	randSource := rand.NewSource(time.Now().UnixNano())
-	ret.rand = rand.New(randSource)
-
 	atomic.StoreUint64(&ret.secretChangedCount, 0)
 	atomic.StoreUint64(&ret.rootCertChangedCount, 0)
 	go ret.keyCertRotationJob()
@@ -770,12 +762,10 @@ func (sc *SecretCache) sendRetriableRequest(ctx context.Context, csrPEM []byte,
 	providedExchangedToken string, connKey ConnKey, isCSR bool) ([]string, error) {
 
 	if sc.configOptions.InitialBackoffInMilliSec > 0 {
-		sc.randMutex.Lock()
-		randomizedInitialBackOffInMS := sc.rand.Int63n(sc.configOptions.InitialBackoffInMilliSec)
-		sc.randMutex.Unlock()
-		cacheLog.Debugf("Wait for %d millisec for jitter", randomizedInitialBackOffInMS)
+		randomizedInitialBackOff := jitter.Duration(time.Duration(sc.configOptions.InitialBackoffInMilliSec) * time.Millisecond)
+		cacheLog.Debugf("Wait for %v for jitter", randomizedInitialBackOff)
 		// Add a jitter to initial CSR to avoid thundering herd problem.
-		time.Sleep(time.Duration(randomizedInitialBackOffInMS) * time.Millisecond)
+		time.Sleep(randomizedInitialBackOff)
 	}
 	retryBackoffInMS := int64(firstRetryBackOffInMilliSec)
 
@@ -17,12 +17,13 @@ package ca
 import (
 	"bytes"
 	"fmt"
-	"math/rand"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 
+	"istio.io/istio/pkg/jitter"
 	"istio.io/istio/security/pkg/k8s/controller"
 	"istio.io/istio/security/pkg/pki/util"
 	certutil "istio.io/istio/security/pkg/util"
@@ -52,6 +53,9 @@ type SelfSignedCARootCertRotator struct {
 	config             *SelfSignedCARootCertRotatorConfig
 	backOffTime        time.Duration
 	ca                 *IstioCA
+	clock              clock.Clock
+	// rotate is called on every check interval. It is overridden in tests.
+	rotate func()
 }
 
 // NewSelfSignedCARootCertRotator returns a new root cert rotator instance that
@@ -62,13 +66,13 @@ func NewSelfSignedCARootCertRotator(config *SelfSignedCARootCertRotatorConfig,
 		caSecretController: controller.NewCaSecretController(config.client),
 		config:             config,
 		ca:                 ca,
+		clock:              clock.RealClock{},
 	}
+	rotator.rotate = rotator.checkAndRotateRootCert
 	if config.enableJitter {
 		// Select a back off time in seconds, which is in the range of [0, rotator.config.CheckInterval).
-		randSource := rand.NewSource(time.Now().UnixNano())
-		randBackOff := rand.New(randSource)
-		backOffSeconds := int(time.Duration(randBackOff.Int63n(int64(rotator.config.CheckInterval))).Seconds())
-		rotator.backOffTime = time.Duration(backOffSeconds) * time.Second
+		// The back off time is zero if jitter is disabled globally.
+		rotator.backOffTime = jitter.Duration(rotator.config.CheckInterval).Truncate(time.Second)
 		rootCertRotatorLog.Infof("Set up back off time %s to start rotator.", rotator.backOffTime.String())
 	} else {
 		rotator.backOffTime = time.Duration(0)
@@ -78,23 +82,23 @@ func NewSelfSignedCARootCertRotator(config *SelfSignedCARootCertRotatorConfig,
 
 // Run refreshes root certs and updates config map accordingly.
 func (rotator *SelfSignedCARootCertRotator) Run(stopCh chan struct{}) {
-	if rotator.config.enableJitter {
+	if rotator.config.enableJitter && rotator.backOffTime > 0 {
 		rootCertRotatorLog.Infof("Jitter is enabled, wait %s before "+
 			"starting root cert rotator.", rotator.backOffTime.String())
 		select {
-		case <-time.After(rotator.backOffTime):
+		case <-rotator.clock.After(rotator.backOffTime):
 			rootCertRotatorLog.Infof("Jitter complete, start rotator.")
 		case <-stopCh:
 			rootCertRotatorLog.Info("Received stop signal, so stop the root cert rotator.")
 			return
 		}
 	}
-	ticker := time.NewTicker(rotator.config.CheckInterval)
+	ticker := rotator.clock.NewTicker(rotator.config.CheckInterval)
 	for {
 		select {
-		case <-ticker.C:
+		case <-ticker.C():
 			rootCertRotatorLog.Info("Check and rotate root cert.")
-			rotator.checkAndRotateRootCert()
+			rotator.rotate()
 		case _, ok := <-stopCh:
 			if !ok {
 				rootCertRotatorLog.Info("Received stop signal, so stop the root cert rotator.")
@@ -25,9 +25,11 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/client-go/kubernetes/fake"
 	ktesting "k8s.io/client-go/testing"
 
+	"istio.io/istio/pkg/jitter"
 	"istio.io/istio/security/pkg/cmd"
 	"istio.io/istio/security/pkg/pki/util"
 	certutil "istio.io/istio/security/pkg/util"
@@ -71,6 +73,48 @@ func TestRootCertRotatorWithoutRootCertSecret(t *testing.T) {
 	}
 }
 
+// TestRootCertRotatorWithJitterDisabled verifies that with jitter disabled globally, the rotator
+// starts immediately and checks the root cert at exact intervals.
+func TestRootCertRotatorWithJitterDisabled(t *testing.T) {
+	defer jitter.SetDisabled(jitter.Disabled())
+	jitter.SetDisabled(true)
+
+	opts := getDefaultSelfSignedIstioCAOptions(nil)
+	opts.RotatorConfig.enableJitter = true
+	rotator := getRootCertRotator(opts)
+	if rotator.backOffTime != 0 {
+		t.Fatalf("expected no back off time with jitter disabled, got %v", rotator.backOffTime)
+	}
+
+	start := time.Now()
+	fakeClock := clock.NewFakeClock(start)
+	rotator.clock = fakeClock
+	checks := make(chan time.Time, 10)
+	rotator.rotate = func() {
+		checks <- fakeClock.Now()
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go rotator.Run(stop)
+
+	interval := rotator.config.CheckInterval
+	for i := 1; i <= 3; i++ {
+		// Wait for the rotator to wait on the ticker before advancing the clock.
+		for !fakeClock.HasWaiters() {
+			time.Sleep(time.Millisecond)
+		}
+		fakeClock.Step(interval)
+		select {
+		case got := <-checks:
+			if want := start.Add(time.Duration(i) * interval); !got.Equal(want) {
+				t.Fatalf("check %d: expected at %v, got %v", i, want, got)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("check %d: rotator did not run", i)
+		}
+	}
+}
+
 type rootCertItem struct {
 	caSecret                *v1.Secret
 	rootCertInKeyCertBundle []byte
@@ -0,0 +1,111 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	kubeApiMeta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"istio.io/istio/operator/pkg/object"
+	kubecluster "istio.io/istio/pkg/test/framework/components/environment/kube"
+	"istio.io/istio/pkg/test/scopes"
+	"istio.io/istio/pkg/test/util/retry"
+)
+
+var efgvr = schema.GroupVersionResource{
+	Group:    "networking.istio.io",
+	Version:  "v1alpha3",
+	Resource: "envoyfilters",
+}
+
+// errKindNotChecked is returned by getInClusterObject for kinds it does not know how to fetch.
+var errKindNotChecked = goerrors.New("kind not checked")
+
+// getInClusterObject fetches the in-cluster counterpart of a generated object.
+func getInClusterObject(cs *kubecluster.Cluster, obj *object.K8sObject) error {
+	ns, name := obj.Namespace, obj.Name
+	var err error
+	switch obj.Kind {
+	case "Service":
+		_, err = cs.CoreV1().Services(ns).Get(context.TODO(), name, kubeApiMeta.GetOptions{})
+	case "ServiceAccount":
+		_, err = cs.CoreV1().ServiceAccounts(ns).Get(context.TODO(), name, kubeApiMeta.GetOptions{})
+	case "Deployment":
+		_, err = cs.AppsV1().Deployments(ns).Get(context.TODO(), name, kubeApiMeta.GetOptions{})
+	case "ConfigMap":
+		_, err = cs.CoreV1().ConfigMaps(ns).Get(context.TODO(), name, kubeApiMeta.GetOptions{})
+	case "ValidatingWebhookConfiguration":
+		_, err = cs.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Get(context.TODO(),
+			name, kubeApiMeta.GetOptions{})
+	case "MutatingWebhookConfiguration":
+		_, err = cs.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Get(context.TODO(),
+			name, kubeApiMeta.GetOptions{})
+	case "CustomResourceDefinition":
+		_, err = cs.Ext().ApiextensionsV1beta1().CustomResourceDefinitions().Get(context.TODO(), name,
+			kubeApiMeta.GetOptions{})
+	case "EnvoyFilter":
+		_, err = cs.Dynamic().Resource(efgvr).Namespace(ns).Get(context.TODO(), name, kubeApiMeta.GetOptions{})
+	case "PodDisruptionBudget":
+		_, err = cs.PolicyV1beta1().PodDisruptionBudgets(ns).Get(context.TODO(), name, kubeApiMeta.GetOptions{})
+	case "NetworkPolicy":
+		_, err = cs.GetNetworkPolicy(ns, name)
+	case "Role":
+		_, err = cs.GetRole(ns, name)
+	case "RoleBinding":
+		_, err = cs.GetRoleBinding(ns, name)
+	case "ClusterRole":
+		_, err = cs.GetClusterRole(name)
+	case "ClusterRoleBinding":
+		_, err = cs.GetClusterRoleBinding(name)
+	case "HorizontalPodAutoscaler":
+		_, err = cs.AutoscalingV2beta1().HorizontalPodAutoscalers(ns).Get(context.TODO(), name,
+			kubeApiMeta.GetOptions{})
+	default:
+		return errKindNotChecked
+	}
+	return err
+}
+
+// verifyResourcesRemoved waits until none of the generated objects are left in the cluster. On timeout
+// the returned error lists the objects that are still present.
+func verifyResourcesRemoved(cs *kubecluster.Cluster, objs object.K8sObjects, opts ...retry.Option) error {
+	scopes.Framework.Infof("verifying %d generated resources are removed", len(objs))
+	return retry.UntilSuccess(func() error {
+		var leftovers []string
+		for _, obj := range objs {
+			err := getInClusterObject(cs, obj)
+			if err == errKindNotChecked {
+				continue
+			}
+			if err == nil {
+				leftovers = append(leftovers, obj.Hash())
+				continue
+			}
+			if !errors.IsNotFound(err) {
+				return fmt.Errorf("failed to check %s: %v", obj.Hash(), err)
+			}
+		}
+		if len(leftovers) > 0 {
+			return fmt.Errorf("resources still in cluster: %s", strings.Join(leftovers, ", "))
+		}
+		return nil
+	}, opts...)
+}
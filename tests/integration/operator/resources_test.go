@@ -0,0 +1,125 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	kubeApiCore "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	kubeApiMeta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"istio.io/istio/operator/pkg/object"
+	istioKube "istio.io/istio/pkg/kube"
+	kubecluster "istio.io/istio/pkg/test/framework/components/environment/kube"
+	"istio.io/istio/pkg/test/util/retry"
+)
+
+const generatedManifest = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: istiod-v2
+  namespace: istio-system
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: istio-v2
+  namespace: istio-system
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: istio-ca-secret
+  namespace: istio-system
+`
+
+func TestVerifyResourcesRemoved(t *testing.T) {
+	objs, err := object.ParseK8sObjectsFromYAMLManifest(generatedManifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := []retry.Option{retry.Timeout(100 * time.Millisecond), retry.Delay(10 * time.Millisecond)}
+
+	t.Run("all removed", func(t *testing.T) {
+		cs := &kubecluster.Cluster{ExtendedClient: istioKube.NewFakeClient()}
+		if err := verifyResourcesRemoved(cs, objs, opts...); err != nil {
+			t.Fatalf("expected no leftovers, got: %v", err)
+		}
+	})
+
+	t.Run("lingering object", func(t *testing.T) {
+		cs := &kubecluster.Cluster{ExtendedClient: istioKube.NewFakeClient(&kubeApiCore.Service{
+			ObjectMeta: kubeApiMeta.ObjectMeta{Name: "istiod-v2", Namespace: "istio-system"},
+		})}
+		err := verifyResourcesRemoved(cs, objs, opts...)
+		if err == nil {
+			t.Fatal("expected lingering service to be reported")
+		}
+		if !strings.Contains(err.Error(), "Service:istio-system:istiod-v2") {
+			t.Errorf("expected error to report the lingering service, got: %v", err)
+		}
+		if strings.Contains(err.Error(), "ConfigMap") {
+			t.Errorf("expected removed config map not to be reported, got: %v", err)
+		}
+	})
+}
+
+func TestGetInClusterObject(t *testing.T) {
+	objs, err := object.ParseK8sObjectsFromYAMLManifest(`
+apiVersion: networking.k8s.io/v1
+kind: NetworkPolicy
+metadata:
+  name: istiod
+  namespace: istio-system
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: RoleBinding
+metadata:
+  name: istiod
+  namespace: istio-system
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: istiod-istio-system
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs := &kubecluster.Cluster{ExtendedClient: istioKube.NewFakeClient(
+		&networkingv1.NetworkPolicy{ObjectMeta: kubeApiMeta.ObjectMeta{Name: "istiod", Namespace: "istio-system"}},
+		&rbacv1.RoleBinding{ObjectMeta: kubeApiMeta.ObjectMeta{Name: "istiod", Namespace: "istio-system"}},
+		&rbacv1.ClusterRole{ObjectMeta: kubeApiMeta.ObjectMeta{Name: "istiod-istio-system"}},
+	)}
+	for _, obj := range objs {
+		if err := getInClusterObject(cs, obj); err != nil {
+			t.Errorf("expected %s to be found, got: %v", obj.Hash(), err)
+		}
+	}
+	secret := object.NewK8sObject(&unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]interface{}{"name": "istio-ca-secret", "namespace": "istio-system"},
+	}}, nil, nil)
+	if err := getInClusterObject(cs, secret); err != errKindNotChecked {
+		t.Errorf("expected unknown kinds not to be checked, got: %v", err)
+	}
+}
@@ -16,9 +16,7 @@
 package operator
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -26,17 +24,19 @@ import (
 	"testing"
 	"time"
 
-	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
 	kubeApiCore "k8s.io/api/core/v1"
 	kubeApiMeta "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"istio.io/api/label"
 	api "istio.io/api/operator/v1alpha1"
 	"istio.io/istio/operator/pkg/object"
 	"istio.io/istio/operator/pkg/util"
 	istioKube "istio.io/istio/pkg/kube"
 	"istio.io/istio/pkg/test/env"
 	"istio.io/istio/pkg/test/framework"
+	kubecluster "istio.io/istio/pkg/test/framework/components/environment/kube"
 	"istio.io/istio/pkg/test/framework/components/istioctl"
 	"istio.io/istio/pkg/test/framework/image"
 	"istio.io/istio/pkg/test/framework/resource"
@@ -121,6 +121,7 @@ func TestController(t *testing.T) {
 				if err := cs.DeleteYAMLFiles(IstioNamespace, iopCRFile); err != nil {
 					t.Errorf("failed to delete test IstioOperator CR: %v", err)
 				}
+				verifyUninstalled(t, istioCtl, "default", "v2", cs)
 				if err := cs.AppsV1().Deployments(IstioNamespace).DeleteCollection(context.TODO(),
 					kube2.DeleteOptionsForeground(), kubeApiMeta.ListOptions{LabelSelector: "app=istiod"}); err != nil {
 					t.Errorf("failed to remove istiod deployments: %v", err)
@@ -183,49 +184,22 @@ func checkInstallStatus(cs istioKube.ExtendedClient, revision string) error {
 		Resource: "istiooperators",
 	}
 
-	var unhealthyCN []string
-	retryFunc := func() error {
-		us, err := cs.Dynamic().Resource(gvr).Namespace(IstioNamespace).Get(context.TODO(), revName("test-istiocontrolplane", revision), kubeApiMeta.GetOptions{})
-		if err != nil {
-			return fmt.Errorf("failed to get istioOperator resource: %v", err)
-		}
-		usIOPStatus := us.UnstructuredContent()["status"]
-		if usIOPStatus == nil {
-			if _, err := cs.CoreV1().Services(OperatorNamespace).Get(context.TODO(), revName("istio-operator", revision),
-				kubeApiMeta.GetOptions{}); err != nil {
-				return fmt.Errorf("istio operator svc is not ready: %v", err)
-			}
-			if _, err := kube2.CheckPodsAreReady(kube2.NewPodFetch(cs, OperatorNamespace, "")); err != nil {
-				return fmt.Errorf("istio operator pod is not ready: %v", err)
-			}
-
-			return fmt.Errorf("status not found from the istioOperator resource")
-		}
-		usIOPStatus = usIOPStatus.(map[string]interface{})
-		iopStatusString, err := json.Marshal(usIOPStatus)
-		if err != nil {
-			return fmt.Errorf("failed to marshal istioOperator status: %v", err)
-		}
-		status := &api.InstallStatus{}
-		jspb := jsonpb.Unmarshaler{AllowUnknownFields: true}
-		if err := jspb.Unmarshal(bytes.NewReader(iopStatusString), status); err != nil {
-			return fmt.Errorf("failed to unmarshal istioOperator status: %v", err)
-		}
+	healthy := func(msg proto.Message) error {
+		status := msg.(*api.InstallStatus)
 		errs := util.Errors{}
-		unhealthyCN = []string{}
 		if status.Status != api.InstallStatus_HEALTHY {
 			errs = util.AppendErr(errs, fmt.Errorf("got IstioOperator status: %v", status.Status))
 		}
 
 		for cn, cnstatus := range status.ComponentStatus {
 			if cnstatus.Status != api.InstallStatus_HEALTHY {
-				unhealthyCN = append(unhealthyCN, cn)
 				errs = util.AppendErr(errs, fmt.Errorf("got component: %s status: %v", cn, cnstatus.Status))
 			}
 		}
 		return errs.ToError()
 	}
-	err := retry.UntilSuccess(retryFunc, retry.Timeout(retryTimeOut), retry.Delay(retryDelay))
+	err := kube2.WaitForStatus(cs.Dynamic(), gvr, IstioNamespace, revName("test-istiocontrolplane", revision),
+		&api.InstallStatus{}, healthy, retry.Timeout(retryTimeOut), retry.Delay(retryDelay))
 	if err != nil {
 		return fmt.Errorf("istioOperator status is not healthy: %v", err)
 	}
@@ -292,8 +266,8 @@ func verifyInstallation(t *testing.T, ctx resource.Context,
 		t.Fatalf("IstioOperator status not healthy: %v", err)
 	}
 
-	if _, err := kube2.CheckPodsAreReady(kube2.NewSinglePodFetch(cs, IstioNamespace, "app=istiod")); err != nil {
-		t.Fatalf("istiod pod is not ready: %v", err)
+	if err := cs.(*kubecluster.Cluster).WaitForDeploymentRollout(IstioNamespace, revName("istiod", revision), retryTimeOut); err != nil {
+		t.Fatalf("istiod deployment is not rolled out: %v", err)
 	}
 
 	if err := compareInClusterAndGeneratedResources(t, istioCtl, profileName, revision, cs); err != nil {
@@ -303,8 +277,8 @@ func verifyInstallation(t *testing.T, ctx resource.Context,
 	scopes.Framework.Infof("=== succeeded ===")
 }
 
-func compareInClusterAndGeneratedResources(t *testing.T, istioCtl istioctl.Instance, profileName string, revision string,
-	cs resource.Cluster) error {
+// generatedResources returns the objects generated by `manifest generate` for the given profile and revision.
+func generatedResources(t *testing.T, istioCtl istioctl.Instance, profileName string, revision string) (object.K8sObjects, error) {
 	// get manifests by running `manifest generate`
 	generateCmd := []string{
 		"manifest", "generate",
@@ -319,68 +293,42 @@ func compareInClusterAndGeneratedResources(t *testing.T, istioCtl istioctl.Insta
 	genManifests, _ := istioCtl.InvokeOrFail(t, generateCmd)
 	genK8SObjects, err := object.ParseK8sObjectsFromYAMLManifest(genManifests)
 	if err != nil {
-		return fmt.Errorf("failed to parse generated manifest: %v", err)
+		return nil, fmt.Errorf("failed to parse generated manifest: %v", err)
+	}
+	return genK8SObjects, nil
+}
+
+// verifyUninstalled waits until the revision specific resources generated for the given profile and
+// revision are removed from the cluster after the IstioOperator CR is deleted.
+func verifyUninstalled(t *testing.T, istioCtl istioctl.Instance, profileName string, revision string, cs resource.Cluster) {
+	genK8SObjects, err := generatedResources(t, istioCtl, profileName, revision)
+	if err != nil {
+		t.Errorf("failed to generate resources: %v", err)
+		return
+	}
+	var revisioned object.K8sObjects
+	for _, obj := range genK8SObjects {
+		if obj.UnstructuredObject().GetLabels()[label.IstioRev] == revision {
+			revisioned = append(revisioned, obj)
+		}
 	}
-	efgvr := schema.GroupVersionResource{
-		Group:    "networking.istio.io",
-		Version:  "v1alpha3",
-		Resource: "envoyfilters",
+	if err := verifyResourcesRemoved(cs.(*kubecluster.Cluster), revisioned, retry.Timeout(retryTimeOut), retry.Delay(retryDelay)); err != nil {
+		t.Errorf("resources of revision %s were not removed: %v", revision, err)
 	}
+}
 
+func compareInClusterAndGeneratedResources(t *testing.T, istioCtl istioctl.Instance, profileName string, revision string,
+	cs resource.Cluster) error {
+	genK8SObjects, err := generatedResources(t, istioCtl, profileName, revision)
+	if err != nil {
+		return err
+	}
 	for _, genK8SObject := range genK8SObjects {
-		kind := genK8SObject.Kind
-		ns := genK8SObject.Namespace
-		name := genK8SObject.Name
-		scopes.Framework.Infof("checking kind: %s, namespace: %s, name: %s", kind, ns, name)
+		scopes.Framework.Infof("checking kind: %s, namespace: %s, name: %s", genK8SObject.Kind,
+			genK8SObject.Namespace, genK8SObject.Name)
 		retry.UntilSuccessOrFail(t, func() error {
-			switch kind {
-			case "Service":
-				if _, err := cs.CoreV1().Services(ns).Get(context.TODO(), name, kubeApiMeta.GetOptions{}); err != nil {
-					return fmt.Errorf("failed to get expected service: %s from cluster", name)
-				}
-			case "ServiceAccount":
-				if _, err := cs.CoreV1().ServiceAccounts(ns).Get(context.TODO(), name, kubeApiMeta.GetOptions{}); err != nil {
-					return fmt.Errorf("failed to get expected serviceAccount: %s from cluster", name)
-				}
-			case "Deployment":
-				if _, err := cs.AppsV1().Deployments(IstioNamespace).Get(context.TODO(), name,
-					kubeApiMeta.GetOptions{}); err != nil {
-					return fmt.Errorf("failed to get expected deployment: %s from cluster", name)
-				}
-			case "ConfigMap":
-				if _, err := cs.CoreV1().ConfigMaps(ns).Get(context.TODO(), name, kubeApiMeta.GetOptions{}); err != nil {
-					return fmt.Errorf("failed to get expected configMap: %s from cluster", name)
-				}
-			case "ValidatingWebhookConfiguration":
-				if _, err := cs.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Get(context.TODO(),
-					name, kubeApiMeta.GetOptions{}); err != nil {
-					return fmt.Errorf("failed to get expected ValidatingWebhookConfiguration: %s from cluster", name)
-				}
-			case "MutatingWebhookConfiguration":
-				if _, err := cs.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Get(context.TODO(),
-					name, kubeApiMeta.GetOptions{}); err != nil {
-					return fmt.Errorf("failed to get expected MutatingWebhookConfiguration: %s from cluster", name)
-				}
-			case "CustomResourceDefinition":
-				if _, err := cs.Ext().ApiextensionsV1beta1().CustomResourceDefinitions().Get(context.TODO(), name,
-					kubeApiMeta.GetOptions{}); err != nil {
-					return fmt.Errorf("failed to get expected CustomResourceDefinition: %s from cluster", name)
-				}
-			case "EnvoyFilter":
-				if _, err := cs.Dynamic().Resource(efgvr).Namespace(ns).Get(context.TODO(), name,
-					kubeApiMeta.GetOptions{}); err != nil {
-					return fmt.Errorf("failed to get expected Envoyfilter: %s from cluster", name)
-				}
-			case "PodDisruptionBudget":
-				if _, err := cs.PolicyV1beta1().PodDisruptionBudgets(ns).Get(context.TODO(), name,
-					kubeApiMeta.GetOptions{}); err != nil {
-					return fmt.Errorf("failed to get expected PodDisruptionBudget: %s from cluster", name)
-				}
-			case "HorizontalPodAutoscaler":
-				if _, err := cs.AutoscalingV2beta1().HorizontalPodAutoscalers(ns).Get(context.TODO(), name,
-					kubeApiMeta.GetOptions{}); err != nil {
-					return fmt.Errorf("failed to get expected HorizontalPodAutoscaler: %s from cluster", name)
-				}
+			if err := getInClusterObject(cs.(*kubecluster.Cluster), genK8SObject); err != nil && err != errKindNotChecked {
+				return fmt.Errorf("failed to get expected %s: %s from cluster: %v", genK8SObject.Kind, genK8SObject.Name, err)
 			}
 			return nil
 		}, retry.Timeout(time.Second*300), retry.Delay(time.Millisecond*100))
@@ -68,6 +68,23 @@ func DumpCertFromSidecar(ns namespace.Instance, fromSelector, fromContainer, con
 	return out, nil
 }
 
+// PodCertFetcher returns a FetchFunc that reads the certificate at certPath from the given container of
+// the pod matching labelSelector, such as the certificates that the sidecar writes for Prometheus.
+func PodCertFetcher(ns namespace.Instance, labelSelector, container, certPath string) FetchFunc {
+	return func() ([]byte, error) {
+		podName, err := dir.GetPodName(ns, labelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("err getting pod name: %v", err)
+		}
+		execCmd := fmt.Sprintf("kubectl exec %s -c %s -n %s -- cat %s", podName, container, ns.Name(), certPath)
+		out, err := shell.Execute(false, execCmd)
+		if err != nil {
+			return nil, fmt.Errorf("error executing the cmd (%v): %v", execCmd, err)
+		}
+		return []byte(out), nil
+	}
+}
+
 // CreateCASecret creates a k8s secret "cacerts" to store the CA key and cert.
 func CreateCASecret(ctx resource.Context) error {
 	name := "cacerts"
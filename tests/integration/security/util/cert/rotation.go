@@ -0,0 +1,86 @@
+//  Copyright Istio Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package cert
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"istio.io/istio/pkg/test/util/retry"
+)
+
+// FetchFunc returns the PEM encoded certificate chain currently used by a workload.
+type FetchFunc func() ([]byte, error)
+
+// Serial returns the serial number of the first certificate found in the given PEM data. Any text
+// preceding the certificate, such as the output of openssl s_client, is ignored.
+func Serial(pemData []byte) (*big.Int, error) {
+	for {
+		var block *pem.Block
+		block, pemData = pem.Decode(pemData)
+		if block == nil {
+			return nil, errors.New("no certificate found")
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		c, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate: %v", err)
+		}
+		return c.SerialNumber, nil
+	}
+}
+
+// FetchSerial fetches the workload certificate and returns its serial number.
+func FetchSerial(fetch FetchFunc) (*big.Int, error) {
+	pemData, err := fetch()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch certificate: %v", err)
+	}
+	return Serial(pemData)
+}
+
+// WaitForRotation captures the serial of the workload certificate, calls trigger (if not nil) to cause
+// a rotation, and waits until a certificate with a different serial is served. The retry options
+// bound how long to wait for the new certificate.
+func WaitForRotation(fetch FetchFunc, trigger func() error, options ...retry.Option) error {
+	initial, err := FetchSerial(fetch)
+	if err != nil {
+		return err
+	}
+	if trigger != nil {
+		if err := trigger(); err != nil {
+			return fmt.Errorf("failed to trigger certificate rotation: %v", err)
+		}
+	}
+	err = retry.UntilSuccess(func() error {
+		current, err := FetchSerial(fetch)
+		if err != nil {
+			return err
+		}
+		if current.Cmp(initial) == 0 {
+			return fmt.Errorf("certificate with serial %s has not been rotated", initial)
+		}
+		return nil
+	}, options...)
+	if err != nil {
+		return fmt.Errorf("certificate was not rotated: %v", err)
+	}
+	return nil
+}
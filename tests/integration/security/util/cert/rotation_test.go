@@ -0,0 +1,113 @@
+//  Copyright Istio Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package cert
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"istio.io/istio/pkg/test/util/retry"
+)
+
+func generateCert(t *testing.T, serial int64) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{Organization: []string{"cluster.local"}},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+// fakeWorkload serves one certificate generation until it is rotated.
+type fakeWorkload struct {
+	mu          sync.Mutex
+	generations [][]byte
+	current     int
+}
+
+func (f *fakeWorkload) fetch() ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.generations[f.current], nil
+}
+
+func (f *fakeWorkload) rotate() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.current < len(f.generations)-1 {
+		f.current++
+	}
+	return nil
+}
+
+func TestSerial(t *testing.T) {
+	out := append([]byte("CONNECTED(00000003)\n---\nCertificate chain\n"), generateCert(t, 42)...)
+	serial, err := Serial(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if serial.Int64() != 42 {
+		t.Fatalf("expected serial 42, got %v", serial)
+	}
+	if _, err := Serial([]byte("no certificate here")); err == nil {
+		t.Fatal("expected error for output without certificate")
+	}
+}
+
+func TestWaitForRotation(t *testing.T) {
+	opts := []retry.Option{retry.Timeout(time.Second), retry.Delay(10 * time.Millisecond)}
+	t.Run("rotated", func(t *testing.T) {
+		w := &fakeWorkload{generations: [][]byte{generateCert(t, 1), generateCert(t, 2)}}
+		if err := WaitForRotation(w.fetch, w.rotate, opts...); err != nil {
+			t.Fatal(err)
+		}
+	})
+	t.Run("rotated asynchronously", func(t *testing.T) {
+		w := &fakeWorkload{generations: [][]byte{generateCert(t, 1), generateCert(t, 2)}}
+		trigger := func() error {
+			time.AfterFunc(100*time.Millisecond, func() {
+				_ = w.rotate()
+			})
+			return nil
+		}
+		if err := WaitForRotation(w.fetch, trigger, opts...); err != nil {
+			t.Fatal(err)
+		}
+	})
+	t.Run("not rotated", func(t *testing.T) {
+		w := &fakeWorkload{generations: [][]byte{generateCert(t, 1)}}
+		if err := WaitForRotation(w.fetch, w.rotate, opts...); err == nil {
+			t.Fatal("expected error when the certificate is not rotated")
+		}
+	})
+}
@@ -163,8 +163,13 @@ type Expected struct {
 	// Metadata includes headers and additional injected information such as Method, Proto, etc.
 	// The test will validate the returned metadata includes all options specified here
 	Metadata map[string]string
+	// Validator, if set, is additionally applied to the call result, e.g. to check a TCP echo
+	Validator echo.Validator
 }
 
+// tcpEchoMessage is sent on every call so TCP cases can verify it is echoed back.
+const tcpEchoMessage = "outbound-traffic-policy"
+
 // TrafficPolicy is the mode of the outbound traffic policy to use
 // when configuring the sidecar for the client
 type TrafficPolicy string
@@ -279,7 +284,8 @@ func RunExternalRequest(cases []*TestCase, prometheus prometheus.Instance, mode
 							Headers: map[string][]string{
 								"Host": {tc.Host},
 							},
-							HTTP2: tc.HTTP2,
+							HTTP2:   tc.HTTP2,
+							Message: tcpEchoMessage,
 						})
 
 						// the expected response from a blackhole test case will have err
@@ -304,6 +310,10 @@ func RunExternalRequest(cases []*TestCase, prometheus prometheus.Instance, mode
 							}
 						}
 
+						if tc.Expected.Validator != nil {
+							return tc.Expected.Validator.Validate(resp, err)
+						}
+
 						return nil
 					}, retry.Delay(time.Second), retry.Timeout(20*time.Second))
 
@@ -335,7 +345,7 @@ func setupEcho(t *testing.T, ctx resource.Context, mode TrafficPolicy) (echo.Ins
 		With(&dest, echo.Config{
 			Service:   "destination",
 			Namespace: appsNamespace,
-			Subsets:   []echo.SubsetConfig{{Annotations: echo.NewAnnotations().SetBool(echo.SidecarInject, false)}},
+			Subsets:   []echo.SubsetConfig{echo.NoSidecarSubset("")},
 			Ports: []echo.Port{
 				{
 					// Plain HTTP port, will match no listeners and fall through
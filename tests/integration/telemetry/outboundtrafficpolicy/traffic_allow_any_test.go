@@ -17,6 +17,8 @@ package outboundtrafficpolicy
 
 import (
 	"testing"
+
+	"istio.io/istio/pkg/test/framework/components/echo"
 )
 
 func TestOutboundTrafficPolicy_AllowAny(t *testing.T) {
@@ -126,7 +128,8 @@ func TestOutboundTrafficPolicy_AllowAny(t *testing.T) {
 				//PromQueryFormat: `sum(istio_tcp_connections_closed_total{reporter="source",destination_service_name="PassthroughCluster",source_workload="client-v1"})`,
 				ResponseCode: []string{"200"},
 				// TCP will add StatusCode field. We don't really have a better way to identify as TCP
-				Metadata: map[string]string{"StatusCode": "200"},
+				Metadata:  map[string]string{"StatusCode": "200"},
+				Validator: echo.ExpectTCPEcho(tcpEchoMessage),
 			},
 		},
 		{
@@ -138,7 +141,8 @@ func TestOutboundTrafficPolicy_AllowAny(t *testing.T) {
 				//PromQueryFormat: `sum(istio_tcp_connections_closed_total{reporter="source",destination_service_name="PassthroughCluster",source_workload="client-v1"})`,
 				ResponseCode: []string{"200"},
 				// TCP will add StatusCode field. We don't really have a better way to identify as TCP
-				Metadata: map[string]string{"StatusCode": "200"},
+				Metadata:  map[string]string{"StatusCode": "200"},
+				Validator: echo.ExpectTCPEcho(tcpEchoMessage),
 			},
 		},
 	}